@@ -0,0 +1,25 @@
+//go:build moby_v1_47
+
+package main
+
+// The packages declaring the roots of API v1.47 are loaded from their sources
+// by the generator, they are imported here so that go mod tidy keeps their
+// modules in go.v1.47.mod.
+import (
+	_ "github.com/docker/docker/api/types"
+	_ "github.com/docker/docker/api/types/container"
+	_ "github.com/docker/docker/api/types/events"
+	_ "github.com/docker/docker/api/types/image"
+	_ "github.com/docker/docker/api/types/network"
+	_ "github.com/docker/docker/api/types/registry"
+	_ "github.com/docker/docker/api/types/swarm"
+	_ "github.com/docker/docker/api/types/swarm/runtime"
+	_ "github.com/docker/docker/api/types/system"
+	_ "github.com/docker/docker/api/types/volume"
+	_ "github.com/docker/docker/pkg/archive"
+	_ "github.com/docker/docker/pkg/jsonmessage"
+)
+
+// buildFlags load the sources of API v1.47 with the module versions of
+// go.v1.47.mod, the same this binary is built with.
+var buildFlags = []string{"-modfile=go.v1.47.mod"}
@@ -1,170 +1,89 @@
 package main
 
 import (
-	"bufio"
+	"flag"
 	"fmt"
 	"os"
-	"path"
-	"reflect"
-	"strings"
-)
-
-var reflectedTypes = map[reflect.Type]*TSModelType{}
-
-func ultimateType(t reflect.Type) reflect.Type {
-	for {
-		switch t.Kind() {
-		case reflect.Array, reflect.Chan, reflect.Map, reflect.Ptr, reflect.Slice:
-			t = t.Elem()
-		default:
-			return t
-		}
-	}
-}
 
-func reflectTypeMembers(t reflect.Type, m *TSModelType) {
-	for index := 0; index < t.NumField(); index++ {
-		field := t.Field(index)
-
-		// encoding/json never marshals unexported fields
-		if !field.IsExported() && !field.Anonymous {
-			continue
-		}
-
-		jsonTag, _ := JsonTagFromString(field.Tag.Get("json"))
-		if jsonTag.Skip {
-			continue
-		}
-
-		var name string
-		if strings.Compare(jsonTag.Name, "") == 0 {
-			name = field.Name
-		} else {
-			name = jsonTag.Name
-		}
+	"github.com/docker/docker/api"
 
-		// Inline struct definitions
-		if field.Type.Kind() == reflect.Struct && field.Type.Name() == "" {
-			goSourceName := strings.Split(m.GoSourceName, ".")[0] + field.Name
-			m2 := &TSModelType{GoSourceName: goSourceName}
-			reflectTypeMembers(field.Type, m2)
-			tsType := TSType{StrRepresentation: m2.WriteInlineStruct(), Nullable: false}
-			tsProp := TSProperty{FieldName: name, Type: tsType, IsOpt: jsonTag.OmitEmpty}
-			m.Properties = append(m.Properties, tsProp)
-			continue
-		}
-
-		// Embedded struct definitions
-		if field.Anonymous {
-			ut := ultimateType(field.Type)
-			reflectType(ut)
-			newType := reflectedTypes[ut]
-			tsTypeName := fmt.Sprintf("%s.%s", newType.Name(), newType.Name())
-			tsType := TSType{StrRepresentation: tsTypeName, Nullable: false}
-			tsProp := TSProperty{FieldName: newType.GoSourceName, Type: tsType, IsOpt: false, IsAnonymous: true}
-			m.Properties = append(m.Properties, tsProp)
-			continue
-		}
-
-		// If we are referencing a struct that isn't inline or anonymous we need to update it too
-		ut := ultimateType(field.Type)
-		if ut.Kind() == reflect.Struct && ut != EmptyStruct {
-			if _, ok := TSInboxTypesMap[field.Type.Kind()]; !ok {
-				reflectType(ut)
-			}
-		}
-		tsProp := TSProperty{FieldName: name, Type: goTypeToTsType(field.Type), IsOpt: jsonTag.OmitEmpty}
-		if replacement, willReplace := fieldsToReplace[t.String()+"."+field.Name]; willReplace {
-			tsProp.Type = replacement
-		}
-		m.Properties = append(m.Properties, tsProp)
-	}
-}
-
-func reflectType(t reflect.Type) {
-	if _, willReplace := typesToReplace[t]; willReplace {
-		return
-	}
+	"github.com/leonitousconforti/the-moby-effect/reflection/schemagen"
+)
 
-	if _, alreadyInserted := reflectedTypes[t]; alreadyInserted {
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "routes" {
+		routes(os.Args[2:])
 		return
 	}
 
-	// Needs to be a struct or something with a name
-	if t.Name() == "" {
-		panic("Unable to reflect a type with no name")
-	}
-
-	activeType := &TSModelType{GoSourceName: t.String()}
-	reflectedTypes[t] = activeType
-	reflectTypeMembers(t, activeType)
-}
+	configFile := flag.String("config", "config.yaml", "generator config file")
+	check := flag.Bool("check", false, "compare the generated files with the files on disk instead of writing them")
+	reportFile := flag.String("report", "", "also write the diagnostics as JSON to this file, - for stdout")
+	flag.Parse()
 
-func main() {
-	cwd, err := os.Getwd()
+	// Load and validate the config before loading any package
+	config, err := schemagen.LoadConfig(*configFile)
 	if err != nil {
-		panic(err)
+		exit(err)
 	}
-
-	// Delete old generated files
-	sourcePath := path.Join(cwd, "..", "src", "internal", "generated")
-	err = os.RemoveAll(sourcePath)
-	if err != nil {
-		panic(err)
+	roots, ok := config.Roots[api.DefaultVersion]
+	if !ok && config.Discover == nil {
+		exit(fmt.Errorf("%s: roots: no root types for API version %s and none to discover", *configFile, api.DefaultVersion))
 	}
 
-	// Make sure the directory exists
-	err = os.MkdirAll(sourcePath, 0755)
-	if err != nil {
-		panic(err)
+	// Every API version gets its own tree, generated from the version of
+	// moby this binary was built against
+	g := schemagen.NewGenerator(api.DefaultVersion)
+	g.BuildFlags = buildFlags
+	g.ApplyConfig(config)
+	g.AddRoots(roots...)
+
+	ok = g.Generate(*check)
+	g.WriteDiagnostics(os.Stderr)
+	if *reportFile != "" {
+		if err := g.WriteDiagnosticsReport(*reportFile); err != nil {
+			exit(err)
+		}
 	}
-
-	// Reflect all types
-	for _, t := range dockerTypesToReflect {
-		reflectType(t)
+	if !ok {
+		os.Exit(1)
 	}
+}
 
-	// Write all reflected types to files
-	for _, v := range reflectedTypes {
-		f, err := os.CreateTemp(sourcePath, "")
-		if err != nil {
-			panic(err)
-		}
-		defer f.Close()
+// exit prints an error that stops a run before anything is generated, and
+// exits with status 1.
+func exit(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
 
-		b := bufio.NewWriter(f)
-		v.WriteClass(b)
-		err = b.Flush()
-		if err != nil {
-			os.Remove(f.Name())
-			panic(err)
-		}
+// routes compares the routes of the daemon routers of the API version with
+// the endpoints of the TS endpoint groups, and writes the coverage report.
+func routes(args []string) {
+	flags := flag.NewFlagSet("routes", flag.ExitOnError)
+	configFile := flags.String("config", "config.yaml", "generator config file")
+	reportFile := flags.String("report", "-", "file to write the coverage report to as JSON, - for stdout")
+	flags.Parse(args)
 
-		f.Close()
-		err = os.Rename(f.Name(), path.Join(sourcePath, v.Name()+".generated.ts"))
-		if err != nil {
-			panic(err)
-		}
-	}
-
-	// Write index.ts file
-	f, err := os.CreateTemp(sourcePath, "")
+	config, err := schemagen.LoadConfig(*configFile)
 	if err != nil {
-		panic(err)
+		exit(err)
 	}
-	defer f.Close()
-	b := bufio.NewWriter(f)
-	for _, z := range reflectedTypes {
-		fmt.Fprintln(b, "export * from \"./"+z.Name()+".generated.ts\";")
+	if config.Routes == nil {
+		exit(fmt.Errorf("%s: routes: must be set to compare the routes with the endpoints", *configFile))
 	}
-	err = b.Flush()
-	if err != nil {
-		os.Remove(f.Name())
-		panic(err)
+
+	g := schemagen.NewGenerator(api.DefaultVersion)
+	g.BuildFlags = buildFlags
+	g.ApplyConfig(config)
+	report := g.RouteCoverage(*config.Routes)
+	if err := schemagen.WriteCoverageReport(*reportFile, report); err != nil {
+		exit(err)
 	}
-	f.Close()
-	err = os.Rename(f.Name(), path.Join(sourcePath, "index.ts"))
-	if err != nil {
-		panic(err)
+	g.WriteDiagnostics(os.Stderr)
+	for _, d := range g.Diagnostics() {
+		if d.Severity == schemagen.SeverityError {
+			os.Exit(1)
+		}
 	}
 }
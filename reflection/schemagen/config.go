@@ -0,0 +1,394 @@
+package schemagen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the generator policy read from a config file like config.yaml,
+// and registered with ApplyConfig. Types are named by their import path and
+// type name, like "github.com/docker/docker/api/types/container.Summary", and
+// fields by the type name followed by the Go field name.
+type Config struct {
+	// Output is the directory the versioned trees are generated into,
+	// relative to the config file.
+	Output string `yaml:"output"`
+
+	// Format is the command that formats generated files, run with the
+	// paths to format appended.
+	Format []string `yaml:"format"`
+
+	// Imports are the namespaces generated schemas may use, in the order
+	// they are imported.
+	Imports []ImportConfig `yaml:"imports"`
+
+	// Roots are the types to generate per API version, along with every type
+	// they reference.
+	Roots map[string][]string `yaml:"roots"`
+
+	// Discover finds more roots in the router backends of the API version,
+	// see DiscoveryConfig.
+	Discover *DiscoveryConfig `yaml:"discover"`
+
+	// Routes configures the route coverage report, see RoutesConfig.
+	Routes *RoutesConfig `yaml:"routes"`
+
+	// Queries generates the query parameters of the router handlers, see
+	// QueriesConfig.
+	Queries *QueriesConfig `yaml:"queries"`
+
+	Renames        map[string]string         `yaml:"renames"`
+	PackageAliases map[string]string         `yaml:"packageAliases"`
+	TypeOverrides  map[string]SchemaOverride `yaml:"typeOverrides"`
+	FieldOverrides map[string]SchemaOverride `yaml:"fieldOverrides"`
+	InlineStructs  []string                  `yaml:"inlineStructs"`
+
+	// FieldDefaults are the values the constructors of generated classes
+	// give the fields they are not passed, as TS expressions, like the
+	// defaults of the daemon.
+	FieldDefaults map[string]string `yaml:"fieldDefaults"`
+
+	// Swagger is the api spec fields are annotated from, named by the import
+	// path of its package and its file name. SwaggerDefinitions names the
+	// definitions of types whose generated and Go names are different.
+	Swagger            string            `yaml:"swagger"`
+	SwaggerDefinitions map[string]string `yaml:"swaggerDefinitions"`
+
+	// UntypedEnums lists the constants of types whose constants are declared
+	// untyped, in order.
+	UntypedEnums map[string][]string `yaml:"untypedEnums"`
+
+	// Diagnostics changes the severity of diagnostic codes to error, warning
+	// or off.
+	Diagnostics map[string]Severity `yaml:"diagnostics"`
+
+	// Mixins extend the generated class of a type with hand-written members,
+	// see extensions.go.
+	Mixins map[string][]MixinConfig `yaml:"mixins"`
+}
+
+type ImportConfig struct {
+	Namespace string `yaml:"namespace"`
+	From      string `yaml:"from"`
+}
+
+// MixinConfig names a function exported by a module that takes a generated
+// class and returns a class extending it.
+type MixinConfig struct {
+	From   string `yaml:"from"`
+	Export string `yaml:"export"`
+}
+
+// SchemaOverride replaces the generated schema of a type or field, with
+// either a schema expression or the schema of a Go kind like "int64".
+type SchemaOverride struct {
+	Schema   string `yaml:"schema"`
+	Kind     string `yaml:"kind"`
+	Nullable bool   `yaml:"nullable"`
+}
+
+// LoadConfig reads and validates a config file, every problem found is
+// reported at once.
+func LoadConfig(file string) (*Config, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var config Config
+	decoder := yaml.NewDecoder(bytes.NewReader(b))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	if !filepath.IsAbs(config.Output) {
+		config.Output = filepath.Join(filepath.Dir(file), config.Output)
+	}
+	if config.Discover != nil && config.Discover.Lockfile != "" && !filepath.IsAbs(config.Discover.Lockfile) {
+		config.Discover.Lockfile = filepath.Join(filepath.Dir(file), config.Discover.Lockfile)
+	}
+	if config.Routes != nil && config.Routes.Endpoints != "" && !filepath.IsAbs(config.Routes.Endpoints) {
+		config.Routes.Endpoints = filepath.Join(filepath.Dir(file), config.Routes.Endpoints)
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return &config, nil
+}
+
+func (c *Config) validate() error {
+	var errs []error
+	report := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if c.Output == "" {
+		report("output: must be set")
+	}
+
+	namespaces := map[string]bool{}
+	for i, imp := range c.Imports {
+		switch {
+		case !identifierRegexp.MatchString(imp.Namespace):
+			report("imports[%d]: namespace %q is not a TS identifier", i, imp.Namespace)
+		case namespaces[imp.Namespace]:
+			report("imports[%d]: namespace %q is imported twice", i, imp.Namespace)
+		case imp.From == "":
+			report("imports[%d]: %s has no module to import from", i, imp.Namespace)
+		}
+		namespaces[imp.Namespace] = true
+	}
+
+	for version, roots := range c.Roots {
+		seen := map[string]bool{}
+		for _, root := range roots {
+			if !isGoTypePath(root) {
+				report("roots[%s]: %q is not an import path and type name", version, root)
+			} else if seen[root] {
+				report("roots[%s]: %q is listed twice", version, root)
+			}
+			seen[root] = true
+		}
+	}
+
+	if d := c.Discover; d != nil {
+		if d.Routers == "" {
+			report("discover: routers must be set")
+		}
+		if len(d.Packages) == 0 {
+			report("discover: packages must list the API packages")
+		}
+		for table, patterns := range map[string][]string{"deny": d.Deny, "allow": d.Allow} {
+			for _, pattern := range patterns {
+				_, namePattern, _ := cutLast(pattern, ".")
+				if _, err := path.Match(namePattern, ""); err != nil || !isGoTypePath(pattern) {
+					report("discover: %s: %q is not a package pattern followed by a type name pattern", table, pattern)
+				}
+			}
+		}
+	}
+
+	if r := c.Routes; r != nil {
+		if r.Routers == "" {
+			report("routes: routers must be set")
+		}
+		if r.Endpoints == "" {
+			report("routes: endpoints must be set")
+		}
+		for _, entry := range r.Ignore {
+			method, path, _ := strings.Cut(entry, " ")
+			if _, ok := httpMethods[method]; !ok || !strings.HasPrefix(path, "/") {
+				report("routes: ignore: %q is not a method followed by a path", entry)
+			}
+		}
+	}
+
+	if q := c.Queries; q != nil && q.Routers == "" {
+		report("queries: routers must be set")
+	}
+
+	for goType, name := range c.Renames {
+		if !isGoTypePath(goType) {
+			report("renames: %q is not an import path and type name", goType)
+		}
+		if !identifierRegexp.MatchString(name) {
+			report("renames[%s]: %q is not a TS identifier", goType, name)
+		}
+	}
+
+	for pkgPath, alias := range c.PackageAliases {
+		if !identifierRegexp.MatchString(alias) {
+			report("packageAliases[%s]: %q is not a TS identifier", pkgPath, alias)
+		}
+	}
+
+	checkOverride := func(table string, key string, o SchemaOverride) {
+		switch {
+		case o.Schema == "" && o.Kind == "":
+			report("%s[%s]: one of schema or kind must be set", table, key)
+		case o.Schema != "" && o.Kind != "":
+			report("%s[%s]: only one of schema or kind can be set", table, key)
+		case o.Kind != "":
+			if _, ok := kindsByName[o.Kind]; !ok {
+				report("%s[%s]: unknown kind %q", table, key, o.Kind)
+			}
+		default:
+			namespace, _, _ := strings.Cut(o.Schema, ".")
+			if !namespaces[namespace] {
+				report("%s[%s]: schema %q uses namespace %q which is not in imports", table, key, o.Schema, namespace)
+			}
+		}
+	}
+	for goType, o := range c.TypeOverrides {
+		if !isGoTypePath(goType) {
+			report("typeOverrides: %q is not an import path and type name", goType)
+		}
+		checkOverride("typeOverrides", goType, o)
+	}
+	for goField, o := range c.FieldOverrides {
+		if goType, _, ok := cutLast(goField, "."); !ok || !isGoTypePath(goType) {
+			report("fieldOverrides: %q is not an import path, type and field name", goField)
+		}
+		checkOverride("fieldOverrides", goField, o)
+	}
+	for _, goField := range c.InlineStructs {
+		if goType, _, ok := cutLast(goField, "."); !ok || !isGoTypePath(goType) {
+			report("inlineStructs: %q is not an import path, type and field name", goField)
+		}
+	}
+	for goField, value := range c.FieldDefaults {
+		if goType, _, ok := cutLast(goField, "."); !ok || !isGoTypePath(goType) {
+			report("fieldDefaults: %q is not an import path, type and field name", goField)
+		}
+		if strings.TrimSpace(value) == "" {
+			report("fieldDefaults[%s]: must be a TS expression", goField)
+		}
+	}
+
+	for goType, mixins := range c.Mixins {
+		if !isGoTypePath(goType) {
+			report("mixins: %q is not an import path and type name", goType)
+		}
+		for i, mixin := range mixins {
+			if mixin.From == "" {
+				report("mixins[%s][%d]: has no module to import from", goType, i)
+			}
+			if !identifierRegexp.MatchString(mixin.Export) {
+				report("mixins[%s][%d]: export %q is not a TS identifier", goType, i, mixin.Export)
+			}
+		}
+	}
+
+	if c.Swagger != "" && !strings.Contains(c.Swagger, "/") {
+		report("swagger: %q is not an import path and file name", c.Swagger)
+	}
+	for goType, definition := range c.SwaggerDefinitions {
+		if !isGoTypePath(goType) {
+			report("swaggerDefinitions: %q is not an import path and type name", goType)
+		}
+		if definition == "" {
+			report("swaggerDefinitions[%s]: must name a definition", goType)
+		}
+	}
+	for goType, constants := range c.UntypedEnums {
+		if !isGoTypePath(goType) {
+			report("untypedEnums: %q is not an import path and type name", goType)
+		}
+		if len(constants) == 0 {
+			report("untypedEnums[%s]: must list constants", goType)
+		}
+	}
+
+	for code, severity := range c.Diagnostics {
+		if _, ok := diagnosticCodes[code]; !ok {
+			report("diagnostics: unknown code %q", code)
+		}
+		switch severity {
+		case SeverityError, SeverityWarning, SeverityOff:
+		default:
+			report("diagnostics[%s]: severity %q is not error, warning or off", code, severity)
+		}
+	}
+
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+	return errors.Join(errs...)
+}
+
+// isGoTypePath reports whether s looks like an import path followed by a
+// type name, like "time.Duration".
+func isGoTypePath(s string) bool {
+	pkgPath, name, ok := cutLast(s, ".")
+	return ok && pkgPath != "" && name != "" && !strings.HasSuffix(pkgPath, "/")
+}
+
+func cutLast(s string, sep string) (string, string, bool) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return s, "", false
+	}
+	return s[:i], s[i+len(sep):], true
+}
+
+var kindsByName = func() map[string]reflect.Kind {
+	kinds := map[string]reflect.Kind{}
+	for kind := range TSInboxTypesMap {
+		kinds[kind.String()] = kind
+	}
+	return kinds
+}()
+
+func (o SchemaOverride) tsType() TSType {
+	if o.Kind != "" {
+		t := TSInboxTypesMap[kindsByName[o.Kind]]
+		t.Nullable = t.Nullable || o.Nullable
+		return t
+	}
+	return TSType{StrRepresentation: o.Schema, Nullable: o.Nullable}
+}
+
+// ApplyConfig registers everything a config sets with the generator, after
+// what is already registered.
+func (g *Generator) ApplyConfig(c *Config) {
+	g.Output = c.Output
+	g.Format = c.Format
+	g.SwaggerFile = c.Swagger
+	if c.Discover != nil {
+		g.DiscoverRoots(*c.Discover)
+	}
+	if c.Queries != nil {
+		g.GenerateQueries(*c.Queries)
+	}
+	for _, imp := range c.Imports {
+		g.Import(imp.Namespace, imp.From)
+	}
+	for goType, name := range c.Renames {
+		g.Rename(goType, name)
+	}
+	for pkgPath, alias := range c.PackageAliases {
+		g.AliasPackage(pkgPath, alias)
+	}
+	for goType, o := range c.TypeOverrides {
+		g.OverrideType(goType, o)
+	}
+	for goField, o := range c.FieldOverrides {
+		g.OverrideField(goField, o)
+	}
+	for _, goField := range c.InlineStructs {
+		g.InlineStruct(goField)
+	}
+	for goField, value := range c.FieldDefaults {
+		g.DefaultField(goField, value)
+	}
+	for goType, definition := range c.SwaggerDefinitions {
+		g.NameSwaggerDefinition(goType, definition)
+	}
+	for goType, constants := range c.UntypedEnums {
+		g.DeclareEnumConstants(goType, constants...)
+	}
+	for code, severity := range c.Diagnostics {
+		g.SetSeverity(code, severity)
+	}
+	for goType, mixins := range c.Mixins {
+		for _, mixin := range mixins {
+			g.AddMixin(goType, mixin)
+		}
+	}
+}
+
+// goTypePath names a reflected type by its import path and source name, with
+// the package name of the source name replaced by the import path.
+func goTypePath(goPkgPath string, goSourceName string) string {
+	_, name, _ := strings.Cut(goSourceName, ".")
+	return goPkgPath + "." + name
+}
@@ -0,0 +1,136 @@
+package schemagen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// referencedModels returns the generated types a schema expression refers
+// to. A reference to another generated type looks like `Foo.Foo`, which
+// tokenizes to two adjacent identical identifiers.
+func referencedModels(src string) []string {
+	parts := strings.FieldsFunc(src, func(r rune) bool {
+		return !isIdentifierChar(byte(r)) || r > 127
+	})
+
+	var names []string
+	for i := 0; i+1 < len(parts); i++ {
+		if parts[i] == parts[i+1] &&
+			identifierRegexp.MatchString(parts[i]) &&
+			strings.Contains(src, parts[i]+"."+parts[i+1]) {
+			names = append(names, parts[i])
+		}
+	}
+	return names
+}
+
+// stronglyConnectedModels groups the generated types into the strongly
+// connected components of their reference graph (Tarjan's algorithm), and
+// returns the component of every type.
+func stronglyConnectedModels(models []*TSModelType) map[string]int {
+	edges := map[string][]string{}
+	for _, m := range models {
+		for _, p := range m.Properties {
+			edges[m.Name()] = append(edges[m.Name()], referencedModels(p.Type.StrRepresentation)...)
+		}
+	}
+
+	component := map[string]int{}
+	index := map[string]int{}
+	lowLink := map[string]int{}
+	onStack := map[string]bool{}
+	var stack []string
+	var components int
+
+	var connect func(name string)
+	connect = func(name string) {
+		index[name] = len(index)
+		lowLink[name] = index[name]
+		stack = append(stack, name)
+		onStack[name] = true
+
+		for _, next := range edges[name] {
+			if _, visited := index[next]; !visited {
+				connect(next)
+				lowLink[name] = min(lowLink[name], lowLink[next])
+			} else if onStack[next] {
+				lowLink[name] = min(lowLink[name], index[next])
+			}
+		}
+
+		if lowLink[name] == index[name] {
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				component[top] = components
+				if top == name {
+					break
+				}
+			}
+			components++
+		}
+	}
+
+	for _, m := range models {
+		if _, visited := index[m.Name()]; !visited {
+			connect(m.Name())
+		}
+	}
+	return component
+}
+
+// suspendCycles defers every reference between types of the same strongly
+// connected component with Schema.suspend, so that mutually recursive modules
+// never read each other's classes while they are being evaluated. The thunks
+// are annotated because TypeScript can not infer a recursive type, and a type
+// referring to itself uses its own class rather than importing its module.
+func suspendCycles(models []*TSModelType) {
+	sort.Slice(models, func(i, j int) bool { return models[i].Name() < models[j].Name() })
+	component := stronglyConnectedModels(models)
+
+	for _, m := range models {
+		for i, p := range m.Properties {
+			suspended := map[string]bool{}
+			for _, name := range referencedModels(p.Type.StrRepresentation) {
+				c, isModel := component[name]
+				if suspended[name] || !isModel || c != component[m.Name()] {
+					continue
+				}
+				suspended[name] = true
+
+				target := name + "." + name
+				if name == m.Name() {
+					target = name
+				}
+				replacement := fmt.Sprintf("Schema.suspend((): Schema.Codec<%s, unknown> => %s)", target, target)
+				m.Properties[i].Type.StrRepresentation = replaceReference(m.Properties[i].Type.StrRepresentation, name+"."+name, replacement)
+			}
+		}
+	}
+}
+
+// replaceReference replaces every occurrence of reference in src that is not
+// part of a longer qualified identifier.
+func replaceReference(src string, reference string, replacement string) string {
+	var out strings.Builder
+	for {
+		idx := strings.Index(src, reference)
+		if idx == -1 {
+			out.WriteString(src)
+			return out.String()
+		}
+
+		end := idx + len(reference)
+		before := idx > 0 && (isIdentifierChar(src[idx-1]) || src[idx-1] == '.')
+		after := end < len(src) && isIdentifierChar(src[end])
+		out.WriteString(src[:idx])
+		if before || after {
+			out.WriteString(reference)
+		} else {
+			out.WriteString(replacement)
+		}
+		src = src[end:]
+	}
+}
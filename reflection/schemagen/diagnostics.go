@@ -0,0 +1,184 @@
+package schemagen
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// Severity is how a diagnostic affects a run, any error stops the generated
+// files from being written.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityOff     Severity = "off"
+)
+
+// diagnosticCodes are the problems the generator reports, with the severity
+// they have unless the config says otherwise.
+var diagnosticCodes = map[string]Severity{
+	"anonymous-struct":  SeverityError,   // an anonymous struct was used before it was reflected
+	"format-failed":     SeverityError,   // the formatter failed on the staged tree
+	"invalid-lockfile":  SeverityError,   // the lockfile of the discovered roots can not be read
+	"json-key-conflict": SeverityWarning, // fields sharing a JSON key were dropped
+	"load-error":        SeverityError,   // a package can not be loaded or type checked
+	"merged-query":      SeverityWarning, // a handler serves several routes, whose query parameters share one schema
+	"missing-docs":      SeverityWarning, // a type has no documentation to link to
+	"missing-swagger":   SeverityError,   // the api spec can not be read
+	"name-collision":    SeverityError,   // two types would share a TS identifier or file
+	"new-root":          SeverityWarning, // a backend uses a type the lockfile has no root for
+	"no-enum-literals":  SeverityWarning, // a named string type has no declared constants
+	"read-failed":       SeverityError,   // a file the run reads from disk can not be read
+	"unknown-endpoint":  SeverityWarning, // a TS endpoint group adds an endpoint its file does not declare
+	"unknown-root":      SeverityError,   // a root type is not declared by the loaded packages
+	"unnamed-type":      SeverityError,   // a type without a name can not be generated
+	"unresolved-query":  SeverityWarning, // a handler reads a query parameter whose name is not a literal
+	"unresolved-route":  SeverityWarning, // a route is registered with a method or path that is not a literal
+	"unsupported-kind":  SeverityError,   // a Go kind has no schema
+	"vanished-root":     SeverityWarning, // a root in the lockfile is no longer used by any backend
+	"write-failed":      SeverityError,   // a generated file can not be staged, swapped into place or written
+}
+
+// Diagnostic is a problem found while generating, located by the Go type,
+// as import path and type name, and the Go field it was found in. Source is
+// the file and line that type or field is declared at, when known.
+type Diagnostic struct {
+	Code     string   `json:"code"`
+	Severity Severity `json:"severity"`
+	Type     string   `json:"type,omitempty"`
+	Field    string   `json:"field,omitempty"`
+	Source   string   `json:"source,omitempty"`
+	Message  string   `json:"message"`
+}
+
+func (d Diagnostic) String() string {
+	location := d.Type
+	if d.Field != "" {
+		location += "." + d.Field
+	}
+	if location != "" {
+		location += ": "
+	}
+	message := d.Message
+	if d.Source != "" {
+		message += " (" + d.Source + ")"
+	}
+	return fmt.Sprintf("%s[%s] %s%s", d.Severity, d.Code, location, message)
+}
+
+// at moves the position to a Go type and field declared at source, and
+// returns the function that moves it back.
+func (g *Generator) at(goType string, field string, source string) func() {
+	saved := g.position
+	g.position.Type, g.position.Field, g.position.Source = goType, field, source
+	return func() { g.position = saved }
+}
+
+// report records a diagnostic at the current position.
+func (g *Generator) report(code string, format string, args ...any) {
+	g.record(code, g.position.Type, g.position.Field, g.position.Source, fmt.Sprintf(format, args...))
+}
+
+// reportAt records a diagnostic of a Go type and field.
+func (g *Generator) reportAt(code string, goType string, field string, format string, args ...any) {
+	g.record(code, goType, field, "", fmt.Sprintf(format, args...))
+}
+
+// record adds a diagnostic with the severity the config gives its code.
+// Diagnostics that are switched off are dropped, and each is only recorded
+// once.
+func (g *Generator) record(code string, goType string, field string, source string, message string) {
+	severity, ok := diagnosticCodes[code]
+	if !ok {
+		panic(fmt.Errorf("unknown diagnostic code %q", code))
+	}
+	if configured, ok := g.severities[code]; ok {
+		severity = configured
+	}
+	if severity == SeverityOff {
+		return
+	}
+
+	d := Diagnostic{Code: code, Severity: severity, Type: goType, Field: field, Source: source, Message: message}
+	for _, other := range g.diagnostics {
+		if other == d {
+			return
+		}
+	}
+	g.diagnostics = append(g.diagnostics, d)
+}
+
+// Diagnostics returns the diagnostics reported so far, ordered by location,
+// then code and message.
+func (g *Generator) Diagnostics() []Diagnostic {
+	sorted := append([]Diagnostic{}, g.diagnostics...)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Field != b.Field {
+			return a.Field < b.Field
+		}
+		if a.Code != b.Code {
+			return a.Code < b.Code
+		}
+		return a.Message < b.Message
+	})
+	return sorted
+}
+
+func (g *Generator) countDiagnostics() (errors int, warnings int) {
+	for _, d := range g.diagnostics {
+		if d.Severity == SeverityError {
+			errors++
+		} else {
+			warnings++
+		}
+	}
+	return errors, warnings
+}
+
+// WriteDiagnostics prints every diagnostic followed by a count of each
+// severity.
+func (g *Generator) WriteDiagnostics(w io.Writer) {
+	if len(g.diagnostics) == 0 {
+		return
+	}
+	for _, d := range g.Diagnostics() {
+		fmt.Fprintln(w, d)
+	}
+	errors, warnings := g.countDiagnostics()
+	fmt.Fprintf(w, "%d errors, %d warnings\n", errors, warnings)
+}
+
+// WriteDiagnosticsReport writes every diagnostic as a JSON report for tools,
+// to a file or to stdout when it is "-".
+func (g *Generator) WriteDiagnosticsReport(file string) error {
+	errors, warnings := g.countDiagnostics()
+	report := struct {
+		Errors      int          `json:"errors"`
+		Warnings    int          `json:"warnings"`
+		Diagnostics []Diagnostic `json:"diagnostics"`
+	}{errors, warnings, g.Diagnostics()}
+	return writeJSONReport(file, report)
+}
+
+// writeJSONReport writes a report as indented JSON, to a file or to stdout
+// when it is "-".
+func writeJSONReport(file string, report any) error {
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	if file == "-" {
+		_, err = os.Stdout.Write(b)
+		return err
+	}
+	return os.WriteFile(file, b, 0644)
+}
@@ -0,0 +1,118 @@
+package schemagen
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around every change.
+const diffContext = 3
+
+// unifiedDiff returns the line based unified diff from a to b, empty when
+// they are equal. A file that does not exist is named /dev/null. Lines are
+// compared with their line break, so a last line without one differs from the
+// same line with one, and is marked the way diff does.
+func unifiedDiff(aName string, a []byte, bName string, b []byte) string {
+	if bytes.Equal(a, b) {
+		return ""
+	}
+	aLines, bLines := splitLines(a), splitLines(b)
+
+	// Longest common subsequence of the lines, lcs[i][j] is the length for
+	// aLines[i:] and bLines[j:]
+	lcs := make([][]int, len(aLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(bLines)+1)
+	}
+	for i := len(aLines) - 1; i >= 0; i-- {
+		for j := len(bLines) - 1; j >= 0; j-- {
+			if aLines[i] == bLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	type edit struct {
+		op   byte
+		line string
+		a, b int
+	}
+	var edits []edit
+	i, j := 0, 0
+	for i < len(aLines) || j < len(bLines) {
+		switch {
+		case i < len(aLines) && j < len(bLines) && aLines[i] == bLines[j]:
+			edits = append(edits, edit{' ', aLines[i], i, j})
+			i++
+			j++
+		case i < len(aLines) && (j == len(bLines) || lcs[i+1][j] >= lcs[i][j+1]):
+			edits = append(edits, edit{'-', aLines[i], i, j})
+			i++
+		default:
+			edits = append(edits, edit{'+', bLines[j], i, j})
+			j++
+		}
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", aName, bName)
+	for start := 0; start < len(edits); {
+		if edits[start].op == ' ' {
+			start++
+			continue
+		}
+
+		// A hunk spans changes that are at most two contexts apart
+		first := max(start-diffContext, 0)
+		end := start
+		for k := start; k < len(edits); k++ {
+			if edits[k].op != ' ' {
+				end = k
+			} else if k-end > 2*diffContext {
+				break
+			}
+		}
+		last := min(end+diffContext, len(edits)-1)
+
+		aCount, bCount := 0, 0
+		for _, e := range edits[first : last+1] {
+			if e.op != '+' {
+				aCount++
+			}
+			if e.op != '-' {
+				bCount++
+			}
+		}
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(edits[first].a, aCount), hunkRange(edits[first].b, bCount))
+		for _, e := range edits[first : last+1] {
+			fmt.Fprintf(&out, "%c%s", e.op, e.line)
+			if !strings.HasSuffix(e.line, "\n") {
+				out.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		start = last + 1
+	}
+	return out.String()
+}
+
+func hunkRange(start int, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// splitLines splits a file into its lines, each with its line break.
+func splitLines(b []byte) []string {
+	if len(b) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(b), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
@@ -0,0 +1,355 @@
+package schemagen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+	"gopkg.in/yaml.v3"
+)
+
+// DiscoveryConfig finds root types in the method signatures of the backend
+// interfaces of the daemon routers, which are every type the API takes or
+// returns. Patterns name packages by import path, where a trailing "/..."
+// also matches the packages under it, and types by a package pattern
+// followed by a path.Match pattern of the type name, like
+// "github.com/docker/docker/api/types/backend.*".
+type DiscoveryConfig struct {
+	// Routers is the go package pattern of the router packages, like
+	// "github.com/docker/docker/api/server/router/...".
+	Routers string `yaml:"routers"`
+
+	// Packages are the packages declaring API types, only their types become
+	// roots.
+	Packages []string `yaml:"packages"`
+
+	// Deny are types that never become roots, like the daemon internal types
+	// of the backend package, unless they also match Allow.
+	Deny  []string `yaml:"deny"`
+	Allow []string `yaml:"allow"`
+
+	// Lockfile records the roots discovered for every API version, so the
+	// ones that appear or vanish with a new moby version are reported. It is
+	// relative to the config file, and rewritten by every run that writes
+	// the generated tree.
+	Lockfile string `yaml:"lockfile"`
+}
+
+// discoveredRoot is a type found in a backend interface, located by the
+// first method using it.
+type discoveredRoot struct {
+	method string
+	source string
+}
+
+// backendInterface is an interface declared by a router package, along with
+// the file declaring it for the imports of its method signatures.
+type backendInterface struct {
+	iface *ast.InterfaceType
+	file  *ast.File
+}
+
+// DiscoverRoots registers the routers whose backend interfaces the root types
+// are discovered from, in addition to the roots added by AddRoots.
+func (g *Generator) DiscoverRoots(d DiscoveryConfig) {
+	g.discovery = &d
+}
+
+// routerPackage is a router package, parsed with the bodies of its functions.
+type routerPackage struct {
+	pkgPath string
+	files   []*ast.File
+}
+
+// parseRouters parses the packages matching a pattern of router packages,
+// ordered by import path. The routers import most of the daemon, which is not
+// required by this module, so they are only parsed rather than type checked.
+// Each pattern is parsed once per run.
+func (g *Generator) parseRouters(pattern string) []routerPackage {
+	if routers, ok := g.routers[pattern]; ok {
+		return routers
+	}
+
+	config := &packages.Config{Mode: packages.NeedName | packages.NeedFiles, Dir: g.Dir, BuildFlags: g.BuildFlags}
+	loaded, err := packages.Load(config, pattern)
+	if err != nil {
+		g.reportAt("load-error", "", "", "%v", err)
+		return nil
+	}
+	sort.Slice(loaded, func(i, j int) bool { return loaded[i].PkgPath < loaded[j].PkgPath })
+
+	var routers []routerPackage
+	for _, p := range loaded {
+		for _, err := range p.Errors {
+			g.reportAt("load-error", p.PkgPath, "", "%v", err)
+		}
+		router := routerPackage{pkgPath: p.PkgPath}
+		for _, filename := range p.GoFiles {
+			f, err := parser.ParseFile(g.fset, filename, nil, parser.SkipObjectResolution)
+			if err != nil {
+				g.reportAt("load-error", p.PkgPath, "", "%v", err)
+				continue
+			}
+			router.files = append(router.files, f)
+		}
+		routers = append(routers, router)
+	}
+	g.routers[pattern] = routers
+	return routers
+}
+
+// discoverRoots finds the types of the API packages used by the methods of
+// the exported interfaces of the router packages whose name ends in Backend,
+// and of the interfaces of the same package they embed. The types are
+// resolved through the imports of the files declaring the methods, with the
+// names of the imported packages looked up without loading them.
+func (g *Generator) discoverRoots() map[string]discoveredRoot {
+	routers := g.parseRouters(g.discovery.Routers)
+	importPaths := map[string]bool{}
+	for _, p := range routers {
+		for _, f := range p.files {
+			for _, spec := range f.Imports {
+				importPath, _ := strconv.Unquote(spec.Path.Value)
+				importPaths[importPath] = true
+			}
+		}
+	}
+	packageNames := g.packageNames(importPaths)
+
+	discovered := map[string]discoveredRoot{}
+	for _, p := range routers {
+		interfaces := map[string]backendInterface{}
+		for _, f := range p.files {
+			for _, decl := range f.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					ts := spec.(*ast.TypeSpec)
+					if iface, ok := ts.Type.(*ast.InterfaceType); ok {
+						interfaces[ts.Name.Name] = backendInterface{iface, f}
+					}
+				}
+			}
+		}
+
+		names := make([]string, 0, len(interfaces))
+		for name := range interfaces {
+			if token.IsExported(name) && strings.HasSuffix(name, "Backend") {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		// Embedded interfaces are walked once, from the first backend
+		// embedding them
+		walked := map[string]bool{}
+		var walkInterface func(name string)
+		walkInterface = func(name string) {
+			b, ok := interfaces[name]
+			if !ok || walked[name] {
+				return
+			}
+			walked[name] = true
+			imports := fileImports(b.file, packageNames)
+			for _, method := range b.iface.Methods.List {
+				if len(method.Names) == 0 {
+					if embedded, ok := method.Type.(*ast.Ident); ok {
+						walkInterface(embedded.Name)
+					}
+					continue
+				}
+				location := path.Base(p.pkgPath) + "." + name + "." + method.Names[0].Name
+				ast.Inspect(method.Type, func(n ast.Node) bool {
+					sel, ok := n.(*ast.SelectorExpr)
+					if !ok {
+						return true
+					}
+					if x, ok := sel.X.(*ast.Ident); ok {
+						goType := imports[x.Name] + "." + sel.Sel.Name
+						if _, seen := discovered[goType]; !seen && imports[x.Name] != "" && g.discoverable(goType) {
+							discovered[goType] = discoveredRoot{location, g.sourceAt(p.pkgPath, sel.Pos())}
+						}
+					}
+					return false
+				})
+			}
+		}
+		for _, name := range names {
+			walkInterface(name)
+		}
+	}
+	return discovered
+}
+
+// packageNames looks up the package names of import paths. Packages the go
+// command can not find, because their module is not required, are named by
+// the last element of their path, which is never an API package anyway.
+func (g *Generator) packageNames(importPaths map[string]bool) map[string]string {
+	names := map[string]string{}
+	patterns := make([]string, 0, len(importPaths))
+	for importPath := range importPaths {
+		names[importPath] = path.Base(importPath)
+		patterns = append(patterns, importPath)
+	}
+	if len(patterns) == 0 {
+		return names
+	}
+	sort.Strings(patterns)
+
+	config := &packages.Config{Mode: packages.NeedName, Dir: g.Dir, BuildFlags: g.BuildFlags}
+	loaded, err := packages.Load(config, patterns...)
+	if err != nil {
+		g.reportAt("load-error", "", "", "%v", err)
+		return names
+	}
+	for _, p := range loaded {
+		if p.Name != "" {
+			names[p.PkgPath] = p.Name
+		}
+	}
+	return names
+}
+
+// fileImports maps the names a file refers to its imports by to their import
+// paths.
+func fileImports(f *ast.File, packageNames map[string]string) map[string]string {
+	imports := map[string]string{}
+	for _, spec := range f.Imports {
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		name := packageNames[importPath]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = importPath
+	}
+	return imports
+}
+
+// discoverable reports whether a type used by a backend is in an API package
+// and not denied.
+func (g *Generator) discoverable(goType string) bool {
+	pkgPath, _, _ := cutLast(goType, ".")
+	if !matchAny(g.discovery.Packages, pkgPath, matchPackage) {
+		return false
+	}
+	return !matchAny(g.discovery.Deny, goType, matchType) || matchAny(g.discovery.Allow, goType, matchType)
+}
+
+func matchAny(patterns []string, s string, match func(pattern string, s string) bool) bool {
+	for _, pattern := range patterns {
+		if match(pattern, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPackage reports whether an import path matches a package pattern,
+// where a trailing "/..." matches the package and the packages under it.
+func matchPackage(pattern string, pkgPath string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "/..."); ok {
+		return pkgPath == prefix || strings.HasPrefix(pkgPath, prefix+"/")
+	}
+	return pkgPath == pattern
+}
+
+// matchType reports whether an import path and type name match a package
+// pattern followed by a pattern of the type name.
+func matchType(pattern string, goType string) bool {
+	pkgPattern, namePattern, _ := cutLast(pattern, ".")
+	pkgPath, name, _ := cutLast(goType, ".")
+	matched, _ := path.Match(namePattern, name)
+	return matched && matchPackage(pkgPattern, pkgPath)
+}
+
+// checkDiscovered keeps the discovered roots that are declared structs, and
+// reports the ones that are not declared by the loaded packages, and those
+// that appeared or vanished since the lockfile was written. Generic types are
+// dropped, the instantiations used by a signature are not discovered.
+func (g *Generator) checkDiscovered(discovered map[string]discoveredRoot) []string {
+	var roots []string
+	for goType, found := range discovered {
+		obj, ok := g.lookupType(goType)
+		if !ok {
+			g.record("unknown-root", goType, "", found.source, fmt.Sprintf("discovered from %s but not declared by the loaded packages", found.method))
+			continue
+		}
+		if named, ok := obj.Type().(*types.Named); ok && named.TypeParams().Len() == 0 && kindOf(named) == reflect.Struct {
+			roots = append(roots, goType)
+		}
+	}
+	sort.Strings(roots)
+
+	locked, ok := g.readLockfile()[g.Version]
+	if !ok {
+		return roots
+	}
+	for _, goType := range roots {
+		if !slices.Contains(locked, goType) {
+			found := discovered[goType]
+			g.record("new-root", goType, "", found.source, fmt.Sprintf("discovered from %s, which did not use it before", found.method))
+		}
+	}
+	for _, goType := range locked {
+		if !slices.Contains(roots, goType) {
+			g.reportAt("vanished-root", goType, "", "no longer used by any backend of API version %s", g.Version)
+		}
+	}
+	return roots
+}
+
+// readLockfile reads the roots discovered for every API version, a missing
+// lockfile has none. A lockfile that can not be read or parsed is reported,
+// and read as empty, the error keeps it from being rewritten.
+func (g *Generator) readLockfile() map[string][]string {
+	locked := map[string][]string{}
+	if g.discovery.Lockfile == "" {
+		return locked
+	}
+	b, err := os.ReadFile(g.discovery.Lockfile)
+	if os.IsNotExist(err) {
+		return locked
+	}
+	if err != nil {
+		g.reportAt("read-failed", "", "", "%v", err)
+		return map[string][]string{}
+	}
+	if err := yaml.Unmarshal(b, &locked); err != nil {
+		g.reportAt("invalid-lockfile", "", "", "%s: %v", g.discovery.Lockfile, err)
+		return map[string][]string{}
+	}
+	return locked
+}
+
+// writeLockfile records the roots discovered for the API version, keeping the
+// ones of every other version.
+func (g *Generator) writeLockfile(roots []string) error {
+	if g.discovery.Lockfile == "" {
+		return nil
+	}
+	locked := g.readLockfile()
+	locked[g.Version] = roots
+	b, err := yaml.Marshal(locked)
+	if err != nil {
+		return err
+	}
+	var content bytes.Buffer
+	content.WriteString("# Root types discovered from the router backends of every API version,\n")
+	content.WriteString("# written by the generator. Do not edit.\n")
+	content.Write(b)
+	return writeIfChanged(filepath.Dir(g.discovery.Lockfile), filepath.Base(g.discovery.Lockfile), content.Bytes())
+}
@@ -0,0 +1,35 @@
+package schemagen
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestInvalidLockfile generates the fixtures with a lockfile that is not
+// YAML, and checks that the run fails with a diagnostic naming the lockfile
+// and leaves it as it was.
+func TestInvalidLockfile(t *testing.T) {
+	g, _ := newFixtureGenerator(t, t.TempDir())
+	invalid := []byte("1.0: [shapes.Circle\n")
+	if err := os.WriteFile(g.discovery.Lockfile, invalid, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if g.Generate(false) {
+		t.Fatal("generating with an invalid lockfile succeeded")
+	}
+
+	reported := false
+	for _, d := range g.Diagnostics() {
+		if d.Code == "invalid-lockfile" && d.Severity == SeverityError && strings.Contains(d.Message, g.discovery.Lockfile) {
+			reported = true
+		}
+	}
+	if !reported {
+		t.Errorf("the invalid lockfile was not reported: %v", g.Diagnostics())
+	}
+	if b, err := os.ReadFile(g.discovery.Lockfile); err != nil || !bytes.Equal(b, invalid) {
+		t.Errorf("the invalid lockfile was rewritten: %q, %v", b, err)
+	}
+}
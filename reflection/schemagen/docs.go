@@ -0,0 +1,64 @@
+package schemagen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+)
+
+// generateDocLink builds a pkg.go.dev permalink to a type, pinned to the
+// version of its module the packages were loaded from. Types of the standard
+// library and of the main module, which has no version, are not pinned. Types
+// that can not be found in the package sources are reported and get no link.
+func (g *Generator) generateDocLink(pkgPath string, name string) string {
+	p, ok := g.packages[pkgPath]
+	if !ok {
+		g.reportAt("missing-docs", pkgPath+"."+name, "", "documentation not available: package %s is not loaded", pkgPath)
+		return ""
+	}
+
+	obj, ok := g.lookupType(pkgPath + "." + name)
+	if _, declared := g.typeDecls[obj]; !ok || !declared || !obj.Exported() {
+		g.reportAt("missing-docs", pkgPath+"."+name, "", "no declaration of %s in %s", name, pkgPath)
+		return ""
+	}
+
+	if p.Module == nil || p.Module.Main {
+		return fmt.Sprintf("https://pkg.go.dev/%s#%s", pkgPath, name)
+	}
+	version := p.Module.Version
+	if p.Module.Replace != nil && p.Module.Replace.Version != "" {
+		version = p.Module.Replace.Version
+	}
+	subPath := strings.TrimPrefix(pkgPath, p.Module.Path)
+	return fmt.Sprintf("https://pkg.go.dev/%s@%s%s#%s", p.Module.Path, version, subPath, name)
+}
+
+// typeDescription returns the doc comment of a named type, which go/doc takes
+// from the declaration when the type spec has none.
+func (g *Generator) typeDescription(obj *types.TypeName) string {
+	decl, ok := g.typeDecls[obj]
+	if !ok {
+		return ""
+	}
+	doc := decl.spec.Doc
+	if doc == nil {
+		doc = decl.decl.Doc
+	}
+	return strings.TrimSpace(doc.Text())
+}
+
+// fieldDescription returns the comment describing a field declaration. The
+// trailing line comment wins over the doc comment, because moby groups fields
+// under section comments like "Applicable to all platforms" which go/parser
+// attaches as the doc comment of the first field in the group.
+func fieldDescription(field *ast.Field) string {
+	if field == nil {
+		return ""
+	}
+	if text := strings.TrimSpace(field.Comment.Text()); text != "" {
+		return text
+	}
+	return strings.TrimSpace(field.Doc.Text())
+}
@@ -0,0 +1,72 @@
+package schemagen
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// extensionSuffix names hand-written files that extend the generated class
+// of the same name, like ContainerSummary.ext.ts.
+const extensionSuffix = ".ext.ts"
+
+// TSExtension is a mixin applied to a generated class: a function taking the
+// schema class and returning a class that extends it with hand-written
+// members.
+type TSExtension struct {
+	Import string
+	Mixin  string
+}
+
+// discoverExtensions finds the extensions of a generated class. Mixins
+// registered in the config are applied first, then the Extension export of a
+// sibling <Name>.ext.ts, looked up in the versioned tree and then in the
+// output directory shared by every tree.
+func (g *Generator) discoverExtensions(m *TSModelType) []TSExtension {
+	var extensions []TSExtension
+	for _, mixin := range g.mixins[goTypePath(m.GoPkgPath, m.GoSourceName)] {
+		extensions = append(extensions, TSExtension{
+			Import: fmt.Sprintf("import { %s } from %q;\n", mixin.Export, mixin.From),
+			Mixin:  mixin.Export,
+		})
+	}
+
+	file := m.Name() + extensionSuffix
+	namespace := m.Name() + "Extension"
+	candidates := []struct{ dir, from string }{
+		{path.Join(g.Output, "v"+g.Version), "./" + file},
+		{g.Output, "../" + file},
+	}
+	for _, candidate := range candidates {
+		if _, err := os.Stat(path.Join(candidate.dir, file)); err == nil {
+			extensions = append(extensions, TSExtension{
+				Import: fmt.Sprintf("import * as %s from %q;\n", namespace, candidate.from),
+				Mixin:  namespace + ".Extension",
+			})
+			break
+		}
+	}
+	return extensions
+}
+
+// preserveExtensions copies the hand-written extensions of the versioned tree
+// into a staged tree, so they survive it being swapped into place.
+func (g *Generator) preserveExtensions(stage string) error {
+	files, err := filepath.Glob(path.Join(g.Output, "v"+g.Version, "*"+extensionSuffix))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		err = writeGeneratedFile(stage, path.Base(file), func(w io.Writer) { w.Write(content) })
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
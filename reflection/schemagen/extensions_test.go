@@ -0,0 +1,61 @@
+package schemagen
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// TestExtensions generates the fixtures next to hand-written extensions of
+// Point, in the versioned tree and in the output directory, and of
+// ShapesLayer, in the output directory only. It checks that the classes
+// import the extension found first, from a path that resolves, and that the
+// extensions survive the tree being swapped and checked.
+func TestExtensions(t *testing.T) {
+	output := t.TempDir()
+	extensions := map[string]string{
+		"v1.0/Point.ext.ts":  "export const Extension = <A>(base: A) => base;\n",
+		"Point.ext.ts":       "export const Extension = <A>(base: A) => base; // shadowed\n",
+		"ShapesLayer.ext.ts": "export const Extension = <A>(base: A) => base; // shared\n",
+	}
+	for file, content := range extensions {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(output, file)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(output, file), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, check := range []bool{false, false, true} {
+		g, _ := newFixtureGenerator(t, output)
+		if !g.Generate(check) {
+			t.Fatalf("generating the fixtures with check %v failed: %v", check, g.Diagnostics())
+		}
+	}
+
+	tree := readTree(t, output)
+	for file, content := range extensions {
+		if tree[file] != content {
+			t.Errorf("%s is %q after generating, want %q", file, tree[file], content)
+		}
+	}
+
+	importRegexp := regexp.MustCompile(`import \* as (\w+) from "([^"]*\.ext\.ts)";`)
+	for class, want := range map[string]string{"Point": "./Point.ext.ts", "ShapesLayer": "../ShapesLayer.ext.ts"} {
+		generated := tree["v1.0/"+class+".generated.ts"]
+		m := importRegexp.FindStringSubmatch(generated)
+		if m == nil || m[1] != class+"Extension" || m[2] != want {
+			t.Errorf("%s imports its extension as %q, want %s from %q:\n%s", class, m, class+"Extension", want, generated)
+			continue
+		}
+		if _, ok := tree[filepath.ToSlash(filepath.Join("v1.0", m[2]))]; !ok {
+			t.Errorf("%s imports %s, which does not resolve", class, m[2])
+		}
+		if !strings.Contains(generated, "extends "+class+"Extension.Extension(Schema.Class<") {
+			t.Errorf("%s does not apply its extension:\n%s", class, generated)
+		}
+	}
+}
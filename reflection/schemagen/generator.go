@@ -0,0 +1,313 @@
+// Package schemagen generates Effect schemas for Go types, loaded and type
+// checked from their sources, with the shape encoding/json gives them on the
+// wire.
+package schemagen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+	"path"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/docker/docker/api/types/versions"
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// Generator reflects Go types into a versioned tree of Effect schemas. Its
+// registrations say which types are generated and how, and it holds every
+// type reflected so far, so a generator is used for one tree only.
+type Generator struct {
+	// Output is the directory the versioned trees are generated into.
+	Output string
+
+	// Format is the command that formats generated files, run with the paths
+	// to format appended.
+	Format []string
+
+	// Version is the API version of the generated tree, which is written to
+	// the v<Version> directory of the output.
+	Version string
+
+	// SwaggerFile is the api spec the since and deprecatedIn annotations of
+	// fields are read from, named by the import path of the package it is in
+	// followed by its file name.
+	SwaggerFile string
+
+	// Dir is the directory of the module the types are loaded from, the
+	// current directory when empty. BuildFlags are passed to the go command
+	// loading them, like -modfile to pick the versions of the modules.
+	Dir        string
+	BuildFlags []string
+
+	roots          []string
+	discovery      *DiscoveryConfig
+	queries        *QueriesConfig
+	renames        map[string]string
+	packageAliases map[string]string
+	typeOverrides  map[string]TSType
+	fieldOverrides map[string]TSType
+	fieldDefaults  map[string]string
+	inlineStructs  map[string]bool
+	imports        []tsImport
+	mixins         map[string][]MixinConfig
+	swaggerNames   map[string]string
+	untypedEnums   map[string][]string
+	severities     map[string]Severity
+
+	fset       *token.FileSet
+	packages   map[string]*packages.Package
+	typeDecls  map[*types.TypeName]typeDecl
+	fieldDecls map[*types.Var]*ast.Field
+	constants  map[*types.Package]map[*types.TypeName][]*types.Const
+	routers    map[string][]routerPackage
+
+	// The names the function bodies dropped by parseDeclarations qualify
+	// identifiers with, a map[string]bool by file name
+	droppedQualifiers sync.Map
+
+	// The models of the types reflected so far, keyed by types.Type so that
+	// identical anonymous structs and generic instantiations share one model
+	reflectedTypes   typeutil.Map // *TSModelType
+	reflectedEnums   typeutil.Map // *TSEnumType, nil for types without constants
+	anonymousStructs typeutil.Map // TSType
+	queryTypes       []*TSQueryType
+	claimedNames     map[string]string
+	swagger          *SwaggerSpec
+	diagnostics      []Diagnostic
+
+	// position is the Go type and field being reflected, and where it is
+	// declared, diagnostics reported without a location of their own are
+	// attached to it
+	position struct{ Type, Field, Source string }
+}
+
+// tsImport is a namespace generated schemas may use, with its import line.
+type tsImport struct {
+	namespace string
+	line      string
+}
+
+// NewGenerator returns a generator for the tree of an API version, with
+// nothing registered yet.
+func NewGenerator(version string) *Generator {
+	return &Generator{
+		Version:        version,
+		renames:        map[string]string{},
+		packageAliases: map[string]string{},
+		typeOverrides:  map[string]TSType{},
+		fieldOverrides: map[string]TSType{},
+		fieldDefaults:  map[string]string{},
+		inlineStructs:  map[string]bool{},
+		mixins:         map[string][]MixinConfig{},
+		swaggerNames:   map[string]string{},
+		untypedEnums:   map[string][]string{},
+		severities:     map[string]Severity{},
+		fset:           token.NewFileSet(),
+		packages:       map[string]*packages.Package{},
+		typeDecls:      map[*types.TypeName]typeDecl{},
+		fieldDecls:     map[*types.Var]*ast.Field{},
+		constants:      map[*types.Package]map[*types.TypeName][]*types.Const{},
+		routers:        map[string][]routerPackage{},
+		claimedNames:   map[string]string{},
+	}
+}
+
+// AddRoots registers the types to generate by import path and type name,
+// along with every type they reference.
+func (g *Generator) AddRoots(goTypes ...string) {
+	g.roots = append(g.roots, goTypes...)
+}
+
+// Rename names the generated schema of a Go type.
+func (g *Generator) Rename(goType string, name string) {
+	g.renames[goType] = name
+}
+
+// AliasPackage names the generated schemas of the types of a package with an
+// alias followed by the type name, rather than the package name.
+func (g *Generator) AliasPackage(pkgPath string, alias string) {
+	g.packageAliases[pkgPath] = alias
+}
+
+// OverrideType replaces the generated schema of a Go type wherever it is
+// used, the type itself is not generated.
+func (g *Generator) OverrideType(goType string, o SchemaOverride) {
+	g.typeOverrides[goType] = o.tsType()
+}
+
+// OverrideField replaces the generated schema of a field, named by the type
+// declaring it followed by its Go name.
+func (g *Generator) OverrideField(goField string, o SchemaOverride) {
+	g.fieldOverrides[goField] = o.tsType()
+}
+
+// DefaultField gives a field a value, as a TS expression evaluated every time
+// the constructor of the generated class is not passed the field.
+func (g *Generator) DefaultField(goField string, value string) {
+	g.fieldDefaults[goField] = value
+}
+
+// InlineStruct keeps the anonymous struct of a field inline as a
+// Schema.Struct, rather than hoisting it into its own class.
+func (g *Generator) InlineStruct(goField string) {
+	g.inlineStructs[goField] = true
+}
+
+// Import lets generated schemas use a namespace, imported from a module
+// relative to the versioned tree. Namespaces are imported in the order they
+// are registered.
+func (g *Generator) Import(namespace string, from string) {
+	g.imports = append(g.imports, tsImport{namespace, fmt.Sprintf("import * as %s from %q;\n", namespace, from)})
+}
+
+// AddMixin extends the generated class of a Go type with a mixin, applied
+// after the ones registered before it.
+func (g *Generator) AddMixin(goType string, mixin MixinConfig) {
+	g.mixins[goType] = append(g.mixins[goType], mixin)
+}
+
+// NameSwaggerDefinition names the api spec definition describing a Go type,
+// when it is neither its generated name nor its Go name. Inline object
+// schemas are named by their path, like "Mount.BindOptions".
+func (g *Generator) NameSwaggerDefinition(goType string, definition string) {
+	g.swaggerNames[goType] = definition
+}
+
+// DeclareEnumConstants lists the constants of a Go type that are declared
+// untyped, so they can not be found by their type.
+func (g *Generator) DeclareEnumConstants(goType string, constants ...string) {
+	g.untypedEnums[goType] = append(g.untypedEnums[goType], constants...)
+}
+
+// SetSeverity changes the severity of a diagnostic code.
+func (g *Generator) SetSeverity(code string, severity Severity) {
+	g.severities[code] = severity
+}
+
+// Generate discovers the root types from the router backends, when
+// registered, loads their packages and reflects them, along with the queries
+// of the router handlers when registered, then writes the tree of
+// the API version under the output directory, or only compares it with the
+// files there when check is set. Nothing is written when any error is
+// diagnosed. It reports whether the run had no errors and, when checking,
+// found every file current.
+func (g *Generator) Generate(check bool) bool {
+	var discovered map[string]discoveredRoot
+	if g.discovery != nil {
+		discovered = g.discoverRoots()
+	}
+
+	// Every package is loaded at once, along with the package of the api
+	// spec, so the whole build is only type checked once
+	var pkgPaths []string
+	for _, root := range g.roots {
+		pkgPath, _, _ := cutLast(root, ".")
+		pkgPaths = append(pkgPaths, pkgPath)
+	}
+	for root := range discovered {
+		pkgPath, _, _ := cutLast(root, ".")
+		pkgPaths = append(pkgPaths, pkgPath)
+	}
+	if g.SwaggerFile != "" {
+		pkgPaths = append(pkgPaths, path.Dir(g.SwaggerFile))
+	}
+	g.loadPackages(pkgPaths...)
+
+	roots := slices.Clone(g.roots)
+	var discoveredRoots []string
+	if g.discovery != nil {
+		discoveredRoots = g.checkDiscovered(discovered)
+		for _, root := range discoveredRoots {
+			if !slices.Contains(roots, root) {
+				roots = append(roots, root)
+			}
+		}
+	}
+	for _, root := range roots {
+		obj, ok := g.lookupType(root)
+		if !ok {
+			g.reportAt("unknown-root", root, "", "root of API version %s is not declared by the loaded packages", g.Version)
+			continue
+		}
+		g.reflectType(obj.Type())
+	}
+	if g.queries != nil {
+		g.reflectQueries()
+	}
+
+	// Break the import cycles between recursive types
+	models := make([]*TSModelType, 0, g.reflectedTypes.Len())
+	g.reflectedTypes.Iterate(func(_ types.Type, v any) {
+		models = append(models, v.(*TSModelType))
+	})
+	suspendCycles(models)
+
+	// The root index covers every versioned tree, including this one
+	apiVersions, err := apiVersionsIn(g.Output)
+	if err != nil {
+		g.reportAt("read-failed", "", "", "listing the versioned trees: %v", err)
+		return false
+	}
+	if !slices.Contains(apiVersions, g.Version) {
+		apiVersions = append(apiVersions, g.Version)
+		sort.Slice(apiVersions, func(i, j int) bool {
+			return versions.LessThan(apiVersions[i], apiVersions[j])
+		})
+	}
+
+	files := g.renderVersion()
+	var rootIndex bytes.Buffer
+	writeRootIndex(apiVersions)(&rootIndex)
+
+	// Rendering reports problems too, so the tree is staged before any
+	// diagnostic is looked at
+	if !check {
+		if err := g.restoreVersion(); err != nil {
+			g.reportAt("write-failed", "", "", "restoring the tree of API version %s: %v", g.Version, err)
+			return false
+		}
+	}
+	stage, ok := g.stageVersion(files)
+	if !ok {
+		return false
+	}
+	defer os.RemoveAll(stage)
+
+	if errors, _ := g.countDiagnostics(); errors > 0 {
+		return false
+	}
+
+	if check {
+		return g.checkGenerated(stage, rootIndex.Bytes())
+	}
+
+	// Only the tree of this API version is replaced, and only once all of
+	// its files have been written, the generated files nothing generates
+	// into any more are removed
+	if err := g.swapVersion(stage); err != nil {
+		g.reportAt("write-failed", "", "", "swapping in the tree of API version %s: %v", g.Version, err)
+		return false
+	}
+	if err := g.removeStrayFiles(); err != nil {
+		g.reportAt("write-failed", "", "", "removing stray generated files: %v", err)
+		return false
+	}
+	if err := writeIfChanged(g.Output, "index.ts", rootIndex.Bytes()); err != nil {
+		g.reportAt("write-failed", "", "", "writing the root index: %v", err)
+		return false
+	}
+	if g.discovery != nil {
+		if err := g.writeLockfile(discoveredRoots); err != nil {
+			g.reportAt("write-failed", "", "", "writing the lockfile: %v", err)
+			return false
+		}
+	}
+	return true
+}
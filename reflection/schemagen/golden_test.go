@@ -0,0 +1,106 @@
+package schemagen
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files with the generated output")
+
+// newFixtureGenerator returns a generator configured by testdata/config.yaml
+// that generates into output, with the lockfile copied to a temporary one.
+func newFixtureGenerator(t *testing.T, output string) (*Generator, *Config) {
+	t.Helper()
+	config, err := LoadConfig(filepath.Join("testdata", "config.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := NewGenerator("1.0")
+	g.ApplyConfig(config)
+	g.Output = output
+	lockfile, err := os.ReadFile(g.discovery.Lockfile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.discovery.Lockfile = filepath.Join(t.TempDir(), "roots.lock.yaml")
+	if err := os.WriteFile(g.discovery.Lockfile, lockfile, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return g, config
+}
+
+// TestGolden generates the fixture types of testdata/fixtures, discovered from
+// the backend of the router fixture, and compares every generated schema, the
+// rewritten lockfile, the coverage of the routes of the router fixture by the
+// endpoints of testdata/endpoints and the diagnostics report with the golden
+// files in testdata/golden.
+func TestGolden(t *testing.T) {
+	g, config := newFixtureGenerator(t, t.TempDir())
+	if !g.Generate(false) {
+		t.Fatalf("generating the fixtures failed: %v", g.Diagnostics())
+	}
+	coverageFile := filepath.Join(t.TempDir(), "coverage.json")
+	if err := WriteCoverageReport(coverageFile, g.RouteCoverage(*config.Routes)); err != nil {
+		t.Fatal(err)
+	}
+	reportFile := filepath.Join(t.TempDir(), "diagnostics.json")
+	if err := g.WriteDiagnosticsReport(reportFile); err != nil {
+		t.Fatal(err)
+	}
+
+	generated, err := filepath.Glob(filepath.Join(g.Output, "v"+g.Version, "*.generated.ts"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated = append(generated, reportFile, coverageFile, g.discovery.Lockfile)
+
+	goldenDir := filepath.Join("testdata", "golden")
+	if *update {
+		if err := os.RemoveAll(goldenDir); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(goldenDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	names := map[string]bool{}
+	for _, file := range generated {
+		name := filepath.Base(file)
+		names[name] = true
+		got, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		golden := filepath.Join(goldenDir, name)
+		if *update {
+			if err := os.WriteFile(golden, got, 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			t.Errorf("%s was generated but has no golden file, run go test -update", name)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s differs from its golden file, run go test -update if this is expected\n%s", name, unifiedDiff("golden/"+name, want, "generated/"+name, got))
+		}
+	}
+
+	entries, err := os.ReadDir(goldenDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries {
+		if !names[entry.Name()] && !strings.HasPrefix(entry.Name(), ".") {
+			t.Errorf("%s has a golden file but was not generated", entry.Name())
+		}
+	}
+}
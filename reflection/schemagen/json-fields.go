@@ -0,0 +1,189 @@
+package schemagen
+
+import (
+	"go/types"
+	"reflect"
+	"sort"
+)
+
+// JsonField is a field encoding/json marshals for a struct, possibly promoted
+// from an embedded struct.
+type JsonField struct {
+	Name  string
+	Tag   JsonTag
+	Field *types.Var
+
+	// Owner is the struct type that declares the field, Index the path of
+	// field indexes from the root struct like reflect.Type.FieldByIndex.
+	Owner  types.Type
+	Index  []int
+	Tagged bool
+
+	// ThroughPointer is set when the field is promoted through an embedded
+	// pointer, which encoding/json skips together with its fields when nil.
+	ThroughPointer bool
+}
+
+// jsonFields resolves the fields encoding/json marshals for the struct t.
+// Untagged embedded structs are flattened breadth first, and when several
+// fields share a JSON key the shallowest wins, then the tagged one, and keys
+// that are still ambiguous are dropped. This follows typeFields of
+// encoding/json/encode.go.
+func (g *Generator) jsonFields(t types.Type) []JsonField {
+	type embedded struct {
+		typ            types.Type
+		index          []int
+		throughPointer bool
+	}
+
+	// Embedded types are counted and visited by their type string, as
+	// instantiations of a generic type are not always the same types.Type
+	var fields []JsonField
+	current := []embedded{}
+	next := []embedded{{typ: t}}
+	count := map[string]int{}
+	nextCount := map[string]int{}
+	visited := map[string]bool{}
+
+	for len(next) > 0 {
+		current, next = next, current[:0]
+		count, nextCount = nextCount, map[string]int{}
+
+		for _, e := range current {
+			key := types.TypeString(e.typ, nil)
+			if visited[key] {
+				continue
+			}
+			visited[key] = true
+
+			st := e.typ.Underlying().(*types.Struct)
+			for i := 0; i < st.NumFields(); i++ {
+				sf := st.Field(i)
+
+				// An unnamed pointer to a struct is looked through
+				ft := types.Unalias(sf.Type())
+				if p, ok := ft.(*types.Pointer); ok {
+					ft = types.Unalias(p.Elem())
+				}
+				if sf.Embedded() {
+					// Unexported embedded structs still promote their
+					// exported fields
+					if !sf.Exported() && kindOf(ft) != reflect.Struct {
+						continue
+					}
+				} else if !sf.Exported() {
+					continue
+				}
+
+				jsonTag, _ := JsonTagFromString(reflect.StructTag(st.Tag(i)).Get("json"))
+				if jsonTag.Skip {
+					continue
+				}
+
+				index := make([]int, len(e.index)+1)
+				copy(index, e.index)
+				index[len(e.index)] = i
+
+				// A named or non-struct field is a field of its own
+				if jsonTag.Name != "" || !sf.Embedded() || kindOf(ft) != reflect.Struct {
+					name := jsonTag.Name
+					if name == "" {
+						name = sf.Name()
+					}
+					field := JsonField{
+						Name:           name,
+						Tag:            jsonTag,
+						Field:          sf,
+						Owner:          e.typ,
+						Index:          index,
+						Tagged:         jsonTag.Name != "",
+						ThroughPointer: e.throughPointer,
+					}
+					fields = append(fields, field)
+					if count[key] > 1 {
+						// The struct is embedded more than once at this
+						// depth, duplicate the field so it is ambiguous
+						fields = append(fields, field)
+					}
+					continue
+				}
+
+				nextCount[types.TypeString(ft, nil)]++
+				if nextCount[types.TypeString(ft, nil)] == 1 {
+					throughPointer := e.throughPointer || kindOf(sf.Type()) == reflect.Pointer
+					next = append(next, embedded{typ: ft, index: index, throughPointer: throughPointer})
+				}
+			}
+		}
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		x := fields
+		if x[i].Name != x[j].Name {
+			return x[i].Name < x[j].Name
+		}
+		if len(x[i].Index) != len(x[j].Index) {
+			return len(x[i].Index) < len(x[j].Index)
+		}
+		if x[i].Tagged != x[j].Tagged {
+			return x[i].Tagged
+		}
+		return lessIndex(x[i].Index, x[j].Index)
+	})
+
+	out := fields[:0]
+	for advance, i := 0, 0; i < len(fields); i += advance {
+		name := fields[i].Name
+		for advance = 1; i+advance < len(fields); advance++ {
+			if fields[i+advance].Name != name {
+				break
+			}
+		}
+		if advance == 1 {
+			out = append(out, fields[i])
+			continue
+		}
+
+		candidates := fields[i : i+advance]
+		if dominant, ok := dominantJsonField(candidates); ok {
+			out = append(out, dominant)
+		} else {
+			g.reportAt("json-key-conflict", typePath(t), "", "conflicting json key %q, dropped fields %s", name, jsonFieldOwners(candidates))
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return lessIndex(out[i].Index, out[j].Index)
+	})
+	return out
+}
+
+// dominantJsonField returns the field that wins among fields sharing a JSON
+// key, sorted by depth and then tagged first. There is none when the two
+// first fields are equally deep and equally tagged.
+func dominantJsonField(fields []JsonField) (JsonField, bool) {
+	if len(fields) > 1 && len(fields[0].Index) == len(fields[1].Index) && fields[0].Tagged == fields[1].Tagged {
+		return JsonField{}, false
+	}
+	return fields[0], true
+}
+
+func jsonFieldOwners(fields []JsonField) []string {
+	owners := make([]string, 0, len(fields))
+	for _, f := range fields {
+		owners = append(owners, typeString(f.Owner)+"."+f.Field.Name())
+	}
+	return owners
+}
+
+func lessIndex(a []int, b []int) bool {
+	for k, ak := range a {
+		if k >= len(b) {
+			return false
+		}
+		if ak != b[k] {
+			return ak < b[k]
+		}
+	}
+	return len(a) < len(b)
+}
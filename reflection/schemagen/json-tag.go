@@ -0,0 +1,104 @@
+package schemagen
+
+import (
+	"errors"
+	"go/types"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+type JsonTag struct {
+	Name      string
+	Skip      bool
+	OmitEmpty bool
+	OmitZero  bool
+	Quoted    bool
+}
+
+// This can take the form of json:"..." and is parsed the way encoding/json
+// does: the name comes first and is ignored when it is not a valid key, and
+// unknown options are ignored.
+func JsonTagFromString(tag string) (JsonTag, error) {
+	if tag == "" {
+		return JsonTag{Name: "", Skip: false, OmitEmpty: false}, errors.New("nil or empty json tag string")
+	}
+	if tag == "-" {
+		return JsonTag{Skip: true}, nil
+	}
+
+	name, options, _ := strings.Cut(tag, ",")
+	ret := JsonTag{}
+	if isValidJsonTagName(name) {
+		ret.Name = name
+	}
+
+	for options != "" {
+		var option string
+		option, options, _ = strings.Cut(options, ",")
+		switch option {
+		case "omitempty":
+			ret.OmitEmpty = true
+		case "omitzero":
+			ret.OmitZero = true
+		case "string":
+			ret.Quoted = true
+		}
+	}
+
+	return ret, nil
+}
+
+// isValidJsonTagName mirrors encoding/json, which falls back to the Go field
+// name when the tag name has characters other than these.
+func isValidJsonTagName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, c := range name {
+		switch {
+		case strings.ContainsRune("!#$%&()*+-./:;<=>?@[]^_{|}~ ", c):
+		case !unicode.IsLetter(c) && !unicode.IsDigit(c):
+			return false
+		}
+	}
+	return true
+}
+
+// Omittable reports whether encoding/json can leave a field of type t out of
+// the output. omitzero omits any zero value, but omitempty only omits the
+// empty values of scalars, pointers, interfaces, maps, slices and arrays, and
+// so never omits a struct.
+func (j JsonTag) Omittable(t types.Type) bool {
+	if j.OmitZero {
+		return true
+	}
+	if !j.OmitEmpty {
+		return false
+	}
+
+	switch kindOf(t) {
+	case reflect.Array:
+		return t.Underlying().(*types.Array).Len() == 0
+	case reflect.Map, reflect.Slice, reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Interface, reflect.Pointer:
+		return true
+	}
+	return false
+}
+
+// QuotesValue reports whether the string option applies to a field of type t,
+// which encoding/json only honours for scalars and pointers to scalars.
+func (j JsonTag) QuotesValue(t types.Type) bool {
+	if !j.Quoted {
+		return false
+	}
+	if p, ok := types.Unalias(t).(*types.Pointer); ok {
+		t = p.Elem()
+	}
+
+	_, quotable := TSQuotedTypesMap[kindOf(t)]
+	return quotable
+}
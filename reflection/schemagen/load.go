@@ -0,0 +1,273 @@
+package schemagen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// typeDecl is the declaration of a named type in the loaded sources.
+type typeDecl struct {
+	spec *ast.TypeSpec
+	decl *ast.GenDecl
+}
+
+// loadPackages loads and type checks the packages from source, along with
+// every package they import, and indexes the declarations of their types and
+// struct fields. Packages are loaded all at once so their types are shared,
+// and every file is only parsed once per run.
+func (g *Generator) loadPackages(pkgPaths ...string) {
+	config := &packages.Config{
+		Mode:       packages.LoadAllSyntax | packages.NeedModule,
+		Dir:        g.Dir,
+		BuildFlags: g.BuildFlags,
+		Fset:       g.fset,
+		ParseFile:  g.parseDeclarations,
+	}
+	loaded, err := packages.Load(config, pkgPaths...)
+	if err != nil {
+		g.reportAt("load-error", "", "", "%v", err)
+		return
+	}
+
+	packages.Visit(loaded, nil, func(p *packages.Package) {
+		if _, seen := g.packages[p.PkgPath]; seen {
+			return
+		}
+		g.packages[p.PkgPath] = p
+		for _, err := range p.Errors {
+			if err.Kind == packages.TypeError && unusedImportRegexp.MatchString(err.Msg) && g.usedByDroppedBody(p, err) {
+				continue
+			}
+			g.reportAt("load-error", p.PkgPath, "", "%v", err)
+		}
+		g.indexDeclarations(p)
+	})
+}
+
+// parseDeclarations parses a file without the bodies of its functions, which
+// are most of what there is to type check but declare nothing the generator
+// reads. Generic functions keep theirs and init functions get an empty one,
+// as the type checker requires them. The names the dropped bodies qualify
+// identifiers with are recorded by file, so the imports only they use can be
+// told apart from the ones that are not used at all. Files are parsed
+// concurrently.
+func (g *Generator) parseDeclarations(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
+	f, err := parser.ParseFile(fset, filename, src, parser.AllErrors|parser.ParseComments|parser.SkipObjectResolution)
+	if f != nil {
+		qualifiers := map[string]bool{}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil || fn.Type.TypeParams != nil {
+				continue
+			}
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				if sel, ok := n.(*ast.SelectorExpr); ok {
+					if x, ok := sel.X.(*ast.Ident); ok {
+						qualifiers[x.Name] = true
+					}
+				}
+				return true
+			})
+			if fn.Recv == nil && fn.Name.Name == "init" {
+				fn.Body.List = nil
+			} else {
+				fn.Body = nil
+			}
+		}
+		g.droppedQualifiers.Store(filename, qualifiers)
+	}
+	return f, err
+}
+
+// unusedImportRegexp matches the errors of unused imports, which are expected
+// for the imports only used by the function bodies parseDeclarations drops.
+var unusedImportRegexp = regexp.MustCompile(`imported (as \w+ )?and not used$`)
+
+// usedByDroppedBody reports whether the import an unused import error is
+// about is used by a function body parseDeclarations dropped. The import is
+// located by the file and line of the error, which are the ones of the
+// original source for the files cgo generates.
+func (g *Generator) usedByDroppedBody(p *packages.Package, err packages.Error) bool {
+	rest, _, _ := cutLast(err.Pos, ":")
+	filename, line, _ := cutLast(rest, ":")
+	for _, f := range p.Syntax {
+		qualifiers, ok := g.droppedQualifiers.Load(g.fset.File(f.Pos()).Name())
+		if !ok {
+			continue
+		}
+		for _, spec := range f.Imports {
+			position := g.fset.Position(spec.Pos())
+			if position.Filename != filename || strconv.Itoa(position.Line) != line {
+				continue
+			}
+			name := spec.Name
+			if name == nil {
+				pkgName := p.TypesInfo.PkgNameOf(spec)
+				if pkgName == nil {
+					return false
+				}
+				name = ast.NewIdent(pkgName.Name())
+			}
+			return qualifiers.(map[string]bool)[name.Name]
+		}
+	}
+	return false
+}
+
+// indexDeclarations records the declaration of every named type and struct
+// field of a package by the object it defines.
+func (g *Generator) indexDeclarations(p *packages.Package) {
+	if p.TypesInfo == nil {
+		return
+	}
+	for _, file := range p.Syntax {
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.GenDecl:
+				if n.Tok != token.TYPE {
+					return true
+				}
+				for _, spec := range n.Specs {
+					ts := spec.(*ast.TypeSpec)
+					if obj, ok := p.TypesInfo.Defs[ts.Name].(*types.TypeName); ok {
+						g.typeDecls[obj] = typeDecl{ts, n}
+					}
+				}
+			case *ast.StructType:
+				for _, field := range n.Fields.List {
+					for _, name := range field.Names {
+						if v, ok := p.TypesInfo.Defs[name].(*types.Var); ok {
+							g.fieldDecls[v] = field
+						}
+					}
+				}
+			}
+			return true
+		})
+	}
+}
+
+// packageConstants returns the constants of a package grouped by the named
+// type they are declared with, each in declaration order across the files of
+// the package. They are indexed once per package, when first needed.
+func (g *Generator) packageConstants(p *types.Package) map[*types.TypeName][]*types.Const {
+	if constants, ok := g.constants[p]; ok {
+		return constants
+	}
+
+	constants := map[*types.TypeName][]*types.Const{}
+	scope := p.Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok {
+			continue
+		}
+		if named, ok := types.Unalias(c.Type()).(*types.Named); ok {
+			constants[named.Obj()] = append(constants[named.Obj()], c)
+		}
+	}
+	for _, declared := range constants {
+		sort.Slice(declared, func(i, j int) bool {
+			a, b := g.fset.Position(declared[i].Pos()), g.fset.Position(declared[j].Pos())
+			if a.Filename != b.Filename {
+				return a.Filename < b.Filename
+			}
+			return a.Offset < b.Offset
+		})
+	}
+	g.constants[p] = constants
+	return constants
+}
+
+// lookupType finds a named type of a loaded package by import path and type
+// name.
+func (g *Generator) lookupType(goType string) (*types.TypeName, bool) {
+	pkgPath, name, _ := cutLast(goType, ".")
+	p, ok := g.packages[pkgPath]
+	if !ok || p.Types == nil {
+		return nil, false
+	}
+	obj, ok := p.Types.Scope().Lookup(name).(*types.TypeName)
+	return obj, ok
+}
+
+// fieldDecl returns the declaration of a struct field, generic fields are
+// declared by the field of the generic type they are instantiated from.
+func (g *Generator) fieldDecl(v *types.Var) *ast.Field {
+	return g.fieldDecls[v.Origin()]
+}
+
+// source locates the declaration of an object by the import path of its
+// package, its file name and line, which does not depend on where the module
+// sources are.
+func (g *Generator) source(obj types.Object) string {
+	if obj == nil || obj.Pkg() == nil {
+		return ""
+	}
+	return g.sourceAt(obj.Pkg().Path(), obj.Pos())
+}
+
+// sourceAt locates a position in a file of a package the same way.
+func (g *Generator) sourceAt(pkgPath string, pos token.Pos) string {
+	if !pos.IsValid() {
+		return ""
+	}
+	position := g.fset.Position(pos)
+	return fmt.Sprintf("%s:%d", path.Join(pkgPath, filepath.Base(position.Filename)), position.Line)
+}
+
+// typePath names a type by its import path and type name, or by its name
+// alone for predeclared types. Aliases are named by their own name, and
+// instantiated generic types by the generic type. Unnamed types have none.
+func typePath(t types.Type) string {
+	var obj *types.TypeName
+	switch t := t.(type) {
+	case *types.Alias:
+		obj = t.Obj()
+	case *types.Named:
+		obj = t.Obj()
+	case *types.Basic:
+		return t.Name()
+	default:
+		return ""
+	}
+	if obj.Pkg() == nil {
+		return obj.Name()
+	}
+	return obj.Pkg().Path() + "." + obj.Name()
+}
+
+// goSourceName names a named type the way it is written in Go code outside of
+// its package, like "container.Summary", with the type arguments of generic
+// instantiations qualified the same way.
+func goSourceName(t *types.Named) string {
+	name := t.Obj().Name()
+	if t.Obj().Pkg() != nil {
+		name = t.Obj().Pkg().Name() + "." + name
+	}
+	if args := t.TypeArgs(); args.Len() > 0 {
+		names := make([]string, 0, args.Len())
+		for i := 0; i < args.Len(); i++ {
+			names = append(names, typeString(args.At(i)))
+		}
+		name += "[" + strings.Join(names, ",") + "]"
+	}
+	return name
+}
+
+// typeString writes a type the way it is written in Go code outside of its
+// package, for diagnostics.
+func typeString(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string { return p.Name() })
+}
@@ -0,0 +1,45 @@
+package schemagen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestUnusedImports loads a package with an import only used by a function
+// body, which parseDeclarations drops, and one that is not used at all, and
+// checks that only the unused one is reported.
+func TestUnusedImports(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module example.com/imports\n\ngo 1.24\n",
+		"imports.go": `package imports
+
+import (
+	"fmt"
+	str "strings"
+	"unicode"
+)
+
+type Name string
+
+func (n Name) String() string {
+	return fmt.Sprint(str.ToUpper(string(n)))
+}
+`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	g := NewGenerator("1.0")
+	g.Dir = dir
+	g.loadPackages("example.com/imports")
+	diagnostics := g.Diagnostics()
+	if len(diagnostics) != 1 || diagnostics[0].Code != "load-error" || !strings.Contains(diagnostics[0].Message, `"unicode" imported and not used`) {
+		t.Errorf("got %v, want the unused import of unicode reported", diagnostics)
+	}
+}
@@ -0,0 +1,386 @@
+package schemagen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// QueriesConfig generates the query parameters of the routes of the daemon
+// routers, read from the source of their handlers, as one Schema.Struct per
+// handler. A handler serving several routes has the parameters of all of them
+// in its schema, which is reported.
+type QueriesConfig struct {
+	// Routers is the go package pattern of the router packages, like
+	// "github.com/docker/docker/api/server/router/...".
+	Routers string `yaml:"routers"`
+}
+
+// queryKind is how a handler parses a query parameter.
+type queryKind int
+
+const (
+	queryString queryKind = iota
+	queryStrings
+	queryBool
+	queryNumber
+	queryFilters
+)
+
+// querySchemas are the schemas of the query parameter kinds. Filters are the
+// JSON encoding of a filters.Args.
+var querySchemas = map[queryKind]string{
+	queryString:  "Schema.String",
+	queryStrings: "Schema.Array(Schema.String)",
+	queryBool:    "Schema.Boolean",
+	queryNumber:  "Schema.Finite",
+	queryFilters: "Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))",
+}
+
+// queryReader is a function of the httputils package reading a query
+// parameter of the request it is passed, named by its second argument unless
+// the function always reads the same one.
+type queryReader struct {
+	kind  queryKind
+	param string
+}
+
+var queryReaders = map[string]queryReader{
+	"BoolValue":           {kind: queryBool},
+	"BoolValueOrDefault":  {kind: queryBool},
+	"Int64ValueOrZero":    {kind: queryNumber},
+	"Int64ValueOrDefault": {kind: queryNumber},
+	"Uint32Value":         {kind: queryNumber},
+	"ArchiveFormValues":   {kind: queryString, param: "path"},
+}
+
+// queryConversions are the functions parsing the string value of a query
+// parameter, by the expression calling them.
+var queryConversions = map[string]queryKind{
+	"strconv.Atoi":       queryNumber,
+	"strconv.ParseInt":   queryNumber,
+	"strconv.ParseUint":  queryNumber,
+	"strconv.ParseFloat": queryNumber,
+	"strconv.ParseBool":  queryBool,
+	"filters.FromJSON":   queryFilters,
+}
+
+// QueryParam is a query parameter read by a route handler, with the schema
+// of how it is parsed.
+type QueryParam struct {
+	Name   string
+	Schema string
+
+	// Source is where the handler first reads the parameter.
+	Source string
+}
+
+// TSQueryType is the query of the routes served by a handler of a daemon
+// router, emitted as its own module holding a Schema.Struct of the query
+// parameters, each optional.
+type TSQueryType struct {
+	GoSourceName string
+	GoPkgPath    string
+	Routes       []Route
+	Params       []QueryParam
+
+	name string
+}
+
+// GenerateQueries registers the routers whose handlers the query schemas are
+// generated from.
+func (g *Generator) GenerateQueries(q QueriesConfig) {
+	g.queries = &q
+}
+
+// routerFuncs are the functions and methods of a router package by name,
+// along with the package path.
+type routerFuncs struct {
+	pkgPath string
+	funcs   map[string]*ast.FuncDecl
+	methods map[string]*ast.FuncDecl
+}
+
+// reflectQueries reads the query parameters of the handler of every route of
+// the routers, and names the schema of each handler reading any by the router
+// and the handler, like ContainerGetContainersJSONQuery. Handlers are read
+// along with the functions and methods of their package they pass the request
+// to. Routers are told apart by import path, routers of the same name whose
+// handlers share a name collide.
+func (g *Generator) reflectQueries() {
+	packages := map[string]routerFuncs{}
+	for _, p := range g.parseRouters(g.queries.Routers) {
+		router := routerFuncs{p.pkgPath, map[string]*ast.FuncDecl{}, map[string]*ast.FuncDecl{}}
+		for _, f := range p.files {
+			for _, decl := range f.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+					if fn.Recv == nil {
+						router.funcs[fn.Name.Name] = fn
+					} else {
+						router.methods[fn.Name.Name] = fn
+					}
+				}
+			}
+		}
+		packages[p.pkgPath] = router
+	}
+
+	handlers := map[string]*TSQueryType{}
+	for _, route := range g.Routes(g.queries.Routers) {
+		router, ok := packages[route.pkgPath]
+		if !ok {
+			continue
+		}
+		goSourceName := route.Router + "." + route.Handler
+		handler := goTypePath(route.pkgPath, goSourceName)
+		if q, ok := handlers[handler]; ok {
+			q.Routes = append(q.Routes, route)
+			continue
+		}
+		fn, ok := router.methods[route.Handler]
+		if !ok {
+			fn, ok = router.funcs[route.Handler]
+		}
+		if !ok {
+			continue
+		}
+		handlers[handler] = &TSQueryType{
+			GoSourceName: goSourceName,
+			GoPkgPath:    router.pkgPath,
+			Routes:       []Route{route},
+			Params:       g.readQuery(router, fn),
+			name:         exportedName(route.Router) + exportedName(route.Handler) + "Query",
+		}
+	}
+
+	names := make([]string, 0, len(handlers))
+	for handler, q := range handlers {
+		if len(q.Params) > 0 {
+			names = append(names, handler)
+		}
+	}
+	sort.Strings(names)
+	for _, handler := range names {
+		q := handlers[handler]
+		g.claimName(q.GoPkgPath, q.GoSourceName, q.Name())
+		if merged := q.mergedRoutes(); len(merged) > 1 {
+			g.record("merged-query", handler, "", q.Routes[0].Source, fmt.Sprintf("serves %s, the query parameters of every route are merged into %s", strings.Join(merged, ", "), q.Name()))
+		}
+		g.queryTypes = append(g.queryTypes, q)
+	}
+}
+
+// mergedRoutes lists the distinct routes served by the handler of a query, by
+// method and path, a trailing slash ignored.
+func (q *TSQueryType) mergedRoutes() []string {
+	var routes []string
+	for _, route := range q.Routes {
+		if r := route.Method + " " + trimTrailingSlash(route.Path); !slices.Contains(routes, r) {
+			routes = append(routes, r)
+		}
+	}
+	return routes
+}
+
+// exportedName upper cases the first letter of an identifier.
+func exportedName(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// readQuery finds the query parameters a handler reads from its request, in
+// the order they are first read. Parameters read through r.Form.Get and the
+// like are strings, unless their value is parsed by one of queryConversions,
+// directly or through the variable it is assigned to. Parameters read through
+// r.Form["name"] are repeated.
+func (g *Generator) readQuery(router routerFuncs, handler *ast.FuncDecl) []QueryParam {
+	var params []QueryParam
+	kinds := map[string]queryKind{}
+	add := func(name string, kind queryKind, source string) {
+		read, ok := kinds[name]
+		if !ok {
+			params = append(params, QueryParam{Name: name, Source: source})
+		}
+		// Parsing the value says more than reading it as a string
+		if !ok || read == queryString {
+			kinds[name] = kind
+		}
+	}
+
+	walked := map[*ast.FuncDecl]bool{}
+	var walk func(fn *ast.FuncDecl)
+	walk = func(fn *ast.FuncDecl) {
+		request := requestParam(fn)
+		if walked[fn] || request == "" {
+			return
+		}
+		walked[fn] = true
+
+		// read returns the name of the parameter an expression reads as a
+		// string, or "" when it reads none
+		read := func(expr ast.Expr) string {
+			call, ok := expr.(*ast.CallExpr)
+			if !ok || len(call.Args) != 1 {
+				return ""
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return ""
+			}
+			x := types.ExprString(sel.X)
+			switch {
+			case sel.Sel.Name == "Get" && (x == request+".Form" || x == request+".URL.Query()"):
+			case (sel.Sel.Name == "FormValue" || sel.Sel.Name == "PostFormValue") && x == request:
+			default:
+				return ""
+			}
+			name := stringLiteral(call.Args[0])
+			if name == "" {
+				g.record("unresolved-query", "", "", g.sourceAt(router.pkgPath, call.Pos()), fmt.Sprintf("can not read the query parameter of %s", types.ExprString(call)))
+			}
+			return name
+		}
+
+		// Variables holding the value of a parameter, by name, in the order
+		// they are assigned. A variable holds the parameter of the last
+		// assignment before it is used, as handlers reuse names like value
+		// for one parameter after the other.
+		type assignment struct {
+			pos   token.Pos
+			param string
+		}
+		variables := map[string][]assignment{}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if assign, ok := n.(*ast.AssignStmt); ok && len(assign.Lhs) == len(assign.Rhs) {
+				for i, lhs := range assign.Lhs {
+					if ident, ok := lhs.(*ast.Ident); ok {
+						if name := read(assign.Rhs[i]); name != "" {
+							variables[ident.Name] = append(variables[ident.Name], assignment{assign.Pos(), name})
+						}
+					}
+				}
+			}
+			return true
+		})
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.IndexExpr:
+				if types.ExprString(n.X) == request+".Form" {
+					if name := stringLiteral(n.Index); name != "" {
+						add(name, queryStrings, g.sourceAt(router.pkgPath, n.Pos()))
+					}
+				}
+			case *ast.CallExpr:
+				source := g.sourceAt(router.pkgPath, n.Pos())
+				if name := read(n); name != "" {
+					add(name, queryString, source)
+					return true
+				}
+				if kind, ok := queryConversions[types.ExprString(n.Fun)]; ok && len(n.Args) > 0 {
+					name := read(n.Args[0])
+					if ident, ok := n.Args[0].(*ast.Ident); ok {
+						for _, a := range variables[ident.Name] {
+							if a.pos < ident.Pos() {
+								name = a.param
+							}
+						}
+					}
+					if name != "" {
+						add(name, kind, source)
+					}
+					return true
+				}
+
+				passesRequest := false
+				for _, arg := range n.Args {
+					if ident, ok := arg.(*ast.Ident); ok && ident.Name == request {
+						passesRequest = true
+					}
+				}
+				if !passesRequest {
+					return true
+				}
+				switch fun := n.Fun.(type) {
+				case *ast.Ident:
+					if callee, ok := router.funcs[fun.Name]; ok {
+						walk(callee)
+					}
+				case *ast.SelectorExpr:
+					if reader, ok := queryReaders[fun.Sel.Name]; ok && types.ExprString(fun.X) == "httputils" {
+						name := reader.param
+						if name == "" && len(n.Args) > 1 {
+							name = stringLiteral(n.Args[1])
+						}
+						if name == "" {
+							g.record("unresolved-query", "", "", source, fmt.Sprintf("can not read the query parameter of %s", types.ExprString(n)))
+							return true
+						}
+						add(name, reader.kind, source)
+					} else if callee, ok := router.methods[fun.Sel.Name]; ok {
+						walk(callee)
+					}
+				}
+			}
+			return true
+		})
+	}
+	walk(handler)
+	for i := range params {
+		params[i].Schema = querySchemas[kinds[params[i].Name]]
+	}
+	return params
+}
+
+// requestParam returns the name of the *http.Request parameter of a function,
+// or "" when it has none.
+func requestParam(fn *ast.FuncDecl) string {
+	for _, field := range fn.Type.Params.List {
+		if types.ExprString(field.Type) == "*http.Request" && len(field.Names) > 0 {
+			return field.Names[0].Name
+		}
+	}
+	return ""
+}
+
+func (q *TSQueryType) Name() string {
+	return q.name
+}
+
+func (q *TSQueryType) Title() string {
+	return q.GoSourceName
+}
+
+// Description lists the routes served by the handler.
+func (q *TSQueryType) Description() string {
+	routes := make([]string, 0, len(q.Routes))
+	for _, route := range q.Routes {
+		routes = append(routes, route.Method+" "+route.Path)
+	}
+	return "Query parameters of " + strings.Join(routes, ", ")
+}
+
+func (q *TSQueryType) WriteQuery(w io.Writer, imports []tsImport) {
+	var buffer bytes.Buffer
+	buffer.WriteString(fmt.Sprintf("export const %s = Schema.Struct({\n", q.Name()))
+	for _, p := range q.Params {
+		buffer.WriteString(fmt.Sprintf("    %s: Schema.optional(%s),\n", formatFieldName(p.Name), p.Schema))
+	}
+	buffer.WriteString(fmt.Sprintln("}).annotate({"))
+	buffer.WriteString(fmt.Sprintf("    identifier: \"%s\",\n", q.Name()))
+	buffer.WriteString(fmt.Sprintf("    title: \"%s\",\n", q.Title()))
+	buffer.WriteString(fmt.Sprintf("    description: %q,\n", q.Description()))
+	buffer.WriteString(fmt.Sprintln("});"))
+	buffer.WriteString(fmt.Sprintln())
+	buffer.WriteString(fmt.Sprintf("export type %s = Schema.Schema.Type<typeof %s>;\n", q.Name(), q.Name()))
+
+	outString := buffer.String()
+	writeKnownImports(w, imports, outString)
+	fmt.Fprintf(w, "\n")
+	fmt.Fprint(w, outString)
+}
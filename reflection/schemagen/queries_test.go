@@ -0,0 +1,34 @@
+package schemagen
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestRoutersOfTheSameName reads the queries of the router fixture along with
+// the ones of a second router package also named router, and checks that the
+// handlers of both get a schema, and that the handler serving several routes
+// is reported.
+func TestRoutersOfTheSameName(t *testing.T) {
+	g, _ := newFixtureGenerator(t, t.TempDir())
+	fixture := "github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/router"
+	g.routers["routers"] = append(g.parseRouters(fixture), g.parseRouters(fixture+"/legacy/router")...)
+	g.GenerateQueries(QueriesConfig{Routers: "routers"})
+	g.reflectQueries()
+
+	var names []string
+	for _, q := range g.queryTypes {
+		names = append(names, q.Name())
+	}
+	want := []string{"RouterGetShapesQuery", "RouterPatchShapePaintQuery", "RouterPostShapesCreateQuery", "RouterGetLegacyShapesQuery"}
+	if !slices.Equal(names, want) {
+		t.Errorf("the query schemas are %q, want %q", names, want)
+	}
+
+	merged := slices.ContainsFunc(g.Diagnostics(), func(d Diagnostic) bool {
+		return d.Code == "merged-query" && d.Type == fixture+".getShapes"
+	})
+	if !merged {
+		t.Errorf("the handler serving several routes was not reported: %v", g.Diagnostics())
+	}
+}
@@ -0,0 +1,134 @@
+package schemagen
+
+import (
+	"fmt"
+	"go/types"
+	"reflect"
+)
+
+func ultimateType(t types.Type) types.Type {
+	for {
+		switch u := t.Underlying().(type) {
+		case *types.Array:
+			t = u.Elem()
+		case *types.Chan:
+			t = u.Elem()
+		case *types.Map:
+			t = u.Elem()
+		case *types.Pointer:
+			t = u.Elem()
+		case *types.Slice:
+			t = u.Elem()
+		default:
+			return types.Unalias(t)
+		}
+	}
+}
+
+// reflectTypeMembers adds a property for every field encoding/json marshals
+// for t to m, with the fields of embedded structs promoted into m. The field
+// declarations from the Go sources provide their descriptions and sources.
+func (g *Generator) reflectTypeMembers(t types.Type, m *TSModelType) {
+	// Embedded structs are flattened, but still generated on their own
+	st := t.Underlying().(*types.Struct)
+	for index := 0; index < st.NumFields(); index++ {
+		field := st.Field(index)
+		jsonTag, _ := JsonTagFromString(reflect.StructTag(st.Tag(index)).Get("json"))
+		if ut := ultimateType(field.Type()); field.Embedded() && jsonTag.Name == "" && !jsonTag.Skip && kindOf(ut) == reflect.Struct {
+			g.reflectType(ut)
+		}
+	}
+
+	for _, jsonField := range g.jsonFields(t) {
+		field := jsonField.Field
+		jsonTag := jsonField.Tag
+		name := jsonField.Name
+		decl := g.fieldDecl(field)
+		owner := goTypePath(m.GoPkgPath, m.GoSourceName)
+		if _, named := jsonField.Owner.(*types.Named); named {
+			owner = typePath(jsonField.Owner)
+		}
+		source := g.source(field)
+		restore := g.at(owner, field.Name(), source)
+		isOpt := jsonTag.Omittable(field.Type()) || jsonField.ThroughPointer
+
+		// Anonymous struct definitions, possibly wrapped in slices, maps,
+		// arrays or pointers, and structs that aren't inline need to be
+		// updated too
+		ut := ultimateType(field.Type())
+		if _, anonymous := ut.(*types.Struct); anonymous && !isEmptyStruct(ut) {
+			goSourceName := m.GoSourceName + "." + field.Name()
+			g.reflectInlineStruct(ut, goSourceName, m.GoPkgPath, fieldDescription(decl), source)
+		} else if kindOf(ut) == reflect.Struct && !isEmptyStruct(ut) {
+			if _, ok := TSInboxTypesMap[kindOf(field.Type())]; !ok {
+				g.reflectType(ut)
+			}
+		}
+		tsProp := TSProperty{FieldName: name, Type: g.goTypeToTsType(field.Type()), IsOpt: isOpt, Source: source}
+		if jsonTag.QuotesValue(field.Type()) {
+			tsProp.Type = goQuotedTypeToTsType(field.Type())
+		}
+		if replacement, willReplace := g.fieldOverrides[owner+"."+field.Name()]; willReplace {
+			tsProp.Type = replacement
+		}
+		if value, hasDefault := g.fieldDefaults[owner+"."+field.Name()]; hasDefault {
+			tsProp.DefaultValue = "() => " + value
+		}
+		if description := fieldDescription(decl); description != "" {
+			tsProp.Annotate("description", description)
+		}
+		m.Properties = append(m.Properties, tsProp)
+		restore()
+	}
+}
+
+// reflectInlineStruct reflects an anonymous struct declared by a field, which
+// is hoisted into its own class named after the path of the field, like
+// system.Info.ContainerdNamespaces, unless the path is listed in
+// inlineStructs. Identical anonymous structs share the first class. The
+// schema of every anonymous struct reflected, a reference to its hoisted class
+// or its inline Schema.Struct, is kept in anonymousStructs.
+func (g *Generator) reflectInlineStruct(t types.Type, goSourceName string, pkgPath string, description string, source string) {
+	if g.inlineStructs[goTypePath(pkgPath, goSourceName)] {
+		m := &TSModelType{GoSourceName: goSourceName, GoPkgPath: pkgPath, Source: source}
+		g.reflectTypeMembers(t, m)
+		g.anonymousStructs.Set(t, TSType{StrRepresentation: m.WriteInlineStruct(), Nullable: false})
+		return
+	}
+
+	m, alreadyInserted := g.reflectedTypes.At(t).(*TSModelType)
+	if !alreadyInserted {
+		m = g.newModel(goSourceName, pkgPath, description)
+		m.Source = source
+		g.claimName(m.GoPkgPath, m.GoSourceName, m.Name())
+		g.reflectedTypes.Set(t, m)
+		g.reflectTypeMembers(t, m)
+	}
+	g.anonymousStructs.Set(t, TSType{StrRepresentation: fmt.Sprintf("%s.%s", m.Name(), m.Name()), Nullable: false})
+}
+
+func (g *Generator) reflectType(t types.Type) {
+	if _, willReplace := g.typeOverrides[typePath(t)]; willReplace {
+		return
+	}
+	t = types.Unalias(t)
+
+	if g.reflectedTypes.At(t) != nil {
+		return
+	}
+
+	// Needs to be a struct or something with a name
+	named, ok := t.(*types.Named)
+	if !ok {
+		g.report("unnamed-type", "unable to reflect %s, a type with no name", typeString(t))
+		return
+	}
+	defer g.at(typePath(named), "", g.source(named.Obj()))()
+
+	activeType := g.newModel(goSourceName(named), named.Obj().Pkg().Path(), g.typeDescription(named.Obj()))
+	activeType.Source = g.source(named.Obj())
+	g.claimName(activeType.GoPkgPath, activeType.GoSourceName, activeType.Name())
+	g.reflectedTypes.Set(named, activeType)
+	g.reflectTypeMembers(named, activeType)
+	g.annotateFromSwagger(named, activeType)
+}
@@ -0,0 +1,421 @@
+package schemagen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// RoutesConfig compares the routes of the daemon routers with the endpoints
+// of the hand-written TS endpoint groups.
+type RoutesConfig struct {
+	// Routers is the go package pattern of the router packages, like
+	// "github.com/docker/docker/api/server/router/...".
+	Routers string `yaml:"routers"`
+
+	// Endpoints is the directory of the TS endpoint groups, relative to the
+	// config file.
+	Endpoints string `yaml:"endpoints"`
+
+	// Ignore are the routes that are not part of the API, like "GET
+	// /debug/...", by method and path template, where a trailing "/..." also
+	// matches the paths under it.
+	Ignore []string `yaml:"ignore"`
+}
+
+// Route is a route registered by a daemon router, with the name of the
+// function or method handling it. Router is the name of the router package,
+// pkgPath its import path.
+type Route struct {
+	Method       string `json:"method"`
+	Path         string `json:"path"`
+	Handler      string `json:"handler"`
+	Router       string `json:"router"`
+	Experimental bool   `json:"experimental,omitempty"`
+	Source       string `json:"source"`
+
+	pkgPath string
+}
+
+// Endpoint is an HttpApiEndpoint of a TS endpoint group, with its path
+// prefixed by the one of the group.
+type Endpoint struct {
+	Group  string `json:"group"`
+	Name   string `json:"name"`
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Source string `json:"source"`
+}
+
+// RouteMismatch is an endpoint that looks like it implements a route, but
+// whose path is one segment apart from it.
+type RouteMismatch struct {
+	Route    Route    `json:"route"`
+	Endpoint Endpoint `json:"endpoint"`
+}
+
+// CoverageReport lists the routes of an API version that no endpoint
+// implements, the endpoints that implement no route, and the ones whose path
+// does not match the route they are meant for.
+type CoverageReport struct {
+	Version       string          `json:"version"`
+	Routes        int             `json:"routes"`
+	Endpoints     int             `json:"endpoints"`
+	Covered       int             `json:"covered"`
+	Ignored       int             `json:"ignored"`
+	Unimplemented []Route         `json:"unimplemented"`
+	Extra         []Endpoint      `json:"extra"`
+	Mismatched    []RouteMismatch `json:"mismatched"`
+}
+
+// httpMethods are the methods routes are registered with.
+var httpMethods = map[string]bool{
+	"GET":     true,
+	"HEAD":    true,
+	"POST":    true,
+	"PUT":     true,
+	"PATCH":   true,
+	"DELETE":  true,
+	"OPTIONS": true,
+}
+
+// routeMethods are the functions of the router package that register a route
+// of their method, NewRoute takes the method as its first argument.
+var routeMethods = map[string]string{
+	"NewGetRoute":     "GET",
+	"NewPostRoute":    "POST",
+	"NewPutRoute":     "PUT",
+	"NewDeleteRoute":  "DELETE",
+	"NewOptionsRoute": "OPTIONS",
+	"NewHeadRoute":    "HEAD",
+	"NewRoute":        "",
+}
+
+// Routes finds the routes registered by the router packages matching a
+// pattern, from their calls to router.NewGetRoute and the like, ordered by
+// path and method. Routes whose method or path is not a string literal can
+// not be read and are reported.
+func (g *Generator) Routes(routers string) []Route {
+	var routes []Route
+	for _, p := range g.parseRouters(routers) {
+		for _, f := range p.files {
+			ast.Inspect(f, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				sel, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+				method, ok := routeMethods[sel.Sel.Name]
+				if !ok {
+					return true
+				}
+
+				args := call.Args
+				if method == "" && len(args) > 0 {
+					method = stringLiteral(args[0])
+					args = args[1:]
+				}
+				source := g.sourceAt(p.pkgPath, call.Pos())
+				if len(args) < 2 || method == "" || stringLiteral(args[0]) == "" {
+					g.record("unresolved-route", "", "", source, fmt.Sprintf("can not read the method and path of %s", types.ExprString(call)))
+					return true
+				}
+
+				route := Route{
+					Method:  strings.ToUpper(method),
+					Path:    stringLiteral(args[0]),
+					Handler: handlerName(args[1]),
+					Router:  path.Base(p.pkgPath),
+					Source:  source,
+					pkgPath: p.pkgPath,
+				}
+				for _, option := range args[2:] {
+					if strings.HasSuffix(types.ExprString(option), "Experimental") {
+						route.Experimental = true
+					}
+				}
+				routes = append(routes, route)
+				return true
+			})
+		}
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+	return routes
+}
+
+// stringLiteral returns the value of a string literal, or "" for any other
+// expression.
+func stringLiteral(expr ast.Expr) string {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok {
+		return ""
+	}
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+// handlerName names the handler of a route by the method or function it is,
+// or by the expression building it, like frameworkAdaptHandler(expvar.Handler()).
+func handlerName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name
+	case *ast.SelectorExpr:
+		return expr.Sel.Name
+	}
+	return types.ExprString(expr)
+}
+
+var (
+	// endpointRegexp matches the declaration of an endpoint, with its name,
+	// method, identifier and path
+	endpointRegexp = regexp.MustCompile(`const\s+(\w+)\s*=\s*HttpApiEndpoint\.(get|post|put|patch|delete|head|options)\(\s*"([^"]*)"\s*,\s*"([^"]*)"`)
+	// groupRegexp matches the declaration of a group, with its name and
+	// identifier, up to the end of the statement adding its endpoints
+	groupRegexp  = regexp.MustCompile(`const\s+(\w+)\s*=\s*HttpApiGroup\.make\(\s*"([^"]*)"\s*\)([^;]*);`)
+	addRegexp    = regexp.MustCompile(`\.add\(([^)]*)\)`)
+	prefixRegexp = regexp.MustCompile(`\.prefix\(\s*"([^"]*)"\s*\)`)
+)
+
+// Endpoints reads the endpoints added to the HttpApiGroups declared by the TS
+// files of a directory, ordered by path and method. The files are matched
+// with regular expressions rather than parsed, so endpoints and groups have
+// to be declared the way the endpoint files do: an endpoint by a const
+// initialized with HttpApiEndpoint.get("identifier", "/path", ...), and a
+// group by a const initialized with HttpApiGroup.make("identifier") followed
+// by the calls to add and prefix. Endpoints added to a group but not declared
+// by its file are reported, and so are files that can not be read.
+func (g *Generator) Endpoints(dir string) []Endpoint {
+	files, err := filepath.Glob(filepath.Join(dir, "*.ts"))
+	if err != nil {
+		g.reportAt("read-failed", "", "", "%s: %v", dir, err)
+		return nil
+	}
+	sort.Strings(files)
+
+	var endpoints []Endpoint
+	for _, file := range files {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			g.reportAt("read-failed", "", "", "%v", err)
+			continue
+		}
+		src := string(b)
+		position := func(offset int) string {
+			return fmt.Sprintf("%s:%d", filepath.Base(file), strings.Count(src[:offset], "\n")+1)
+		}
+
+		declared := map[string]Endpoint{}
+		for _, m := range endpointRegexp.FindAllStringSubmatchIndex(src, -1) {
+			declared[src[m[2]:m[3]]] = Endpoint{
+				Name:   src[m[6]:m[7]],
+				Method: strings.ToUpper(src[m[4]:m[5]]),
+				Path:   src[m[8]:m[9]],
+				Source: position(m[0]),
+			}
+		}
+
+		for _, m := range groupRegexp.FindAllStringSubmatchIndex(src, -1) {
+			group, chain := src[m[4]:m[5]], src[m[6]:m[7]]
+			prefix := ""
+			if p := prefixRegexp.FindStringSubmatch(chain); p != nil {
+				prefix = p[1]
+			}
+			for _, add := range addRegexp.FindAllStringSubmatch(chain, -1) {
+				for _, name := range strings.Split(add[1], ",") {
+					name = strings.TrimSpace(name)
+					if name == "" {
+						continue
+					}
+					endpoint, ok := declared[name]
+					if !ok {
+						g.record("unknown-endpoint", "", "", position(m[0]), fmt.Sprintf("group %s adds %s, which is not declared as an endpoint by %s", group, name, filepath.Base(file)))
+						continue
+					}
+					endpoint.Group = group
+					endpoint.Path = prefix + endpoint.Path
+					endpoints = append(endpoints, endpoint)
+				}
+			}
+		}
+	}
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		if endpoints[i].Path != endpoints[j].Path {
+			return endpoints[i].Path < endpoints[j].Path
+		}
+		return endpoints[i].Method < endpoints[j].Method
+	})
+	return endpoints
+}
+
+// pathSegments splits a path template into its segments, with every
+// parameter, {name} or {name:regexp} in a route and :name in an endpoint,
+// written as {}.
+func pathSegments(path string) []string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			segments[i] = "{}"
+		}
+	}
+	return segments
+}
+
+// ignoredRoute reports whether a route matches an entry of RoutesConfig.Ignore.
+func ignoredRoute(ignore []string, route Route) bool {
+	for _, entry := range ignore {
+		method, path, _ := strings.Cut(entry, " ")
+		if method != route.Method {
+			continue
+		}
+		if prefix, ok := strings.CutSuffix(path, "/..."); ok && (route.Path == prefix || strings.HasPrefix(route.Path, prefix+"/")) {
+			return true
+		}
+		if path == route.Path {
+			return true
+		}
+	}
+	return false
+}
+
+// RouteCoverage compares the routes of the routers with the endpoints of the
+// TS endpoint groups. An endpoint implements every route with its method and
+// path, parameters matching whatever their name and a trailing slash
+// ignored. Endpoints implementing no route are then paired with a route of the
+// same method no endpoint implements whose path is one segment apart from
+// theirs, as a mismatch.
+func (g *Generator) RouteCoverage(c RoutesConfig) CoverageReport {
+	report := CoverageReport{
+		Version:       g.Version,
+		Unimplemented: []Route{},
+		Extra:         []Endpoint{},
+		Mismatched:    []RouteMismatch{},
+	}
+
+	var routes []Route
+	for _, route := range g.Routes(c.Routers) {
+		if ignoredRoute(c.Ignore, route) {
+			report.Ignored++
+			continue
+		}
+		routes = append(routes, route)
+	}
+	endpoints := g.Endpoints(c.Endpoints)
+	report.Routes, report.Endpoints = len(routes), len(endpoints)
+
+	key := func(method string, path string) string {
+		return method + " " + strings.Join(pathSegments(trimTrailingSlash(path)), "/")
+	}
+	implemented := map[string]bool{}
+	for _, endpoint := range endpoints {
+		implemented[key(endpoint.Method, endpoint.Path)] = true
+	}
+	served := map[string]bool{}
+	var unimplemented []Route
+	for _, route := range routes {
+		served[key(route.Method, route.Path)] = true
+		if implemented[key(route.Method, route.Path)] {
+			report.Covered++
+		} else {
+			unimplemented = append(unimplemented, route)
+		}
+	}
+
+	paired := map[int]bool{}
+	for _, endpoint := range endpoints {
+		if served[key(endpoint.Method, endpoint.Path)] {
+			continue
+		}
+		mismatch := -1
+		for i, route := range unimplemented {
+			if !paired[i] && route.Method == endpoint.Method && oneSegmentApart(route.Path, endpoint.Path) {
+				mismatch = i
+				break
+			}
+		}
+		if mismatch < 0 {
+			report.Extra = append(report.Extra, endpoint)
+			continue
+		}
+		paired[mismatch] = true
+		report.Mismatched = append(report.Mismatched, RouteMismatch{unimplemented[mismatch], endpoint})
+	}
+	for i, route := range unimplemented {
+		if !paired[i] {
+			report.Unimplemented = append(report.Unimplemented, route)
+		}
+	}
+	return report
+}
+
+// oneSegmentApart reports whether one segment has to be replaced, inserted or
+// removed to turn a path template into another, like a parameter written as
+// a literal or a missing prefix. A trailing slash is not a segment, and paths
+// without a literal segment in common, like /pprof/ and /secrets/, are
+// unrelated.
+func oneSegmentApart(a string, b string) bool {
+	as, bs := pathSegments(trimTrailingSlash(a)), pathSegments(trimTrailingSlash(b))
+	shared := false
+	for _, segment := range as {
+		if segment != "" && segment != "{}" && slices.Contains(bs, segment) {
+			shared = true
+		}
+	}
+	if !shared {
+		return false
+	}
+	if len(as) < len(bs) {
+		as, bs = bs, as
+	}
+	switch len(as) - len(bs) {
+	case 0:
+		differences := 0
+		for i := range as {
+			if as[i] != bs[i] {
+				differences++
+			}
+		}
+		return differences == 1
+	case 1:
+		i := 0
+		for i < len(bs) && as[i] == bs[i] {
+			i++
+		}
+		return slices.Equal(as[i+1:], bs[i:])
+	}
+	return false
+}
+
+// trimTrailingSlash removes the trailing slash of a path other than /, so
+// /configs and /configs/ are the same route.
+func trimTrailingSlash(path string) string {
+	if len(path) > 1 {
+		return strings.TrimSuffix(path, "/")
+	}
+	return path
+}
+
+// WriteCoverageReport writes a coverage report as JSON, to a file or to
+// stdout when it is "-".
+func WriteCoverageReport(file string, report CoverageReport) error {
+	return writeJSONReport(file, report)
+}
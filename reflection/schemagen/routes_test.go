@@ -0,0 +1,79 @@
+package schemagen
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPathSegments(t *testing.T) {
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"/containers/json", []string{"", "containers", "json"}},
+		{"/containers/{name:.*}/json", []string{"", "containers", "{}", "json"}},
+		{"/containers/:identifier/json", []string{"", "containers", "{}", "json"}},
+		{"/networks/", []string{"", "networks", ""}},
+		{"/{anyroute:.*}", []string{"", "{}"}},
+	}
+	for _, tt := range tests {
+		if got := pathSegments(tt.path); !slices.Equal(got, tt.want) {
+			t.Errorf("pathSegments(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestIgnoredRoute(t *testing.T) {
+	ignore := []string{"GET /debug/...", "POST /grpc"}
+	tests := []struct {
+		method, path string
+		want         bool
+	}{
+		{"GET", "/debug", true},
+		{"GET", "/debug/vars", true},
+		{"GET", "/debug/pprof/heap", true},
+		{"POST", "/debug/vars", false},
+		{"GET", "/debugger", false},
+		{"POST", "/grpc", true},
+		{"POST", "/grpc/stream", false},
+		{"GET", "/grpc", false},
+	}
+	for _, tt := range tests {
+		if got := ignoredRoute(ignore, Route{Method: tt.method, Path: tt.path}); got != tt.want {
+			t.Errorf("ignoredRoute(%s %s) = %v, want %v", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestOneSegmentApart(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"trailing slash", "/configs", "/configs/", false},
+		{"trailing slash on secrets", "/secrets", "/secrets/", false},
+		{"trailing slash on volumes", "/volumes/", "/volumes", false},
+		{"trailing slash and a parameter as a literal", "/volumes/{name:.*}", "/volumes/prune/", true},
+		{"missing prefix", "/commit", "/images/commit", true},
+		{"parameter as a literal", "/containers/{name:.*}/json", "/containers/json/json", true},
+		{"different literal", "/containers/{name:.*}/start", "/containers/{name:.*}/stop", true},
+		{"no literal in common", "/pprof/", "/secrets/", false},
+		{"root", "/", "/_ping", false},
+		{"only parameters in common", "/{name}", "/:identifier/", false},
+		{"same path", "/containers/json", "/containers/json", false},
+		{"two segments replaced", "/containers/{id}/json", "/containers/list/logs", false},
+		{"two segments inserted", "/commit", "/images/v2/commit", false},
+		{"inserted and replaced", "/images/commit", "/containers/{id}/commit/", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := oneSegmentApart(tt.a, tt.b); got != tt.want {
+				t.Errorf("oneSegmentApart(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+			if got := oneSegmentApart(tt.b, tt.a); got != tt.want {
+				t.Errorf("oneSegmentApart(%q, %q) = %v, want %v", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
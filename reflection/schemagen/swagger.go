@@ -0,0 +1,145 @@
+package schemagen
+
+import (
+	"go/types"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type SwaggerSchema struct {
+	Ref         string                    `yaml:"$ref"`
+	Description string                    `yaml:"description"`
+	Properties  map[string]*SwaggerSchema `yaml:"properties"`
+	AllOf       []*SwaggerSchema          `yaml:"allOf"`
+}
+
+type SwaggerSpec struct {
+	Definitions map[string]*SwaggerSchema `yaml:"definitions"`
+}
+
+// swaggerSpec reads the api spec of SwaggerFile from the package it is in, as
+// loaded with the reflected types, so it always describes the same API
+// version as they do. There is no spec when SwaggerFile is not set.
+func (g *Generator) swaggerSpec() *SwaggerSpec {
+	if g.swagger != nil || g.SwaggerFile == "" {
+		return g.swagger
+	}
+	g.swagger = &SwaggerSpec{}
+
+	pkgPath, file := path.Dir(g.SwaggerFile), path.Base(g.SwaggerFile)
+	p, ok := g.packages[pkgPath]
+	if !ok {
+		g.reportAt("missing-swagger", "", "", "%s: package %s is not loaded", g.SwaggerFile, pkgPath)
+		return g.swagger
+	}
+	b, err := os.ReadFile(filepath.Join(p.Dir, file))
+	if err != nil {
+		g.reportAt("missing-swagger", "", "", "%v", err)
+		return g.swagger
+	}
+	err = yaml.Unmarshal(b, g.swagger)
+	if err != nil {
+		g.reportAt("missing-swagger", "", "", "%s: %v", g.SwaggerFile, err)
+	}
+	return g.swagger
+}
+
+var (
+	sinceRegexp        = regexp.MustCompile(`(?i)\b(?:added|introduced|available) (?:in|since) (?:API )?v?(1\.\d+)`)
+	deprecatedInRegexp = regexp.MustCompile(`(?i)\b(?:deprecated (?:since|in|as of)|omitted in) (?:docker [\d.]+ \()?(?:API )?v?(1\.\d+)`)
+)
+
+// swaggerAnnotations extracts the API versions a property description says it
+// was added or deprecated in.
+func swaggerAnnotations(description string) map[string]string {
+	annotations := map[string]string{}
+	if match := sinceRegexp.FindStringSubmatch(description); match != nil {
+		annotations["since"] = match[1]
+	}
+	if match := deprecatedInRegexp.FindStringSubmatch(description); match != nil {
+		annotations["deprecatedIn"] = match[1]
+	}
+	return annotations
+}
+
+// swaggerLookup resolves a definition name, where "Mount.BindOptions" names
+// the inline object schema of the BindOptions property of Mount.
+func (s *SwaggerSpec) lookup(name string) *SwaggerSchema {
+	parts := strings.Split(name, ".")
+	definition := s.Definitions[parts[0]]
+	for _, part := range parts[1:] {
+		if definition == nil {
+			return nil
+		}
+		properties := map[string]*SwaggerSchema{}
+		s.properties(definition, properties)
+		definition = properties[part]
+	}
+	return definition
+}
+
+// swaggerDefinition finds the spec definition describing a reflected type,
+// first by the name it was given with NameSwaggerDefinition, then by generated
+// name and finally by Go name.
+func (g *Generator) swaggerDefinition(spec *SwaggerSpec, t *types.Named, m *TSModelType) *SwaggerSchema {
+	candidates := []string{m.Name(), t.Obj().Name()}
+	if name, ok := g.swaggerNames[typePath(t)]; ok {
+		candidates = []string{name}
+	}
+
+	for _, name := range candidates {
+		if definition := spec.lookup(name); definition != nil {
+			return definition
+		}
+	}
+	return nil
+}
+
+// properties flattens a definition into its properties, following references
+// and allOf compositions.
+func (spec *SwaggerSpec) properties(s *SwaggerSchema, into map[string]*SwaggerSchema) {
+	if s == nil {
+		return
+	}
+	if s.Ref != "" {
+		spec.properties(spec.Definitions[strings.TrimPrefix(s.Ref, "#/definitions/")], into)
+	}
+	for _, part := range s.AllOf {
+		spec.properties(part, into)
+	}
+	for name, property := range s.Properties {
+		into[name] = property
+	}
+}
+
+// annotateFromSwagger copies the since/deprecatedIn versions of every property
+// the api spec documents onto the matching reflected fields.
+func (g *Generator) annotateFromSwagger(t *types.Named, m *TSModelType) {
+	spec := g.swaggerSpec()
+	if spec == nil {
+		return
+	}
+	definition := g.swaggerDefinition(spec, t, m)
+	if definition == nil {
+		return
+	}
+
+	properties := map[string]*SwaggerSchema{}
+	spec.properties(definition, properties)
+
+	for i, p := range m.Properties {
+		property, ok := properties[p.FieldName]
+		if !ok {
+			continue
+		}
+
+		for key, value := range swaggerAnnotations(property.Description) {
+			m.Properties[i].Annotate(key, value)
+		}
+	}
+}
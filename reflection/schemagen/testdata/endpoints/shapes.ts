@@ -0,0 +1,23 @@
+import * as HttpApiEndpoint from "effect/unstable/httpapi/HttpApiEndpoint";
+import * as HttpApiGroup from "effect/unstable/httpapi/HttpApiGroup";
+
+const allShapesEndpoint = HttpApiEndpoint.get("all", "/", {});
+const listShapesEndpoint = HttpApiEndpoint.get("list", "/json", {});
+const inspectShapeEndpoint = HttpApiEndpoint.get("inspect", "/:identifier/json", {});
+const createShapeEndpoint = HttpApiEndpoint.post("create", "/create", {});
+const deleteShapeEndpoint = HttpApiEndpoint.delete("delete", "/:identifier", {});
+const drawShapeEndpoint = HttpApiEndpoint.post("draw", "/:identifier/draw", {});
+const commitShapeEndpoint = HttpApiEndpoint.post("commit", "/commit", {});
+
+const ShapesGroup = HttpApiGroup.make("shapes")
+    .add(
+        allShapesEndpoint,
+        listShapesEndpoint,
+        inspectShapeEndpoint,
+        createShapeEndpoint,
+        deleteShapeEndpoint,
+        drawShapeEndpoint,
+        commitShapeEndpoint,
+        paintShapeEndpoint
+    )
+    .prefix("/shapes");
@@ -0,0 +1,39 @@
+// Package paint declares enums and a type sharing its name with one of
+// package shapes, generated under a package alias.
+package paint
+
+// Color is a named color.
+type Color string
+
+const (
+	ColorRed   Color = "red"
+	ColorGreen Color = "green"
+	ColorBlue  Color = "blue"
+)
+
+// Finish is how glossy a coat is.
+type Finish int
+
+const (
+	FinishMatte Finish = iota
+	FinishSatin
+	FinishGloss
+
+	// Evaluated by the type checker
+	FinishMirror = FinishGloss << 2
+)
+
+// Mode has no declared constants, so it is generated as a plain string.
+type Mode string
+
+// Layer is one coat of paint.
+type Layer struct {
+	Color  Color  `json:"color"`
+	Finish Finish `json:"finish,omitempty"`
+	Mode   Mode   `json:"mode,omitempty"`
+}
+
+// Palette is only used by the router backend, which is how it is generated.
+type Palette struct {
+	Colors []Color `json:"colors"`
+}
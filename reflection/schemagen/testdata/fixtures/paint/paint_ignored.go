@@ -0,0 +1,6 @@
+//go:build ignore
+
+package paint
+
+// ColorNone is not part of any build, so it is not one of the colors.
+const ColorNone Color = ""
@@ -0,0 +1,28 @@
+// Package router declares the backend interfaces the golden tests discover
+// their root types from.
+package router
+
+import (
+	"context"
+
+	coat "github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/paint"
+	"github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/shapes"
+)
+
+// Backend is what the router needs to serve shapes.
+type Backend interface {
+	drawBackend
+
+	// Generic types are not roots, the types of their arguments are
+	Polygons(ctx context.Context, page shapes.Page[shapes.Point]) ([]*shapes.Polygon, error)
+}
+
+// drawBackend is embedded by Backend, so its types are discovered too.
+type drawBackend interface {
+	Draw(ctx context.Context, layers []coat.Layer, mode coat.Mode) (*coat.Palette, error)
+}
+
+// Renderer is not a backend, so its types are not discovered.
+type Renderer interface {
+	Render(labels *shapes.Labels) error
+}
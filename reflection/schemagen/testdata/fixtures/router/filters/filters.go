@@ -0,0 +1,17 @@
+// Package filters parses the filters query parameter the way the filters
+// package of moby does.
+package filters
+
+import "encoding/json"
+
+// Args are the values of every filter.
+type Args map[string][]string
+
+// FromJSON decodes filters from their JSON encoding.
+func FromJSON(p string) (Args, error) {
+	args := Args{}
+	if p == "" {
+		return args, nil
+	}
+	return args, json.Unmarshal([]byte(p), &args)
+}
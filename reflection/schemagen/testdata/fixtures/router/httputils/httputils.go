@@ -0,0 +1,20 @@
+// Package httputils reads query parameters the way the httputils package of
+// moby does.
+package httputils
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// BoolValue parses a query parameter as a boolean.
+func BoolValue(r *http.Request, k string) bool {
+	v, _ := strconv.ParseBool(r.Form.Get(k))
+	return v
+}
+
+// Int64ValueOrZero parses a query parameter as an integer, or zero.
+func Int64ValueOrZero(r *http.Request, k string) int64 {
+	v, _ := strconv.ParseInt(r.Form.Get(k), 10, 64)
+	return v
+}
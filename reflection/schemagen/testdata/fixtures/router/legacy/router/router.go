@@ -0,0 +1,25 @@
+// Package router is a second router package named router, whose query
+// schemas are kept apart from the ones of the first by its import path.
+package router
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/router/route"
+)
+
+type legacyRouter struct {
+	routes []route.Route
+}
+
+func (r *legacyRouter) initRoutes() {
+	r.routes = []route.Route{
+		route.NewGetRoute("/legacy/shapes", r.getLegacyShapes),
+	}
+}
+
+func (r *legacyRouter) getLegacyShapes(ctx context.Context, w http.ResponseWriter, req *http.Request, vars map[string]string) error {
+	_ = req.Form.Get("format")
+	return nil
+}
@@ -0,0 +1,51 @@
+// Package route registers routes the way the router package of moby does.
+package route
+
+import (
+	"context"
+	"net/http"
+)
+
+// Handler serves a route.
+type Handler func(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error
+
+// Option changes a route.
+type Option func(r Route) Route
+
+// Route is a method, path template and handler.
+type Route struct {
+	Method       string
+	Path         string
+	Handler      Handler
+	Experimental bool
+}
+
+// Experimental marks a route as experimental.
+func Experimental(r Route) Route {
+	r.Experimental = true
+	return r
+}
+
+// NewRoute registers a route of any method.
+func NewRoute(method, path string, handler Handler, opts ...Option) Route {
+	r := Route{Method: method, Path: path, Handler: handler}
+	for _, opt := range opts {
+		r = opt(r)
+	}
+	return r
+}
+
+// NewGetRoute registers a GET route.
+func NewGetRoute(path string, handler Handler, opts ...Option) Route {
+	return NewRoute(http.MethodGet, path, handler, opts...)
+}
+
+// NewPostRoute registers a POST route.
+func NewPostRoute(path string, handler Handler, opts ...Option) Route {
+	return NewRoute(http.MethodPost, path, handler, opts...)
+}
+
+// NewDeleteRoute registers a DELETE route.
+func NewDeleteRoute(path string, handler Handler, opts ...Option) Route {
+	return NewRoute(http.MethodDelete, path, handler, opts...)
+}
@@ -0,0 +1,86 @@
+package router
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/router/filters"
+	"github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/router/httputils"
+	"github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/router/route"
+)
+
+// debugPath is not a literal, so its route can not be read.
+const debugPath = "/debug/vars"
+
+type shapesRouter struct {
+	backend Backend
+	routes  []route.Route
+}
+
+func (r *shapesRouter) initRoutes() {
+	r.routes = []route.Route{
+		route.NewGetRoute("/shapes", r.getShapes),
+		route.NewGetRoute("/shapes/json", r.getShapes),
+		route.NewGetRoute("/shapes/{name:.*}/json", r.getShapeByName),
+		route.NewPostRoute("/shapes/create", r.postShapesCreate),
+		route.NewDeleteRoute("/shapes/{name:.*}", r.deleteShape),
+		route.NewRoute("PATCH", "/shapes/{name}/paint", r.patchShapePaint),
+		route.NewPostRoute("/commit", r.postCommit, route.Experimental),
+		route.NewGetRoute("/debug/shapes", r.getShapes),
+		route.NewGetRoute(debugPath, r.getShapes),
+	}
+}
+
+func (r *shapesRouter) getShapes(ctx context.Context, w http.ResponseWriter, req *http.Request, vars map[string]string) error {
+	if _, err := filters.FromJSON(req.Form.Get("filters")); err != nil {
+		return err
+	}
+	_ = httputils.BoolValue(req, "all")
+	if limit := req.Form.Get("limit"); limit != "" {
+		if _, err := strconv.Atoi(limit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *shapesRouter) getShapeByName(ctx context.Context, w http.ResponseWriter, req *http.Request, vars map[string]string) error {
+	return nil
+}
+
+func (r *shapesRouter) postShapesCreate(ctx context.Context, w http.ResponseWriter, req *http.Request, vars map[string]string) error {
+	_, _ = req.FormValue("name"), req.Form["label"]
+	return r.readStyle(req)
+}
+
+// readStyle reads the query parameters of a shape's style, for the handlers
+// creating shapes.
+func (r *shapesRouter) readStyle(req *http.Request) error {
+	_ = httputils.Int64ValueOrZero(req, "sides")
+	_ = req.URL.Query().Get("color")
+	return nil
+}
+
+func (r *shapesRouter) deleteShape(ctx context.Context, w http.ResponseWriter, req *http.Request, vars map[string]string) error {
+	// The name is not a literal, so the parameter can not be read
+	force := "force"
+	_ = req.Form.Get(force)
+	return nil
+}
+
+func (r *shapesRouter) patchShapePaint(ctx context.Context, w http.ResponseWriter, req *http.Request, vars map[string]string) error {
+	if value := req.URL.Query().Get("glossy"); value != "" {
+		if _, err := strconv.ParseBool(value); err != nil {
+			return err
+		}
+	}
+	if value := req.URL.Query().Get("finish"); value != "" {
+		_ = value
+	}
+	return nil
+}
+
+func (r *shapesRouter) postCommit(ctx context.Context, w http.ResponseWriter, req *http.Request, vars map[string]string) error {
+	return nil
+}
@@ -0,0 +1,77 @@
+// Package shapes declares the struct fixtures of the golden tests.
+package shapes
+
+import (
+	"time"
+
+	"github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/paint"
+)
+
+// Point is a position on a plane.
+type Point struct {
+	X int64 `json:"x"`
+	Y int64 `json:"y,omitempty"`
+}
+
+// Meta is embedded by the shapes, its fields are flattened into theirs.
+type Meta struct {
+	ID      string    `json:"id"`
+	Created time.Time `json:"created"`
+
+	// Shadowed by the Name field of the shapes embedding Meta
+	Name string `json:"name"`
+}
+
+// Labels is embedded through a pointer, so its fields are optional.
+type Labels struct {
+	Labels map[string]string `json:"labels"`
+}
+
+// Layer has the same Go name as paint.Layer.
+type Layer struct {
+	Index int           `json:"index"`
+	Shape *Polygon      `json:"shape"`
+	Paint []paint.Layer `json:"paint"`
+}
+
+// Page is a page of a listing, every instantiation is generated as a class
+// of its own.
+type Page[T any] struct {
+	Items []T    `json:"items"`
+	Next  string `json:"next,omitempty"`
+}
+
+// Polygon is a closed shape.
+type Polygon struct {
+	Meta
+	*Labels
+
+	Name string `json:"name"`
+
+	// Vertices are the corners in drawing order
+	Vertices []Point          `json:"vertices"`
+	Center   *Point           `json:"center,omitempty"`
+	Anchors  map[string]Point `json:"anchors,omitempty"`
+	Corners  [4]Point         `json:"corners"`
+	Holes    []*Polygon       `json:"holes,omitempty"`
+	Area     float64          `json:"area,string"`
+	Closed   bool             `json:"closed,omitzero"`
+	Hidden   bool             `json:"-"`
+	Legacy   string           `json:"legacy"`
+
+	// Bounds is hoisted into its own class
+	Bounds struct {
+		Min Point `json:"min"`
+		Max Point `json:"max"`
+	} `json:"bounds"`
+
+	// Style stays inline, it is listed in inlineStructs
+	Style *struct {
+		Stroke string  `json:"stroke"`
+		Width  float32 `json:"width"`
+	} `json:"style,omitempty"`
+
+	Timeout   time.Duration `json:"timeout"`
+	Layers    []Layer       `json:"layers"`
+	Neighbors Page[Point]   `json:"neighbors"`
+}
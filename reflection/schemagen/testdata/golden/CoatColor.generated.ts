@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export const CoatColor = Schema.Literals(["red", "green", "blue"]).annotate({
+    identifier: "CoatColor",
+    title: "paint.Color",
+    documentation: "https://pkg.go.dev/github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/paint#Color",
+    description: "Color is a named color.",
+});
+
+export type CoatColor = Schema.Schema.Type<typeof CoatColor>;
+
+export const CoatColorConstants = Object.freeze({
+    ColorRed: "red",
+    ColorGreen: "green",
+    ColorBlue: "blue",
+} as const);
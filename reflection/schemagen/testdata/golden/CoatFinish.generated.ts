@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+import * as MobyNumber from "../../schemas/number.ts";
+
+export const CoatFinish = MobyNumber.LiteralsFromWireString([0, 1, 2, 8]).annotate({
+    identifier: "CoatFinish",
+    title: "paint.Finish",
+    documentation: "https://pkg.go.dev/github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/paint#Finish",
+    description: "Finish is how glossy a coat is.",
+});
+
+export type CoatFinish = Schema.Schema.Type<typeof CoatFinish>;
+
+export const CoatFinishConstants = Object.freeze({
+    FinishMatte: 0,
+    FinishSatin: 1,
+    FinishGloss: 2,
+    FinishMirror: 8,
+} as const);
@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+import * as CoatColor from "./CoatColor.generated.ts";
+import * as CoatFinish from "./CoatFinish.generated.ts";
+
+export class CoatLayer extends Schema.Class<CoatLayer>("CoatLayer")(
+    {
+        color: CoatColor.CoatColor,
+        finish: Schema.optional(CoatFinish.CoatFinish),
+        mode: Schema.optional(Schema.String),
+    },
+    {
+        identifier: "CoatLayer",
+        title: "paint.Layer",
+        documentation: "https://pkg.go.dev/github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/paint#Layer",
+        description: "Layer is one coat of paint.",
+    }
+) {}
@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+import * as CoatColor from "./CoatColor.generated.ts";
+
+export class CoatPalette extends Schema.Class<CoatPalette>("CoatPalette")(
+    {
+        colors: Schema.NullOr(Schema.Array(CoatColor.CoatColor)),
+    },
+    {
+        identifier: "CoatPalette",
+        title: "paint.Palette",
+        documentation: "https://pkg.go.dev/github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/paint#Palette",
+        description: "Palette is only used by the router backend, which is how it is generated.",
+    }
+) {}
@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class Point extends Schema.Class<Point>("Point")(
+    {
+        x: MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })),
+        y: Schema.optional(MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n }))),
+    },
+    {
+        identifier: "Point",
+        title: "shapes.Point",
+        documentation: "https://pkg.go.dev/github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/shapes#Point",
+        description: "Point is a position on a plane.",
+    }
+) {}
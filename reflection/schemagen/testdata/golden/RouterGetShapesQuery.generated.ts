@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export const RouterGetShapesQuery = Schema.Struct({
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+    all: Schema.optional(Schema.Boolean),
+    limit: Schema.optional(Schema.Finite),
+}).annotate({
+    identifier: "RouterGetShapesQuery",
+    title: "router.getShapes",
+    description: "Query parameters of GET /debug/shapes, GET /shapes, GET /shapes/json",
+});
+
+export type RouterGetShapesQuery = Schema.Schema.Type<typeof RouterGetShapesQuery>;
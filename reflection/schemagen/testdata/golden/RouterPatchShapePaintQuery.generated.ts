@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export const RouterPatchShapePaintQuery = Schema.Struct({
+    glossy: Schema.optional(Schema.Boolean),
+    finish: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "RouterPatchShapePaintQuery",
+    title: "router.patchShapePaint",
+    description: "Query parameters of PATCH /shapes/{name}/paint",
+});
+
+export type RouterPatchShapePaintQuery = Schema.Schema.Type<typeof RouterPatchShapePaintQuery>;
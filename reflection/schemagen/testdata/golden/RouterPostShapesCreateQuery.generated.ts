@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export const RouterPostShapesCreateQuery = Schema.Struct({
+    name: Schema.optional(Schema.String),
+    label: Schema.optional(Schema.Array(Schema.String)),
+    sides: Schema.optional(Schema.Finite),
+    color: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "RouterPostShapesCreateQuery",
+    title: "router.postShapesCreate",
+    description: "Query parameters of POST /shapes/create",
+});
+
+export type RouterPostShapesCreateQuery = Schema.Schema.Type<typeof RouterPostShapesCreateQuery>;
@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export class ShapesLabels extends Schema.Class<ShapesLabels>("ShapesLabels")(
+    {
+        labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+    },
+    {
+        identifier: "ShapesLabels",
+        title: "shapes.Labels",
+        documentation: "https://pkg.go.dev/github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/shapes#Labels",
+        description: "Labels is embedded through a pointer, so its fields are optional.",
+    }
+) {}
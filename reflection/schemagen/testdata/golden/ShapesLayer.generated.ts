@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+import * as MobyNumber from "../../schemas/number.ts";
+import * as CoatLayer from "./CoatLayer.generated.ts";
+import * as ShapesPolygon from "./ShapesPolygon.generated.ts";
+
+export class ShapesLayer extends Schema.Class<ShapesLayer>("ShapesLayer")(
+    {
+        index: MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })),
+        shape: Schema.NullOr(Schema.suspend((): Schema.Codec<ShapesPolygon.ShapesPolygon, unknown> => ShapesPolygon.ShapesPolygon)),
+        paint: Schema.NullOr(Schema.Array(Schema.NullOr(CoatLayer.CoatLayer))),
+    },
+    {
+        identifier: "ShapesLayer",
+        title: "shapes.Layer",
+        documentation: "https://pkg.go.dev/github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/shapes#Layer",
+        description: "Layer has the same Go name as paint.Layer.",
+    }
+) {}
@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+
+export class ShapesMeta extends Schema.Class<ShapesMeta>("ShapesMeta")(
+    {
+        id: Schema.String,
+        created: Schema.NullOr(Schema.DateFromString),
+        name: Schema.String.annotate({ description: "Shadowed by the Name field of the shapes embedding Meta" }),
+    },
+    {
+        identifier: "ShapesMeta",
+        title: "shapes.Meta",
+        documentation: "https://pkg.go.dev/github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/shapes#Meta",
+        description: "Meta is embedded by the shapes, its fields are flattened into theirs.",
+    }
+) {}
@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+import * as Point from "./Point.generated.ts";
+
+export class ShapesPageShapesPoint extends Schema.Class<ShapesPageShapesPoint>("ShapesPageShapesPoint")(
+    {
+        items: Schema.NullOr(Schema.Array(Schema.NullOr(Point.Point))),
+        next: Schema.optional(Schema.String),
+    },
+    {
+        identifier: "ShapesPageShapesPoint",
+        title: "shapes.Page[shapes.Point]",
+        documentation: "https://pkg.go.dev/github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/shapes#Page",
+        description: "Page is a page of a listing, every instantiation is generated as a class\nof its own.",
+    }
+) {}
@@ -0,0 +1,41 @@
+import * as Effect from "effect/Effect";
+import * as Schema from "effect/Schema";
+import * as MobyNumber from "../../schemas/number.ts";
+import * as MobyQuoted from "../../schemas/quoted.ts";
+import * as Point from "./Point.generated.ts";
+import * as ShapesLayer from "./ShapesLayer.generated.ts";
+import * as ShapesPageShapesPoint from "./ShapesPageShapesPoint.generated.ts";
+import * as ShapesPolygonBounds from "./ShapesPolygonBounds.generated.ts";
+import { Measured } from "../../schemas/measured.ts";
+
+export class ShapesPolygon extends Measured(Schema.Class<ShapesPolygon>("ShapesPolygon")(
+    {
+        id: Schema.String,
+        created: Schema.NullOr(Schema.DateFromString),
+        labels: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.String))),
+        name: Schema.String.pipe(Schema.withConstructorDefault(Effect.sync(() => "polygon"))),
+        vertices: Schema.NullOr(Schema.Array(Schema.NullOr(Point.Point))).annotate({ description: "Vertices are the corners in drawing order" }).pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        center: Schema.optional(Schema.NullOr(Point.Point)),
+        anchors: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.NullOr(Point.Point)))),
+        corners: Schema.Array(Schema.NullOr(Point.Point)).check(Schema.isLengthBetween(4, 4)),
+        holes: Schema.optional(Schema.NullOr(Schema.Array(Schema.NullOr(Schema.suspend((): Schema.Codec<ShapesPolygon, unknown> => ShapesPolygon))))),
+        area: MobyQuoted.NumberFromQuotedString,
+        closed: Schema.optional(Schema.Boolean),
+        legacy: Schema.Literal("v1"),
+        bounds: ShapesPolygonBounds.ShapesPolygonBounds.annotate({ description: "Bounds is hoisted into its own class" }),
+        style: Schema.optional(Schema.NullOr(Schema.Struct({
+        stroke: Schema.Literal("solid", "dashed"),
+        width: MobyNumber.NumberFromWireString.pipe(Schema.withConstructorDefault(Effect.sync(() => 1))),
+})
+).annotate({ description: "Style stays inline, it is listed in inlineStructs" })),
+        timeout: MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })),
+        layers: Schema.NullOr(Schema.Array(Schema.NullOr(Schema.suspend((): Schema.Codec<ShapesLayer.ShapesLayer, unknown> => ShapesLayer.ShapesLayer)))),
+        neighbors: Schema.NullOr(ShapesPageShapesPoint.ShapesPageShapesPoint),
+    },
+    {
+        identifier: "ShapesPolygon",
+        title: "shapes.Polygon",
+        documentation: "https://pkg.go.dev/github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/shapes#Polygon",
+        description: "Polygon is a closed shape.",
+    }
+)) {}
@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+import * as Point from "./Point.generated.ts";
+
+export class ShapesPolygonBounds extends Schema.Class<ShapesPolygonBounds>("ShapesPolygonBounds")(
+    {
+        min: Schema.NullOr(Point.Point),
+        max: Schema.NullOr(Point.Point),
+    },
+    {
+        identifier: "ShapesPolygonBounds",
+        title: "shapes.Polygon.Bounds",
+        documentation: "https://pkg.go.dev/github.com/leonitousconforti/the-moby-effect/reflection/schemagen/testdata/fixtures/shapes#Polygon",
+        description: "Bounds is hoisted into its own class",
+    }
+) {}
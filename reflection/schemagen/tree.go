@@ -0,0 +1,489 @@
+package schemagen
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/docker/docker/api/types/versions"
+)
+
+// writeGeneratedFile writes a file into dir through a temporary file so that
+// readers never observe a partially written schema.
+func writeGeneratedFile(dir string, name string, write func(w io.Writer)) error {
+	f, err := os.CreateTemp(dir, "")
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	b := bufio.NewWriter(f)
+	write(b)
+	err = b.Flush()
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	f.Close()
+	err = os.Chmod(f.Name(), 0644)
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	err = os.Rename(f.Name(), path.Join(dir, name))
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return nil
+}
+
+// apiVersionsIn returns the versions of the trees found under root, oldest
+// first.
+func apiVersionsIn(root string) ([]string, error) {
+	entries, err := os.ReadDir(root)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	var apiVersions []string
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), "v") {
+			apiVersions = append(apiVersions, strings.TrimPrefix(entry.Name(), "v"))
+		}
+	}
+	sort.Slice(apiVersions, func(i, j int) bool {
+		return versions.LessThan(apiVersions[i], apiVersions[j])
+	})
+	return apiVersions, nil
+}
+
+// writeRootIndex re-exports every versioned tree, each as its own namespace,
+// and the newest tree flat for code that does not negotiate.
+func writeRootIndex(apiVersions []string) func(w io.Writer) {
+	return func(w io.Writer) {
+		latest := apiVersions[len(apiVersions)-1]
+		fmt.Fprintf(w, "export * from \"./v%s/index.ts\";\n\n", latest)
+
+		quoted := make([]string, 0, len(apiVersions))
+		for _, v := range apiVersions {
+			fmt.Fprintf(w, "export * as V%s from \"./v%s/index.ts\";\n", strings.ReplaceAll(v, ".", "_"), v)
+			quoted = append(quoted, fmt.Sprintf("%q", v))
+		}
+		fmt.Fprintf(w, "\nexport const ApiVersions = [%s] as const;\n", strings.Join(quoted, ", "))
+	}
+}
+
+// renderVersion returns the renderer of every file of the tree of the API
+// version, keyed by file name. The files are rendered concurrently, so a
+// renderer only reads the models.
+func (g *Generator) renderVersion() map[string]func(w io.Writer) {
+	files := map[string]func(w io.Writer){}
+	var modules []string
+	g.reflectedTypes.Iterate(func(_ types.Type, value any) {
+		v := value.(*TSModelType)
+		v.Extensions = g.discoverExtensions(v)
+		files[v.Name()+".generated.ts"] = func(w io.Writer) { v.WriteClass(w, g.imports) }
+		modules = append(modules, v.Name())
+	})
+	g.reflectedEnums.Iterate(func(_ types.Type, value any) {
+		if e := value.(*TSEnumType); e != nil {
+			files[e.Name()+".generated.ts"] = func(w io.Writer) { e.WriteEnum(w, g.imports) }
+			modules = append(modules, e.Name())
+		}
+	})
+
+	for _, q := range g.queryTypes {
+		files[q.Name()+".generated.ts"] = func(w io.Writer) { q.WriteQuery(w, g.imports) }
+		modules = append(modules, q.Name())
+	}
+
+	sort.Strings(modules)
+	files["index.ts"] = func(w io.Writer) {
+		for _, name := range modules {
+			fmt.Fprintln(w, "export * from \"./"+name+".generated.ts\";")
+		}
+		fmt.Fprintf(w, "\nexport const ApiVersion = %q as const;\n", g.Version)
+	}
+	return files
+}
+
+// runFormatter runs the configured formatter over the given paths.
+func runFormatter(format []string, paths ...string) error {
+	if len(format) == 0 {
+		return nil
+	}
+	cmd := exec.Command(format[0], append(format[1:], paths...)...)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("formatting %s: %w", strings.Join(paths, " "), err)
+	}
+	return nil
+}
+
+// stageVersion renders the tree of this API version into a new directory next
+// to the tree it replaces, and formats it there so the formatter config
+// applies. A tree that can not be written or formatted is reported and
+// removed, and no tree is returned.
+func (g *Generator) stageVersion(files map[string]func(w io.Writer)) (string, bool) {
+	stage, err := g.makeStage()
+	if err != nil {
+		g.reportAt("write-failed", "", "", "staging the tree of API version %s: %v", g.Version, err)
+		return "", false
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	err = forEachParallel(names, func(name string) error {
+		return writeGeneratedFile(stage, name, files[name])
+	})
+	if err != nil {
+		os.RemoveAll(stage)
+		g.reportAt("write-failed", "", "", "staging the tree of API version %s: %v", g.Version, err)
+		return "", false
+	}
+	err = runFormatter(g.Format, stage)
+	if err != nil {
+		os.RemoveAll(stage)
+		g.reportAt("format-failed", "", "", "%v", err)
+		return "", false
+	}
+	err = g.preserveExtensions(stage)
+	if err != nil {
+		os.RemoveAll(stage)
+		g.reportAt("write-failed", "", "", "staging the tree of API version %s: %v", g.Version, err)
+		return "", false
+	}
+	return stage, true
+}
+
+// makeStage creates the directory a tree is staged in, next to the tree it
+// replaces.
+func (g *Generator) makeStage() (string, error) {
+	err := os.MkdirAll(g.Output, 0755)
+	if err != nil {
+		return "", err
+	}
+	stage, err := os.MkdirTemp(g.Output, ".v"+g.Version+"-")
+	if err != nil {
+		return "", err
+	}
+	err = os.Chmod(stage, 0755)
+	if err != nil {
+		os.RemoveAll(stage)
+		return "", err
+	}
+	return stage, nil
+}
+
+// checkGenerated compares a staged tree and the root index with the files on
+// disk, and prints a unified diff for every file that is stale, missing or
+// unexpected, in the tree or anywhere strayFiles finds one. It reports whether
+// everything is current, files that can not be read are reported.
+func (g *Generator) checkGenerated(stage string, rootIndex []byte) bool {
+	versionDir := "v" + g.Version
+	failed := func(err error) bool {
+		g.reportAt("read-failed", "", "", "checking the tree of API version %s: %v", g.Version, err)
+		return false
+	}
+
+	// Every file that is generated, or on disk where it would be
+	names := map[string]bool{}
+	for _, dir := range []string{stage, path.Join(g.Output, versionDir)} {
+		entries, err := os.ReadDir(dir)
+		if err != nil && !os.IsNotExist(err) {
+			return failed(err)
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				names[entry.Name()] = true
+			}
+		}
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	compare := func(name string, current []byte, currentName string, expected []byte, expectedName string) string {
+		if currentName != "/dev/null" {
+			currentName = "a/" + name
+		}
+		if expectedName != "/dev/null" {
+			expectedName = "b/" + name
+		}
+		diff := unifiedDiff(currentName, current, expectedName, expected)
+		if diff == "" && currentName != expectedName && (currentName == "/dev/null" || expectedName == "/dev/null") {
+			// An empty file that exists on one side only
+			diff = fmt.Sprintf("--- %s\n+++ %s\n", currentName, expectedName)
+		}
+		return diff
+	}
+
+	// Files are compared concurrently, and their diffs printed in order
+	diffs := make([]string, len(sorted)+1)
+	current, currentName, err := readGenerated(path.Join(g.Output, "index.ts"))
+	if err != nil {
+		return failed(err)
+	}
+	diffs[0] = compare("index.ts", current, currentName, rootIndex, "index.ts")
+	indexes := make([]int, len(sorted))
+	for i := range sorted {
+		indexes[i] = i
+	}
+	err = forEachParallel(indexes, func(i int) error {
+		current, currentName, err := readGenerated(path.Join(g.Output, versionDir, sorted[i]))
+		if err != nil {
+			return err
+		}
+		expected, expectedName, err := readGenerated(path.Join(stage, sorted[i]))
+		if err != nil {
+			return err
+		}
+		diffs[i+1] = compare(path.Join(versionDir, sorted[i]), current, currentName, expected, expectedName)
+		return nil
+	})
+	if err != nil {
+		return failed(err)
+	}
+
+	stray, err := g.strayFiles()
+	if err != nil {
+		return failed(err)
+	}
+	for _, file := range stray {
+		current, _, err := readGenerated(path.Join(g.Output, file))
+		if err != nil {
+			return failed(err)
+		}
+		diffs = append(diffs, compare(file, current, file, nil, "/dev/null"))
+	}
+
+	stale := 0
+	for _, diff := range diffs {
+		if diff != "" {
+			fmt.Print(diff)
+			stale++
+		}
+	}
+
+	if stale > 0 {
+		fmt.Fprintf(os.Stderr, "%d generated files under %s are out of date\n", stale, g.Output)
+	}
+	return stale == 0
+}
+
+// strayFiles lists the generated files and indexes under the output directory,
+// relative to it, that no tree is generated into: the ones at its root besides
+// the root index, like the files of the unversioned tree, and the ones in
+// directories of this tree or of no API version. The trees of the other API
+// versions are left to their own runs, and hidden directories to the runs
+// that staged them.
+func (g *Generator) strayFiles() ([]string, error) {
+	versionDir := "v" + g.Version
+	var stray []string
+	err := filepath.WalkDir(g.Output, func(file string, entry os.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return filepath.SkipAll
+			}
+			return err
+		}
+		rel, err := filepath.Rel(g.Output, file)
+		if err != nil {
+			return err
+		}
+		dir, name := filepath.Split(rel)
+		dir = filepath.Clean(dir)
+		if entry.IsDir() {
+			switch {
+			case rel == ".":
+				return nil
+			case strings.HasPrefix(name, "."):
+				return filepath.SkipDir
+			case dir == "." && strings.HasPrefix(name, "v") && name != versionDir:
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		switch {
+		case !strings.HasSuffix(name, ".generated.ts") && name != "index.ts":
+		case dir == "." && name == "index.ts":
+		case dir == versionDir:
+		default:
+			stray = append(stray, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	return stray, err
+}
+
+// removeStrayFiles removes the files strayFiles lists, so nothing is left of
+// the trees generated before, like the unversioned tree.
+func (g *Generator) removeStrayFiles() error {
+	stray, err := g.strayFiles()
+	if err != nil {
+		return err
+	}
+	for _, file := range stray {
+		err := os.Remove(path.Join(g.Output, file))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// swapVersion replaces the tree of this API version with a staged tree.
+// Staged files that are unchanged take the modification time of the file they
+// replace, so watchers do not see them change. The previous tree is moved
+// aside before the staged tree takes its place, and is restored by the next
+// run if the swap is interrupted in between.
+func (g *Generator) swapVersion(stage string) error {
+	target := path.Join(g.Output, "v"+g.Version)
+	previous := path.Join(g.Output, ".v"+g.Version+".previous")
+
+	entries, err := os.ReadDir(stage)
+	if err != nil {
+		return err
+	}
+	err = forEachParallel(entries, func(entry os.DirEntry) error {
+		current, err := os.ReadFile(path.Join(target, entry.Name()))
+		if err != nil {
+			return nil
+		}
+		staged, err := os.ReadFile(path.Join(stage, entry.Name()))
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(current, staged) {
+			return nil
+		}
+		info, err := os.Stat(path.Join(target, entry.Name()))
+		if err != nil {
+			return err
+		}
+		return os.Chtimes(path.Join(stage, entry.Name()), info.ModTime(), info.ModTime())
+	})
+	if err != nil {
+		return err
+	}
+
+	err = os.RemoveAll(previous)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(target); err == nil {
+		err = os.Rename(target, previous)
+		if err != nil {
+			return err
+		}
+	}
+	err = os.Rename(stage, target)
+	if err != nil {
+		// The previous tree is put back by the next run
+		return err
+	}
+	os.RemoveAll(previous)
+	return nil
+}
+
+// restoreVersion puts back the previous tree of this API version when a swap
+// was interrupted after moving it aside, and removes abandoned staged trees.
+func (g *Generator) restoreVersion() error {
+	target := path.Join(g.Output, "v"+g.Version)
+	previous := path.Join(g.Output, ".v"+g.Version+".previous")
+
+	if _, err := os.Stat(target); os.IsNotExist(err) {
+		if _, err := os.Stat(previous); err == nil {
+			err = os.Rename(previous, target)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	abandoned, err := filepath.Glob(path.Join(g.Output, ".v"+g.Version+"-*"))
+	if err != nil {
+		return err
+	}
+	for _, dir := range abandoned {
+		os.RemoveAll(dir)
+	}
+	return nil
+}
+
+// writeIfChanged writes a file unless it already has the rendered content.
+func writeIfChanged(dir string, name string, content []byte) error {
+	current, err := os.ReadFile(path.Join(dir, name))
+	if err == nil && bytes.Equal(current, content) {
+		return nil
+	}
+	return writeGeneratedFile(dir, name, func(w io.Writer) { w.Write(content) })
+}
+
+// readGenerated reads a file for comparison, a missing file is empty and
+// named /dev/null.
+func readGenerated(file string) ([]byte, string, error) {
+	b, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil, "/dev/null", nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return b, file, nil
+}
+
+// forEachParallel calls work with every item, on as many goroutines as there
+// are CPUs, and returns once all calls are done, with the error of the first
+// item that failed. A panic in any call is raised again in the caller, the
+// one of the first item that panicked.
+func forEachParallel[T any](items []T, work func(item T) error) error {
+	errs := make([]error, len(items))
+	panics := make([]any, len(items))
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for range min(runtime.GOMAXPROCS(0), len(items)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := int(next.Add(1)) - 1; i < len(items); i = int(next.Add(1)) - 1 {
+				func() {
+					defer func() { panics[i] = recover() }()
+					errs[i] = work(items[i])
+				}()
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, p := range panics {
+		if p != nil {
+			panic(p)
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
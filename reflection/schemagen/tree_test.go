@@ -0,0 +1,218 @@
+package schemagen
+
+import (
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// captureStdout returns what run prints to stdout.
+func captureStdout(t *testing.T, run func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	printed := make(chan string)
+	go func() {
+		b, _ := io.ReadAll(r)
+		printed <- string(b)
+	}()
+	run()
+	w.Close()
+	return <-printed
+}
+
+// TestCheck generates the fixtures, then makes one file stale, removes
+// another and adds unexpected ones, in the tree and next to it, and checks
+// that a check run fails with a diff of each, and of nothing else.
+func TestCheck(t *testing.T) {
+	output := t.TempDir()
+	if g, _ := newFixtureGenerator(t, output); !g.Generate(false) {
+		t.Fatalf("generating the fixtures failed: %v", g.Diagnostics())
+	}
+
+	g, _ := newFixtureGenerator(t, output)
+	var ok bool
+	if diff := captureStdout(t, func() { ok = g.Generate(true) }); !ok || diff != "" {
+		t.Fatalf("checking a current tree failed:\n%s", diff)
+	}
+
+	tree := filepath.Join(output, "v1.0")
+	stale := filepath.Join(tree, "Point.generated.ts")
+	b, err := os.ReadFile(stale)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(stale, append(b, "// edited\n"...), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(tree, "ShapesLayer.generated.ts")); err != nil {
+		t.Fatal(err)
+	}
+	for _, extra := range []string{filepath.Join(tree, "Extra.generated.ts"), filepath.Join(output, "Flat.generated.ts")} {
+		if err := os.WriteFile(extra, []byte("export const Extra = 1;\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	g, _ = newFixtureGenerator(t, output)
+	diff := captureStdout(t, func() { ok = g.Generate(true) })
+	if ok {
+		t.Fatal("checking a stale tree succeeded")
+	}
+	for _, want := range []string{
+		"--- a/Flat.generated.ts\n+++ /dev/null\n@@ -1,1 +0,0 @@\n-export const Extra = 1;\n",
+		"--- a/v1.0/Extra.generated.ts\n+++ /dev/null\n@@ -1,1 +0,0 @@\n-export const Extra = 1;\n",
+		"--- a/v1.0/Point.generated.ts\n+++ b/v1.0/Point.generated.ts\n",
+		"-// edited\n",
+		"--- /dev/null\n+++ b/v1.0/ShapesLayer.generated.ts\n@@ -0,0 +1,",
+	} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("the diff has no\n%s\nin\n%s", want, diff)
+		}
+	}
+	if files := strings.Count(diff, "\n--- ") + 1; files != 4 {
+		t.Errorf("the diff has %d files, want 4:\n%s", files, diff)
+	}
+}
+
+// readTree reads every file under a directory, by path relative to it.
+func readTree(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	files := map[string]string{}
+	err := filepath.WalkDir(dir, func(file string, entry os.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, file)
+		files[filepath.ToSlash(rel)] = string(b)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+// TestFailedRunKeepsTree checks that a run failing with a diagnostic, or
+// with a formatter failing on the staged tree, leaves the previous tree as it
+// was, without the staged one, and that a file that can not be written is
+// reported.
+func TestFailedRunKeepsTree(t *testing.T) {
+	output := t.TempDir()
+	if g, _ := newFixtureGenerator(t, output); !g.Generate(false) {
+		t.Fatalf("generating the fixtures failed: %v", g.Diagnostics())
+	}
+	before := readTree(t, output)
+
+	g, _ := newFixtureGenerator(t, output)
+	g.AddRoots("example.com/missing.Type")
+	if g.Generate(false) {
+		t.Fatal("generating an undeclared root succeeded")
+	}
+	if after := readTree(t, output); !maps.Equal(before, after) {
+		t.Errorf("a run failing with a diagnostic changed the tree")
+	}
+
+	g, _ = newFixtureGenerator(t, output)
+	g.Format = []string{"false"}
+	if g.Generate(false) {
+		t.Error("a failing formatter did not fail the run")
+	}
+	if !hasDiagnostic(g, "format-failed") {
+		t.Errorf("a failing formatter was not reported: %v", g.Diagnostics())
+	}
+	if after := readTree(t, output); !maps.Equal(before, after) {
+		t.Errorf("a run failing while staging changed the tree")
+	}
+
+	// A directory takes the place of the root index
+	output = t.TempDir()
+	if err := os.Mkdir(filepath.Join(output, "index.ts"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	g, _ = newFixtureGenerator(t, output)
+	if g.Generate(false) {
+		t.Error("writing the root index over a directory succeeded")
+	}
+	if !hasDiagnostic(g, "write-failed") {
+		t.Errorf("a root index that can not be written was not reported: %v", g.Diagnostics())
+	}
+}
+
+// hasDiagnostic reports whether a run recorded a diagnostic with the code.
+func hasDiagnostic(g *Generator, code string) bool {
+	return slices.ContainsFunc(g.Diagnostics(), func(d Diagnostic) bool { return d.Code == code })
+}
+
+// TestRestoreInterruptedSwap moves the tree aside the way an interrupted swap
+// does, next to an abandoned staged tree, and checks that the next run puts
+// the tree back and removes the staged one, even when it fails itself.
+func TestRestoreInterruptedSwap(t *testing.T) {
+	output := t.TempDir()
+	if g, _ := newFixtureGenerator(t, output); !g.Generate(false) {
+		t.Fatalf("generating the fixtures failed: %v", g.Diagnostics())
+	}
+	before := readTree(t, output)
+
+	if err := os.Rename(filepath.Join(output, "v1.0"), filepath.Join(output, ".v1.0.previous")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(output, ".v1.0-123"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(output, ".v1.0-123", "Point.generated.ts"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	g, _ := newFixtureGenerator(t, output)
+	g.AddRoots("example.com/missing.Type")
+	if g.Generate(false) {
+		t.Fatal("generating an undeclared root succeeded")
+	}
+	if after := readTree(t, output); !maps.Equal(before, after) {
+		t.Errorf("the previous tree was not restored, the output has %v", slices.Sorted(maps.Keys(after)))
+	}
+}
+
+// TestUnchangedFilesKeepModTime regenerates the fixtures and checks that no
+// file, all unchanged, is touched.
+func TestUnchangedFilesKeepModTime(t *testing.T) {
+	output := t.TempDir()
+	if g, _ := newFixtureGenerator(t, output); !g.Generate(false) {
+		t.Fatalf("generating the fixtures failed: %v", g.Diagnostics())
+	}
+	generated := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	for file := range readTree(t, output) {
+		if err := os.Chtimes(filepath.Join(output, file), generated, generated); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if g, _ := newFixtureGenerator(t, output); !g.Generate(false) {
+		t.Fatalf("regenerating the fixtures failed: %v", g.Diagnostics())
+	}
+	for file := range readTree(t, output) {
+		info, err := os.Stat(filepath.Join(output, file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !info.ModTime().Equal(generated) {
+			t.Errorf("%s was modified at %v by a run that did not change it", file, info.ModTime())
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package schemagen
+
+import (
+	"bytes"
+	"fmt"
+	"go/constant"
+	"go/types"
+	"io"
+	"reflect"
+	"strings"
+)
+
+type ConstantInfo struct {
+	Name  string
+	Value string
+}
+
+// TSEnumType is a named Go type with a set of declared constants. It is
+// emitted as its own module holding a literal schema of the constant values
+// and an object of the constants keyed by their Go names.
+type TSEnumType struct {
+	GoSourceName string
+	GoPkgPath    string
+	Description  string
+	Numeric      bool
+	Constants    []ConstantInfo
+
+	// Source is where the Go type is declared.
+	Source string
+
+	name    string
+	docLink string
+}
+
+func isIntegerKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+// reflectEnum returns the enum for a named string or integer type, or nil when
+// the type has no declared constants.
+func (g *Generator) reflectEnum(t *types.Named) *TSEnumType {
+	if enum := g.reflectedEnums.At(t); enum != nil {
+		return enum.(*TSEnumType)
+	}
+
+	constants := g.getEnumLiterals(t)
+	if len(constants) == 0 {
+		if kindOf(t) == reflect.String {
+			g.record("no-enum-literals", typePath(t), "", g.source(t.Obj()), "no constants declared, generated as a string")
+		}
+		g.reflectedEnums.Set(t, (*TSEnumType)(nil))
+		return nil
+	}
+
+	pkgPath := t.Obj().Pkg().Path()
+	enum := &TSEnumType{
+		GoSourceName: goSourceName(t),
+		GoPkgPath:    pkgPath,
+		Description:  g.typeDescription(t.Obj()),
+		Numeric:      isIntegerKind(kindOf(t)),
+		Constants:    constants,
+		Source:       g.source(t.Obj()),
+		name:         g.tsName(pkgPath, goSourceName(t)),
+		docLink:      g.generateDocLink(pkgPath, t.Obj().Name()),
+	}
+	g.claimName(enum.GoPkgPath, enum.GoSourceName, enum.Name(), enum.Name()+"Constants")
+	g.reflectedEnums.Set(t, enum)
+	return enum
+}
+
+// getEnumLiterals returns every constant of type t declared in its package,
+// in declaration order across the files of the package, with the value the
+// type checker evaluated. Types whose constants are declared untyped use the
+// constants declared with DeclareEnumConstants instead.
+func (g *Generator) getEnumLiterals(t *types.Named) []ConstantInfo {
+	declared := g.packageConstants(t.Obj().Pkg())[t.Obj()]
+	if len(declared) == 0 {
+		scope := t.Obj().Pkg().Scope()
+		for _, name := range g.untypedEnums[typePath(t)] {
+			if c, ok := scope.Lookup(name).(*types.Const); ok {
+				declared = append(declared, c)
+			}
+		}
+	}
+
+	var allConstants []ConstantInfo
+	for _, c := range declared {
+		if c.Val().Kind() != constant.Unknown {
+			allConstants = append(allConstants, constantInfo(c.Name(), c.Val()))
+		}
+	}
+	return allConstants
+}
+
+// constantInfo formats an evaluated constant, strings by their contents and
+// numbers by their exact decimal form.
+func constantInfo(name string, v constant.Value) ConstantInfo {
+	if v.Kind() == constant.String {
+		return ConstantInfo{Name: name, Value: constant.StringVal(v)}
+	}
+	return ConstantInfo{Name: name, Value: v.ExactString()}
+}
+
+func (e *TSEnumType) Name() string {
+	return e.name
+}
+
+func (e *TSEnumType) Title() string {
+	return e.GoSourceName
+}
+
+func (e *TSEnumType) Documentation() string {
+	return e.docLink
+}
+
+// Literals returns the distinct constant values as TS literals, in
+// declaration order.
+func (e *TSEnumType) Literals() []string {
+	var literals []string
+	seen := map[string]bool{}
+	for _, c := range e.Constants {
+		if seen[c.Value] {
+			continue
+		}
+		seen[c.Value] = true
+		if e.Numeric {
+			literals = append(literals, c.Value)
+		} else {
+			literals = append(literals, fmt.Sprintf("%q", c.Value))
+		}
+	}
+	return literals
+}
+
+func (e *TSEnumType) WriteEnum(w io.Writer, imports []tsImport) {
+	var buffer bytes.Buffer
+	literals := strings.Join(e.Literals(), ", ")
+	if e.Numeric {
+		buffer.WriteString(fmt.Sprintf("export const %s = MobyNumber.LiteralsFromWireString([%s]).annotate({\n", e.Name(), literals))
+	} else if len(e.Literals()) == 1 {
+		buffer.WriteString(fmt.Sprintf("export const %s = Schema.Literal(%s).annotate({\n", e.Name(), literals))
+	} else {
+		buffer.WriteString(fmt.Sprintf("export const %s = Schema.Literals([%s]).annotate({\n", e.Name(), literals))
+	}
+	buffer.WriteString(fmt.Sprintf("    identifier: \"%s\",\n", e.Name()))
+	buffer.WriteString(fmt.Sprintf("    title: \"%s\",\n", e.Title()))
+	buffer.WriteString(fmt.Sprintf("    documentation: \"%s\",\n", e.Documentation()))
+	if e.Description != "" {
+		buffer.WriteString(fmt.Sprintf("    description: %q,\n", e.Description))
+	}
+	buffer.WriteString(fmt.Sprintln("});"))
+	buffer.WriteString(fmt.Sprintln())
+	buffer.WriteString(fmt.Sprintf("export type %s = Schema.Schema.Type<typeof %s>;\n", e.Name(), e.Name()))
+	buffer.WriteString(fmt.Sprintln())
+	buffer.WriteString(fmt.Sprintf("export const %sConstants = Object.freeze({\n", e.Name()))
+	for _, c := range e.Constants {
+		if e.Numeric {
+			buffer.WriteString(fmt.Sprintf("    %s: %s,\n", c.Name, c.Value))
+		} else {
+			buffer.WriteString(fmt.Sprintf("    %s: %q,\n", c.Name, c.Value))
+		}
+	}
+	buffer.WriteString(fmt.Sprintln("} as const);"))
+
+	outString := buffer.String()
+	writeKnownImports(w, imports, outString)
+	fmt.Fprintf(w, "\n")
+	fmt.Fprint(w, outString)
+}
@@ -0,0 +1,492 @@
+package schemagen
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+type TSType struct {
+	StrRepresentation string
+	Nullable          bool
+}
+
+type TSProperty struct {
+	FieldName    string
+	Type         TSType
+	IsOpt        bool
+	DefaultValue string
+	Annotations  map[string]string
+
+	// Source is where the Go field is declared, like
+	// "github.com/docker/docker/api/types/container/hostconfig.go:412".
+	Source string
+}
+
+type TSModelType struct {
+	GoSourceName string
+	GoPkgPath    string
+	Description  string
+	Properties   []TSProperty
+	Extensions   []TSExtension
+
+	// Source is where the Go type, or the field declaring an anonymous
+	// struct, is declared.
+	Source string
+
+	name    string
+	docLink string
+}
+
+// newModel returns the model of a Go type, named and linked to its
+// documentation.
+func (g *Generator) newModel(goSourceName string, goPkgPath string, description string) *TSModelType {
+	return &TSModelType{
+		GoSourceName: goSourceName,
+		GoPkgPath:    goPkgPath,
+		Description:  description,
+		name:         g.tsName(goPkgPath, goSourceName),
+		docLink:      g.generateDocLink(goPkgPath, declaredName(goSourceName)),
+	}
+}
+
+// declaredName returns the name of the type a source name is declared by,
+// the type itself or the type an anonymous struct is declared in, without
+// any type arguments.
+func declaredName(goSourceName string) string {
+	_, name, _ := strings.Cut(goSourceName, ".")
+	if end := strings.IndexAny(name, ".["); end >= 0 {
+		name = name[:end]
+	}
+	return name
+}
+
+// Annotate sets a schema annotation on the property.
+func (p *TSProperty) Annotate(key string, value string) {
+	if p.Annotations == nil {
+		p.Annotations = map[string]string{}
+	}
+	p.Annotations[key] = value
+}
+
+// isEmptyStruct reports whether t is struct{}, which has no fields to
+// marshal.
+func isEmptyStruct(t types.Type) bool {
+	s, ok := types.Unalias(t).(*types.Struct)
+	return ok && s.NumFields() == 0
+}
+
+// basicKinds maps the basic types of go/types to the kinds they have at
+// runtime.
+var basicKinds = map[types.BasicKind]reflect.Kind{
+	types.Bool:          reflect.Bool,
+	types.Int:           reflect.Int,
+	types.Int8:          reflect.Int8,
+	types.Int16:         reflect.Int16,
+	types.Int32:         reflect.Int32,
+	types.Int64:         reflect.Int64,
+	types.Uint:          reflect.Uint,
+	types.Uint8:         reflect.Uint8,
+	types.Uint16:        reflect.Uint16,
+	types.Uint32:        reflect.Uint32,
+	types.Uint64:        reflect.Uint64,
+	types.Uintptr:       reflect.Uintptr,
+	types.Float32:       reflect.Float32,
+	types.Float64:       reflect.Float64,
+	types.Complex64:     reflect.Complex64,
+	types.Complex128:    reflect.Complex128,
+	types.String:        reflect.String,
+	types.UnsafePointer: reflect.UnsafePointer,
+}
+
+// kindOf returns the kind a type has at runtime, which is the kind of its
+// underlying type. Type parameters and untyped constants have none.
+func kindOf(t types.Type) reflect.Kind {
+	if _, ok := types.Unalias(t).(*types.TypeParam); ok {
+		return reflect.Invalid
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		return basicKinds[u.Kind()]
+	case *types.Array:
+		return reflect.Array
+	case *types.Chan:
+		return reflect.Chan
+	case *types.Map:
+		return reflect.Map
+	case *types.Pointer:
+		return reflect.Pointer
+	case *types.Slice:
+		return reflect.Slice
+	case *types.Struct:
+		return reflect.Struct
+	case *types.Interface:
+		return reflect.Interface
+	case *types.Signature:
+		return reflect.Func
+	}
+	return reflect.Invalid
+}
+
+// TSInboxTypesMap is a map from Go type kind to TS type.
+var TSInboxTypesMap = map[reflect.Kind]TSType{
+	// Numeric fields marshal as bare JSON numbers on the wire, but are carried
+	// as strings between the agnostic http client and the schemas so 64-bit
+	// values decode losslessly (see src/internal/schemas/number.ts).
+	reflect.Float32: {"MobyNumber.NumberFromWireString", false},
+	reflect.Float64: {"MobyNumber.NumberFromWireString", false},
+	reflect.String:  {"Schema.String", false},
+	reflect.Bool:    {"Schema.Boolean", false},
+
+	// In practice most clients are 64bit so in go Int will be too.
+	reflect.Int:   {"MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n }))", false},
+	reflect.Int8:  {"MobyNumber.NumberFromWireString.check(Schema.isInt(), Schema.isBetween({ minimum: -(2 ** 7), maximum: 2 ** 7 - 1 }))", false},
+	reflect.Int16: {"MobyNumber.NumberFromWireString.check(Schema.isInt(), Schema.isBetween({ minimum: -(2 ** 15), maximum: 2 ** 15 - 1 }))", false},
+	reflect.Int32: {"MobyNumber.NumberFromWireString.check(Schema.isInt(), Schema.isBetween({ minimum: -(2 ** 31), maximum: 2 ** 31 - 1 }))", false},
+	reflect.Int64: {"MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n }))", false},
+
+	reflect.Uint:   {"MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))", false},
+	reflect.Uint8:  {"MobyNumber.NumberFromWireString.check(Schema.isInt(), Schema.isBetween({ minimum: 0, maximum: 2 ** 8 - 1 }))", false},
+	reflect.Uint16: {"MobyNumber.NumberFromWireString.check(Schema.isInt(), Schema.isBetween({ minimum: 0, maximum: 2 ** 16 - 1 }))", false},
+	reflect.Uint32: {"MobyNumber.NumberFromWireString.check(Schema.isInt(), Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 }))", false},
+	reflect.Uint64: {"MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))", false},
+}
+
+// TSQuotedTypesMap holds the schemas of fields tagged with the string option,
+// which encoding/json writes as a JSON string holding the encoded value. Unlike
+// bare numbers, quoted numbers stay strings on the wire.
+var TSQuotedTypesMap = map[reflect.Kind]TSType{
+	reflect.Float32: {"MobyQuoted.NumberFromQuotedString", false},
+	reflect.Float64: {"MobyQuoted.NumberFromQuotedString", false},
+	reflect.String:  {"MobyQuoted.StringFromQuotedString", false},
+	reflect.Bool:    {"MobyQuoted.BooleanFromQuotedString", false},
+
+	reflect.Int:   {"MobyQuoted.BigIntFromQuotedString.check(Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n }))", false},
+	reflect.Int8:  {"MobyQuoted.NumberFromQuotedString.check(Schema.isInt(), Schema.isBetween({ minimum: -(2 ** 7), maximum: 2 ** 7 - 1 }))", false},
+	reflect.Int16: {"MobyQuoted.NumberFromQuotedString.check(Schema.isInt(), Schema.isBetween({ minimum: -(2 ** 15), maximum: 2 ** 15 - 1 }))", false},
+	reflect.Int32: {"MobyQuoted.NumberFromQuotedString.check(Schema.isInt(), Schema.isBetween({ minimum: -(2 ** 31), maximum: 2 ** 31 - 1 }))", false},
+	reflect.Int64: {"MobyQuoted.BigIntFromQuotedString.check(Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n }))", false},
+
+	reflect.Uint:    {"MobyQuoted.BigIntFromQuotedString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))", false},
+	reflect.Uint8:   {"MobyQuoted.NumberFromQuotedString.check(Schema.isInt(), Schema.isBetween({ minimum: 0, maximum: 2 ** 8 - 1 }))", false},
+	reflect.Uint16:  {"MobyQuoted.NumberFromQuotedString.check(Schema.isInt(), Schema.isBetween({ minimum: 0, maximum: 2 ** 16 - 1 }))", false},
+	reflect.Uint32:  {"MobyQuoted.NumberFromQuotedString.check(Schema.isInt(), Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 }))", false},
+	reflect.Uint64:  {"MobyQuoted.BigIntFromQuotedString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))", false},
+	reflect.Uintptr: {"MobyQuoted.BigIntFromQuotedString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))", false},
+}
+
+// goQuotedTypeToTsType converts the type of a field tagged with the string
+// option, an unnamed pointer to a scalar quotes the scalar and encodes nil as
+// null.
+func goQuotedTypeToTsType(t types.Type) TSType {
+	if p, ok := types.Unalias(t).(*types.Pointer); ok {
+		return TSType{TSQuotedTypesMap[kindOf(p.Elem())].StrRepresentation, true}
+	}
+	return TSQuotedTypesMap[kindOf(t)]
+}
+
+func tsTypeToString(t TSType) string {
+	if t.Nullable {
+		return fmt.Sprintf("Schema.NullOr(%s)", t.StrRepresentation)
+	} else {
+		return t.StrRepresentation
+	}
+}
+
+// tsAnnotationsToString renders annotations as an object literal with sorted
+// keys, so output does not depend on map iteration order.
+func tsAnnotationsToString(annotations map[string]string) string {
+	keys := make([]string, 0, len(annotations))
+	for key := range annotations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	entries := make([]string, 0, len(keys))
+	for _, key := range keys {
+		entries = append(entries, fmt.Sprintf("%s: %q", key, annotations[key]))
+	}
+	return fmt.Sprintf("{ %s }", strings.Join(entries, ", "))
+}
+
+func tsPropertyToString(p TSProperty) string {
+	out := tsTypeToString(p.Type)
+	if len(p.Annotations) > 0 {
+		out = fmt.Sprintf("%s.annotate(%s)", out, tsAnnotationsToString(p.Annotations))
+	}
+	if p.IsOpt {
+		out = fmt.Sprintf("Schema.optional(%s)", out)
+	}
+	if p.DefaultValue != "" {
+		out = fmt.Sprintf("%s.pipe(Schema.withConstructorDefault(Effect.sync(%s)))", out, p.DefaultValue)
+	}
+	return out
+}
+
+func (g *Generator) goTypeToTsType(t types.Type) TSType {
+	kind := kindOf(t)
+	if replacement, willReplace := g.typeOverrides[typePath(t)]; willReplace {
+		if replacement.Nullable ||
+			kind == reflect.Pointer ||
+			kind == reflect.Slice ||
+			kind == reflect.Map ||
+			kind == reflect.Struct {
+			return TSType{replacement.StrRepresentation, true}
+		}
+		return replacement
+	}
+
+	// Aliases are overridden by their own name first, then by the name of
+	// the type they stand for
+	if alias, ok := t.(*types.Alias); ok {
+		return g.goTypeToTsType(alias.Rhs())
+	}
+
+	// Named strings and integers with declared constants are enums
+	if named, ok := t.(*types.Named); ok && (kind == reflect.String || isIntegerKind(kind)) && named.Obj().Pkg() != nil {
+		if enum := g.reflectEnum(named); enum != nil {
+			return TSType{fmt.Sprintf("%s.%s", enum.Name(), enum.Name()), false}
+		}
+	}
+
+	def, found := TSInboxTypesMap[kind]
+	if found {
+		return def
+	}
+
+	if isEmptyStruct(t) {
+		return TSType{"Schema.ObjectKeyword", false}
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Slice:
+		inner := tsTypeToString(g.goTypeToTsType(u.Elem()))
+		return TSType{fmt.Sprintf("Schema.Array(%s)", inner), true}
+	case *types.Map:
+		innerKey := tsTypeToString(g.goTypeToTsType(u.Key()))
+		innerValue := tsTypeToString(g.goTypeToTsType(u.Elem()))
+		return TSType{fmt.Sprintf("Schema.Record(%s, %s)", innerKey, innerValue), true}
+	case *types.Array:
+		len := u.Len()
+		inner := tsTypeToString(g.goTypeToTsType(u.Elem()))
+		return TSType{fmt.Sprintf("Schema.Array(%s).check(Schema.isLengthBetween(%d, %d))", inner, len, len), false}
+	case *types.Pointer:
+		ptr := g.goTypeToTsType(u.Elem())
+		ptr.Nullable = true
+		return ptr
+	}
+
+	switch kind {
+	case reflect.Struct:
+		named, ok := t.(*types.Named)
+		if !ok {
+			anonymous, ok := g.anonymousStructs.At(t).(TSType)
+			if !ok {
+				g.report("anonymous-struct", "anonymous struct %s was not reflected", typeString(t))
+				return TSType{"Schema.Unknown", false}
+			}
+			return anonymous
+		}
+		if m, ok := g.reflectedTypes.At(named).(*TSModelType); ok {
+			return TSType{fmt.Sprintf("%s.%s", m.Name(), m.Name()), true}
+		}
+		name := g.tsName(named.Obj().Pkg().Path(), goSourceName(named))
+		return TSType{fmt.Sprintf("%s.%s", name, name), true}
+	case reflect.Interface:
+		return TSType{"Schema.ObjectKeyword", false}
+	case reflect.Func:
+		return TSType{"Schema.Never", false}
+	case reflect.Uintptr:
+		return TSType{"Schema.Never", false}
+	default:
+		g.report("unsupported-kind", "cannot convert type %s of kind %s", typeString(t), kind)
+		return TSType{"Schema.Unknown", false}
+	}
+}
+
+// tsName derives the TS identifier of a Go type from its package path and
+// source name. The package is named by packageAliases, or by the package name
+// the source name is qualified with. The type arguments of generic types are
+// part of the name, like ShapesPageShapesPoint for shapes.Page[shapes.Point].
+func (g *Generator) tsName(goPkgPath string, goSourceName string) string {
+	if newName, willRename := g.renames[goTypePath(goPkgPath, goSourceName)]; willRename {
+		return newName
+	}
+	if alias, hasAlias := g.packageAliases[goPkgPath]; hasAlias {
+		_, name, _ := strings.Cut(goSourceName, ".")
+		return alias + identifierFromParts(name)
+	}
+	name := identifierFromParts(goSourceName)
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// identifierFromParts joins the identifiers of a source name into one, with
+// the first letter of every type argument upper cased.
+func identifierFromParts(goSourceName string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range goSourceName {
+		switch {
+		case r == '.':
+		case r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r):
+			if upper {
+				r = unicode.ToUpper(r)
+				upper = false
+			}
+			b.WriteRune(r)
+		default:
+			upper = true
+		}
+	}
+	return b.String()
+}
+
+// claimName reserves the TS identifiers of a reflected Go type and the file
+// it is written to. Two types that would share an identifier, or a file on a
+// case insensitive file system, can not both be generated, the first type
+// keeps the name. claimedNames maps every name in use to the Go type, by its
+// full package path, that claimed it.
+func (g *Generator) claimName(goPkgPath string, goSourceName string, identifiers ...string) {
+	owner := goTypePath(goPkgPath, goSourceName)
+
+	claim := func(key string, what string) {
+		if other, taken := g.claimedNames[key]; taken && other != owner {
+			g.reportAt("name-collision", owner, "", "%s collides with %s, add an alias to packageAliases or a rename to renames", what, other)
+			return
+		}
+		g.claimedNames[key] = owner
+	}
+	for _, identifier := range identifiers {
+		claim(identifier, fmt.Sprintf("TS identifier %q", identifier))
+	}
+	file := identifiers[0] + ".generated.ts"
+	claim(strings.ToLower(file), fmt.Sprintf("generated file %q", file))
+}
+
+func (t *TSModelType) Name() string {
+	return t.name
+}
+
+func (t *TSModelType) Title() string {
+	return t.GoSourceName
+}
+
+func (t *TSModelType) Documentation() string {
+	return t.docLink
+}
+
+var identifierRegexp = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+func formatFieldName(name string) string {
+	if identifierRegexp.MatchString(name) {
+		return name
+	}
+	return fmt.Sprintf("%q", name)
+}
+
+func (t *TSModelType) WriteProperties() string {
+	var buffer bytes.Buffer
+	for _, p := range t.Properties {
+		buffer.WriteString(fmt.Sprintf("        %s: %s,\n", formatFieldName(p.FieldName), tsPropertyToString(p)))
+	}
+	return buffer.String()
+}
+
+func (t *TSModelType) WriteInlineStruct() string {
+	var buffer bytes.Buffer
+	buffer.WriteString(fmt.Sprintln("Schema.Struct({"))
+	buffer.WriteString(t.WriteProperties())
+	buffer.WriteString(fmt.Sprintln("})"))
+	return buffer.String()
+}
+
+func (t *TSModelType) WriteClass(w io.Writer, imports []tsImport) {
+	var buffer bytes.Buffer
+	// Extensions wrap the schema class, the first one innermost
+	base := fmt.Sprintf("Schema.Class<%s>(\"%s\")(\n", t.Name(), t.Name())
+	for _, extension := range t.Extensions {
+		base = extension.Mixin + "(" + base
+	}
+	buffer.WriteString(fmt.Sprintf("export class %s extends %s", t.Name(), base))
+	buffer.WriteString(fmt.Sprintln("    {"))
+	buffer.WriteString(t.WriteProperties())
+	buffer.WriteString(fmt.Sprintln("    },"))
+	buffer.WriteString(fmt.Sprintln("    {"))
+	buffer.WriteString(fmt.Sprintf("        identifier: \"%s\",\n", t.Name()))
+	buffer.WriteString(fmt.Sprintf("        title: \"%s\",\n", t.Title()))
+	buffer.WriteString(fmt.Sprintf("        documentation: \"%s\",\n", t.Documentation()))
+	if t.Description != "" {
+		buffer.WriteString(fmt.Sprintf("        description: %q,\n", t.Description))
+	}
+	buffer.WriteString(fmt.Sprintln("    }"))
+	buffer.WriteString(fmt.Sprintln(")" + strings.Repeat(")", len(t.Extensions)) + " {}"))
+
+	outString := buffer.String()
+
+	writeKnownImports(w, imports, outString)
+
+	importsUnsorted := make(map[string]string)
+	for _, p := range t.Properties {
+		for _, typeName := range []string{p.Type.StrRepresentation, p.DefaultValue} {
+			for _, name := range referencedModels(typeName) {
+				// Types referring to themselves use their own class
+				if name != t.Name() {
+					importsUnsorted[name] = fmt.Sprintf("import * as %s from \"./%s.generated.ts\";\n", name, name)
+				}
+			}
+		}
+	}
+
+	importNames := make([]string, 0, len(importsUnsorted))
+	for name := range importsUnsorted {
+		importNames = append(importNames, name)
+	}
+	sort.Strings(importNames)
+	for _, name := range importNames {
+		fmt.Fprint(w, importsUnsorted[name])
+	}
+	for _, extension := range t.Extensions {
+		fmt.Fprint(w, extension.Import)
+	}
+
+	fmt.Fprintf(w, "\n")
+	fmt.Fprint(w, outString)
+}
+
+// writeKnownImports writes the import of every known namespace src uses.
+func writeKnownImports(w io.Writer, imports []tsImport, src string) {
+	for _, imp := range imports {
+		if usesNamespace(src, imp.namespace) {
+			fmt.Fprint(w, imp.line)
+		}
+	}
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c == '$' ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// usesNamespace reports whether src references the given namespace (i.e.
+// contains `namespace.` not preceded by another identifier character).
+func usesNamespace(src string, namespace string) bool {
+	needle := namespace + "."
+	offset := 0
+	for {
+		idx := strings.Index(src[offset:], needle)
+		if idx == -1 {
+			return false
+		}
+		abs := offset + idx
+		if abs == 0 || !isIdentifierChar(src[abs-1]) {
+			return true
+		}
+		offset = abs + 1
+	}
+}
@@ -1,45 +0,0 @@
-import * as Schema from "effect/Schema";
-
-import * as MobyNumber from "../schemas/number.ts";
-
-export class ContainerMemoryStats extends Schema.Class<ContainerMemoryStats>("ContainerMemoryStats")(
-    {
-        usage: Schema.optional(
-            MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))
-        ),
-        max_usage: Schema.optional(
-            MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))
-        ),
-        stats: Schema.optional(
-            Schema.NullOr(
-                Schema.Record(
-                    Schema.String,
-                    MobyNumber.BigIntFromWireString.check(
-                        Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
-                    )
-                )
-            )
-        ),
-        failcnt: Schema.optional(
-            MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))
-        ),
-        limit: Schema.optional(
-            MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))
-        ),
-        commitbytes: Schema.optional(
-            MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))
-        ),
-        commitpeakbytes: Schema.optional(
-            MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))
-        ),
-        privateworkingset: Schema.optional(
-            MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))
-        ),
-    },
-    {
-        identifier: "ContainerMemoryStats",
-        title: "container.MemoryStats",
-        documentation:
-            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#MemoryStats",
-    }
-) {}
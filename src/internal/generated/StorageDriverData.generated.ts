@@ -1,13 +0,0 @@
-import * as Schema from "effect/Schema";
-
-export class StorageDriverData extends Schema.Class<StorageDriverData>("StorageDriverData")(
-    {
-        Data: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
-        Name: Schema.String,
-    },
-    {
-        identifier: "StorageDriverData",
-        title: "storage.DriverData",
-        documentation: "",
-    }
-) {}
@@ -1,13 +0,0 @@
-import * as Schema from "effect/Schema";
-
-export class VolumeTypeMount extends Schema.Class<VolumeTypeMount>("VolumeTypeMount")(
-    {
-        FsType: Schema.optional(Schema.String),
-        MountFlags: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
-    },
-    {
-        identifier: "VolumeTypeMount",
-        title: "volume.TypeMount",
-        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/volume#TypeMount",
-    }
-) {}
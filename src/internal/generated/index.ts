@@ -1,216 +1,7 @@
-export * from "./SwarmIPAMOptions.generated.ts";
-export * from "./SwarmIPAMConfig.generated.ts";
-export * from "./ContainerMountPoint.generated.ts";
-export * from "./ImageAttestationProperties.generated.ts";
-export * from "./TypesPluginDevice.generated.ts";
-export * from "./SwarmServiceSpec.generated.ts";
-export * from "./RuntimePluginSpec.generated.ts";
-export * from "./SwarmInfo.generated.ts";
-export * from "./SwarmSwarm.generated.ts";
-export * from "./SystemFirewallInfo.generated.ts";
-export * from "./SwarmDiscreteGenericResource.generated.ts";
-export * from "./SwarmPortConfig.generated.ts";
-export * from "./TypesVersion.generated.ts";
-export * from "./VolumeClusterVolumeSpec.generated.ts";
-export * from "./SwarmPortStatus.generated.ts";
-export * from "./NetworkInspect.generated.ts";
-export * from "./SwarmNamedGenericResource.generated.ts";
-export * from "./SwarmSeccompOpts.generated.ts";
-export * from "./SwarmVolumeAttachment.generated.ts";
-export * from "./VolumeCreateOptions.generated.ts";
-export * from "./ContainerExecStartOptions.generated.ts";
-export * from "./SwarmConfigSpec.generated.ts";
-export * from "./ContainerSummary.generated.ts";
-export * from "./NetworkConfigReference.generated.ts";
-export * from "./SwarmPrivileges.generated.ts";
-export * from "./SwarmUpdateConfig.generated.ts";
-export * from "./SwarmJobStatus.generated.ts";
-export * from "./ContainerCreateRequest.generated.ts";
-export * from "./ContainerTopResponse.generated.ts";
-export * from "./BlkiodevWeightDevice.generated.ts";
-export * from "./ContainerHealthcheckResult.generated.ts";
-export * from "./ImageMetadata.generated.ts";
-export * from "./NetworkPeerInfo.generated.ts";
-export * from "./SwarmNodeDescription.generated.ts";
-export * from "./TypesPluginEnv.generated.ts";
-export * from "./SwarmSecretReference.generated.ts";
-export * from "./SwarmReplicatedService.generated.ts";
-export * from "./StorageDriverData.generated.ts";
-export * from "./ContainerDefaultNetworkSettings.generated.ts";
-export * from "./V1ImageConfig.generated.ts";
-export * from "./SwarmTLSInfo.generated.ts";
-export * from "./SwarmOrchestrationConfig.generated.ts";
-export * from "./SystemRuntimeWithStatus.generated.ts";
-export * from "./SwarmPeer.generated.ts";
-export * from "./VolumeTopology.generated.ts";
-export * from "./ContainerExecInspect.generated.ts";
-export * from "./ContainerExecOptions.generated.ts";
-export * from "./ContainerNetworkStats.generated.ts";
-export * from "./NetworkIPAMConfig.generated.ts";
-export * from "./SwarmTopology.generated.ts";
-export * from "./TypesPlugin.generated.ts";
-export * from "./SwarmContainerStatus.generated.ts";
-export * from "./V1HealthcheckConfig.generated.ts";
-export * from "./SwarmCredentialSpec.generated.ts";
-export * from "./SystemDeviceInfo.generated.ts";
-export * from "./TypesDiskUsage.generated.ts";
-export * from "./RegistryAuthConfig.generated.ts";
-export * from "./JsonmessageJSONProgress.generated.ts";
-export * from "./MountVolumeOptions.generated.ts";
-export * from "./SwarmConfigReferenceRuntimeTarget.generated.ts";
-export * from "./SwarmSpec.generated.ts";
-export * from "./ContainerHostConfig.generated.ts";
-export * from "./MountClusterOptions.generated.ts";
-export * from "./V1DockerOCIImageConfigExt.generated.ts";
-export * from "./SwarmResources.generated.ts";
-export * from "./SwarmNodeCSIInfo.generated.ts";
-export * from "./SwarmInitRequest.generated.ts";
-export * from "./SystemContainerdInfo.generated.ts";
-export * from "./VolumeClusterVolume.generated.ts";
-export * from "./BlkiodevThrottleDevice.generated.ts";
-export * from "./ContainerWaitResponse.generated.ts";
-export * from "./ContainerNetworkSettingsBase.generated.ts";
-export * from "./NetworkConnectOptions.generated.ts";
-export * from "./SwarmSecretSpec.generated.ts";
-export * from "./SwarmDNSConfig.generated.ts";
-export * from "./SwarmSpreadOver.generated.ts";
-export * from "./SystemInfo.generated.ts";
-export * from "./TypesPluginSettings.generated.ts";
-export * from "./SwarmSecretReferenceFileTarget.generated.ts";
-export * from "./SwarmGlobalJob.generated.ts";
-export * from "./SwarmCAConfig.generated.ts";
-export * from "./VolumeVolume.generated.ts";
-export * from "./VolumeTopologyRequirement.generated.ts";
-export * from "./MountBindOptions.generated.ts";
-export * from "./ContainerPathStat.generated.ts";
-export * from "./JsonmessageJSONError.generated.ts";
-export * from "./NetworkAddress.generated.ts";
-export * from "./ImageRootFS.generated.ts";
-export * from "./SwarmLimit.generated.ts";
-export * from "./SwarmEndpointSpec.generated.ts";
-export * from "./SwarmJoinTokens.generated.ts";
-export * from "./SwarmVersion.generated.ts";
-export * from "./ContainerLogConfig.generated.ts";
-export * from "./ContainerDeviceMapping.generated.ts";
-export * from "./ContainerBlkioStats.generated.ts";
-export * from "./SwarmNodeSpec.generated.ts";
-export * from "./SwarmEngineDescription.generated.ts";
-export * from "./RuntimePluginPrivilege.generated.ts";
-export * from "./SwarmRaftConfig.generated.ts";
-export * from "./ImageImageProperties.generated.ts";
-export * from "./TypesPluginMount.generated.ts";
-export * from "./SwarmSecret.generated.ts";
-export * from "./SwarmEndpoint.generated.ts";
-export * from "./SwarmNetworkSpec.generated.ts";
-export * from "./SwarmNode.generated.ts";
-export * from "./SwarmConfigReferenceFileTarget.generated.ts";
-export * from "./SystemCommit.generated.ts";
-export * from "./VolumeTypeBlock.generated.ts";
-export * from "./VolumeCapacityRange.generated.ts";
-export * from "./VolumeSecret.generated.ts";
-export * from "./VolumePublishStatus.generated.ts";
-export * from "./VolumeInfo.generated.ts";
-export * from "./ContainerDeviceRequest.generated.ts";
-export * from "./JSONMessage.generated.ts";
-export * from "./SwarmAnnotations.generated.ts";
-export * from "./ContainerConfig.generated.ts";
-export * from "./NetworkNetworkingConfig.generated.ts";
-export * from "./ContainerPidsStats.generated.ts";
-export * from "./ContainerBlkioStatEntry.generated.ts";
-export * from "./RegistryDistributionInspect.generated.ts";
-export * from "./ContainerCPUUsage.generated.ts";
-export * from "./ImageSummary.generated.ts";
-export * from "./TypesPluginConfig.generated.ts";
-export * from "./SwarmTaskSpec.generated.ts";
-export * from "./SwarmNetworkAttachmentSpec.generated.ts";
-export * from "./SwarmPlacement.generated.ts";
-export * from "./SwarmGlobalService.generated.ts";
-export * from "./SwarmEncryptionConfig.generated.ts";
-export * from "./MountTmpfsOptions.generated.ts";
-export * from "./ContainerPort.generated.ts";
-export * from "./RegistrySearchResult.generated.ts";
-export * from "./SwarmManagerStatus.generated.ts";
-export * from "./SwarmAppArmorOpts.generated.ts";
-export * from "./VolumeUsageData.generated.ts";
-export * from "./BuildCacheRecord.generated.ts";
-export * from "./SwarmTaskStatus.generated.ts";
-export * from "./NetworkIPAM.generated.ts";
-export * from "./SwarmPlatform.generated.ts";
-export * from "./SwarmNodeStatus.generated.ts";
-export * from "./SwarmSELinuxContext.generated.ts";
-export * from "./SwarmUpdateStatus.generated.ts";
-export * from "./SystemContainerdNamespaces.generated.ts";
-export * from "./VolumeAccessMode.generated.ts";
-export * from "./VolumeTypeMount.generated.ts";
-export * from "./V1Descriptor.generated.ts";
-export * from "./ContainerNetworkSettingsSummary.generated.ts";
-export * from "./ContainerResources.generated.ts";
-export * from "./MountMount.generated.ts";
-export * from "./ContainerContainerJSONBase.generated.ts";
-export * from "./ImageDeleteResponse.generated.ts";
-export * from "./ImageHistoryResponseItem.generated.ts";
-export * from "./SwarmContainerSpec.generated.ts";
-export * from "./ArchiveChange.generated.ts";
-export * from "./MountImageOptions.generated.ts";
-export * from "./ContainerStatsResponse.generated.ts";
-export * from "./TypesPluginConfigInterface.generated.ts";
-export * from "./SwarmResourceRequirements.generated.ts";
-export * from "./SwarmJoinRequest.generated.ts";
-export * from "./SystemPluginsInfo.generated.ts";
-export * from "./TypesComponentVersion.generated.ts";
-export * from "./ContainerThrottlingData.generated.ts";
-export * from "./ContainerMemoryStats.generated.ts";
-export * from "./NetworkServiceInfo.generated.ts";
-export * from "./TypesPluginConfigRootfs.generated.ts";
-export * from "./SwarmService.generated.ts";
-export * from "./SwarmNetworkAttachmentConfig.generated.ts";
-export * from "./SwarmDispatcherConfig.generated.ts";
-export * from "./EventsMessage.generated.ts";
-export * from "./UnitsUlimit.generated.ts";
-export * from "./ContainerInspectResponse.generated.ts";
-export * from "./ContainerNetworkSettings.generated.ts";
-export * from "./SystemNetworkAddressPool.generated.ts";
-export * from "./ContainerRestartPolicy.generated.ts";
-export * from "./V1Platform.generated.ts";
-export * from "./ContainerHealth.generated.ts";
-export * from "./SwarmGenericResource.generated.ts";
-export * from "./SwarmEndpointVirtualIP.generated.ts";
-export * from "./RegistryServiceConfig.generated.ts";
-export * from "./SystemRuntime.generated.ts";
-export * from "./RegistryAuthenticateOKBody.generated.ts";
-export * from "./NetworkEndpointSettings.generated.ts";
-export * from "./ContainerStorageStats.generated.ts";
-export * from "./ContainerCPUStats.generated.ts";
-export * from "./ImageManifestSummary.generated.ts";
-export * from "./SwarmTaskDefaults.generated.ts";
-export * from "./TypesPluginConfigLinux.generated.ts";
-export * from "./SwarmRestartPolicy.generated.ts";
-export * from "./SwarmServiceStatus.generated.ts";
-export * from "./EventsActor.generated.ts";
-export * from "./SwarmTask.generated.ts";
-export * from "./NetworkEndpointIPAMConfig.generated.ts";
-export * from "./NetworkEndpointResource.generated.ts";
-export * from "./NetworkCreateRequest.generated.ts";
-export * from "./SwarmPluginDescription.generated.ts";
-export * from "./TypesPluginConfigArgs.generated.ts";
-export * from "./SwarmServiceMode.generated.ts";
-export * from "./RegistryIndexInfo.generated.ts";
-export * from "./NetworkCreateOptions.generated.ts";
-export * from "./SwarmConfig.generated.ts";
-export * from "./ContainerWaitExitError.generated.ts";
-export * from "./V1DockerOCIImageConfig.generated.ts";
-export * from "./TypesPluginConfigUser.generated.ts";
-export * from "./SwarmConfigReference.generated.ts";
-export * from "./SwarmExternalCA.generated.ts";
-export * from "./SwarmNetwork.generated.ts";
-export * from "./SwarmMeta.generated.ts";
-export * from "./MountDriver.generated.ts";
-export * from "./ContainerState.generated.ts";
-export * from "./NetworkTask.generated.ts";
-export * from "./SwarmPlacementPreference.generated.ts";
-export * from "./SwarmReplicatedJob.generated.ts";
-export * from "./SwarmClusterInfo.generated.ts";
-export * from "./TypesPluginConfigNetwork.generated.ts";
-export * from "./SwarmNetworkAttachment.generated.ts";
-export * from "./SwarmDriver.generated.ts";
-export * from "./ImageInspectResponse.generated.ts";
+export * from "./v1.51/index.ts";
+
+export * as V1_44 from "./v1.44/index.ts";
+export * as V1_47 from "./v1.47/index.ts";
+export * as V1_51 from "./v1.51/index.ts";
+
+export const ApiVersions = ["1.44", "1.47", "1.51"] as const;
@@ -0,0 +1,42 @@
+import * as Schema from "effect/Schema";
+
+export const BuildPostBuildQuery = Schema.Struct({
+    q: Schema.optional(Schema.Boolean),
+    dockerfile: Schema.optional(Schema.String),
+    nocache: Schema.optional(Schema.Boolean),
+    forcerm: Schema.optional(Schema.Boolean),
+    memswap: Schema.optional(Schema.Finite),
+    memory: Schema.optional(Schema.Finite),
+    cpushares: Schema.optional(Schema.Finite),
+    cpuperiod: Schema.optional(Schema.Finite),
+    cpuquota: Schema.optional(Schema.Finite),
+    cpusetcpus: Schema.optional(Schema.String),
+    cpusetmems: Schema.optional(Schema.String),
+    cgroupparent: Schema.optional(Schema.String),
+    networkmode: Schema.optional(Schema.String),
+    t: Schema.optional(Schema.Array(Schema.String)),
+    extrahosts: Schema.optional(Schema.Array(Schema.String)),
+    securityopt: Schema.optional(Schema.Array(Schema.String)),
+    squash: Schema.optional(Schema.Boolean),
+    target: Schema.optional(Schema.String),
+    remote: Schema.optional(Schema.String),
+    session: Schema.optional(Schema.String),
+    buildid: Schema.optional(Schema.String),
+    rm: Schema.optional(Schema.Boolean),
+    pull: Schema.optional(Schema.Boolean),
+    platform: Schema.optional(Schema.String),
+    outputs: Schema.optional(Schema.String),
+    shmsize: Schema.optional(Schema.Finite),
+    isolation: Schema.optional(Schema.String),
+    ulimits: Schema.optional(Schema.String),
+    buildargs: Schema.optional(Schema.String),
+    labels: Schema.optional(Schema.String),
+    cachefrom: Schema.optional(Schema.String),
+    version: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "BuildPostBuildQuery",
+    title: "build.postBuild",
+    description: "Query parameters of POST /build",
+});
+
+export type BuildPostBuildQuery = Schema.Schema.Type<typeof BuildPostBuildQuery>;
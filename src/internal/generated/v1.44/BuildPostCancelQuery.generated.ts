@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const BuildPostCancelQuery = Schema.Struct({
+    id: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "BuildPostCancelQuery",
+    title: "build.postCancel",
+    description: "Query parameters of POST /build/cancel",
+});
+
+export type BuildPostCancelQuery = Schema.Schema.Type<typeof BuildPostCancelQuery>;
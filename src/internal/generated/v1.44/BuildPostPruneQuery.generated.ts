@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export const BuildPostPruneQuery = Schema.Struct({
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+    "keep-storage": Schema.optional(Schema.Finite),
+    all: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "BuildPostPruneQuery",
+    title: "build.postPrune",
+    description: "Query parameters of POST /build/prune",
+});
+
+export type BuildPostPruneQuery = Schema.Schema.Type<typeof BuildPostPruneQuery>;
@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export const CheckpointDeleteContainerCheckpointQuery = Schema.Struct({
+    dir: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "CheckpointDeleteContainerCheckpointQuery",
+    title: "checkpoint.deleteContainerCheckpoint",
+    description: "Query parameters of DELETE /containers/{name}/checkpoints/{checkpoint}",
+});
+
+export type CheckpointDeleteContainerCheckpointQuery = Schema.Schema.Type<
+    typeof CheckpointDeleteContainerCheckpointQuery
+>;
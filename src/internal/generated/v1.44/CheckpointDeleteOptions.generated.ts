@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+
+export class CheckpointDeleteOptions extends Schema.Class<CheckpointDeleteOptions>("CheckpointDeleteOptions")(
+    {
+        CheckpointID: Schema.String,
+        CheckpointDir: Schema.String,
+    },
+    {
+        identifier: "CheckpointDeleteOptions",
+        title: "checkpoint.DeleteOptions",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/checkpoint#DeleteOptions",
+        description: "DeleteOptions holds parameters to delete a checkpoint from a container.",
+    }
+) {}
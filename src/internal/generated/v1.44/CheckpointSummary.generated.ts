@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export class CheckpointSummary extends Schema.Class<CheckpointSummary>("CheckpointSummary")(
+    {
+        Name: Schema.String.annotate({ description: "Name is the name of the checkpoint." }),
+    },
+    {
+        identifier: "CheckpointSummary",
+        title: "checkpoint.Summary",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/checkpoint#Summary",
+        description: "Summary represents the details of a checkpoint when listing endpoints.",
+    }
+) {}
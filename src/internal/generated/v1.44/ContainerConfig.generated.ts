@@ -0,0 +1,103 @@
+import * as Effect from "effect/Effect";
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as PortSchemas from "../../schemas/port.ts";
+import * as V1HealthcheckConfig from "./V1HealthcheckConfig.generated.ts";
+
+export class ContainerConfig extends Schema.Class<ContainerConfig>("ContainerConfig")(
+    {
+        Hostname: Schema.String.annotate({ description: "Hostname" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        Domainname: Schema.String.annotate({ description: "Domainname" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        User: Schema.String.annotate({
+            description: "User that will run the command(s) inside the container, also support user:group",
+        }).pipe(Schema.withConstructorDefault(Effect.sync(() => ""))),
+        AttachStdin: Schema.Boolean.annotate({
+            description: "Attach the standard input, makes possible user interaction",
+        }).pipe(Schema.withConstructorDefault(Effect.sync(() => false))),
+        AttachStdout: Schema.Boolean.annotate({ description: "Attach the standard output" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => false))
+        ),
+        AttachStderr: Schema.Boolean.annotate({ description: "Attach the standard error" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => false))
+        ),
+        ExposedPorts: Schema.optional(
+            Schema.NullOr(PortSchemas.PortSet).annotate({ description: "List of exposed ports" })
+        ),
+        Tty: Schema.Boolean.annotate({
+            description: "Attach standard streams to a tty, including stdin if it is not closed.",
+        }).pipe(Schema.withConstructorDefault(Effect.sync(() => false))),
+        OpenStdin: Schema.Boolean.annotate({ description: "Open stdin" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => false))
+        ),
+        StdinOnce: Schema.Boolean.annotate({
+            description: "If true, close stdin after the 1 attached client disconnects.",
+        }).pipe(Schema.withConstructorDefault(Effect.sync(() => false))),
+        Env: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "List of environment variable to set in the container" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        Cmd: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "Command to run when starting the container" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        Healthcheck: Schema.optional(
+            Schema.NullOr(V1HealthcheckConfig.V1HealthcheckConfig).annotate({
+                description: "Healthcheck describes how to check the container is healthy",
+            })
+        ),
+        ArgsEscaped: Schema.optional(
+            Schema.Boolean.annotate({
+                description: "True if command is already escaped (meaning treat as a command line) (Windows specific).",
+            })
+        ),
+        Image: Schema.String.annotate({
+            description: "Name of the image as it was passed by the operator (e.g. could be symbolic)",
+        }),
+        Volumes: Schema.NullOr(Schema.Record(Schema.String, Schema.ObjectKeyword))
+            .annotate({ description: "List of volumes (mounts) used for the container" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        WorkingDir: Schema.String.annotate({
+            description: "Current directory (PWD) in the command will be launched",
+        }).pipe(Schema.withConstructorDefault(Effect.sync(() => ""))),
+        Entrypoint: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "Entrypoint to run when starting the container" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        NetworkDisabled: Schema.optional(Schema.Boolean.annotate({ description: "Is network disabled" })),
+        MacAddress: Schema.optional(
+            Schema.String.annotate({
+                deprecatedIn: "1.44",
+                description:
+                    "Mac Address of the container.\n\nDeprecated: this field is deprecated since API v1.44. Use EndpointSettings.MacAddress instead.",
+            })
+        ),
+        OnBuild: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "ONBUILD metadata that were defined on the image Dockerfile" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String))
+            .annotate({ description: "List of labels set to this container" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        StopSignal: Schema.optional(Schema.String.annotate({ description: "Signal to stop a container" })),
+        StopTimeout: Schema.optional(
+            Schema.NullOr(
+                MobyNumber.BigIntFromWireString.check(
+                    Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+                )
+            ).annotate({ description: "Timeout (in seconds) to stop a container" })
+        ),
+        Shell: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "Shell for shell-form of RUN, CMD, ENTRYPOINT",
+            })
+        ),
+    },
+    {
+        identifier: "ContainerConfig",
+        title: "container.Config",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/container#Config",
+        description:
+            'Config contains the configuration data about a container.\nIt should hold only portable information about the container.\nHere, "portable" means "independent from the host we are running on".\nNon-portable information *should* appear in HostConfig.\nAll fields added to this struct must be marked `omitempty` to keep getting\npredictable hashes from the old `v1Compatibility` configuration.',
+    }
+) {}
@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export class ContainerContainerUpdateOKBody extends Schema.Class<ContainerContainerUpdateOKBody>(
+    "ContainerContainerUpdateOKBody"
+)(
+    {
+        Warnings: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "warnings\nRequired: true" }),
+    },
+    {
+        identifier: "ContainerContainerUpdateOKBody",
+        title: "container.ContainerUpdateOKBody",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/container#ContainerUpdateOKBody",
+        description:
+            "ContainerUpdateOKBody OK response to ContainerUpdate operation\nswagger:model ContainerUpdateOKBody",
+    }
+) {}
@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+export class ContainerCreateResponse extends Schema.Class<ContainerCreateResponse>("ContainerCreateResponse")(
+    {
+        Id: Schema.String.annotate({ description: "The ID of the created container\nRequired: true" }),
+        Warnings: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description: "Warnings encountered when creating the container\nRequired: true",
+        }),
+    },
+    {
+        identifier: "ContainerCreateResponse",
+        title: "container.CreateResponse",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/container#CreateResponse",
+        description:
+            "CreateResponse ContainerCreateResponse\n\nOK response to ContainerCreate operation\nswagger:model CreateResponse",
+    }
+) {}
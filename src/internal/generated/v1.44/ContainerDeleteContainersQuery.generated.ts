@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerDeleteContainersQuery = Schema.Struct({
+    force: Schema.optional(Schema.Boolean),
+    v: Schema.optional(Schema.Boolean),
+    link: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "ContainerDeleteContainersQuery",
+    title: "container.deleteContainers",
+    description: "Query parameters of DELETE /containers/{name:.*}",
+});
+
+export type ContainerDeleteContainersQuery = Schema.Schema.Type<typeof ContainerDeleteContainersQuery>;
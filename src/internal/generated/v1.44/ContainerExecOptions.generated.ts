@@ -0,0 +1,50 @@
+import * as Effect from "effect/Effect";
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class ContainerExecOptions extends Schema.Class<ContainerExecOptions>("ContainerExecOptions")(
+    {
+        User: Schema.String.annotate({ description: "User that will run the command" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        Privileged: Schema.Boolean.annotate({ description: "Is the container in privileged mode" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => false))
+        ),
+        Tty: Schema.Boolean.annotate({ description: "Attach standard streams to a tty." }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => false))
+        ),
+        ConsoleSize: Schema.optional(
+            Schema.NullOr(
+                Schema.Array(
+                    MobyNumber.BigIntFromWireString.check(
+                        Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+                    )
+                ).check(Schema.isLengthBetween(2, 2))
+            ).annotate({ description: "Initial console size [height, width]" })
+        ),
+        AttachStdin: Schema.Boolean.annotate({
+            description: "Attach the standard input, makes possible user interaction",
+        }),
+        AttachStderr: Schema.Boolean.annotate({ description: "Attach the standard error" }),
+        AttachStdout: Schema.Boolean.annotate({ description: "Attach the standard output" }),
+        Detach: Schema.Boolean.annotate({ description: "Execute in detach mode" }),
+        DetachKeys: Schema.String.annotate({ description: "Escape keys for detach" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        Env: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "Environment variables" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        WorkingDir: Schema.String.annotate({ description: "Working directory" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        Cmd: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "Execution commands and args" }),
+    },
+    {
+        identifier: "ContainerExecOptions",
+        title: "types.ExecConfig",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#ExecConfig",
+        description:
+            "ExecConfig is a small subset of the Config struct that holds the configuration\nfor the exec feature of docker.",
+    }
+) {}
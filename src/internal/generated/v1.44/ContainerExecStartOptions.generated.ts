@@ -0,0 +1,26 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class ContainerExecStartOptions extends Schema.Class<ContainerExecStartOptions>("ContainerExecStartOptions")(
+    {
+        Detach: Schema.Boolean.annotate({ description: "ExecStart will first check if it's detached" }),
+        Tty: Schema.Boolean.annotate({ description: "Check if there's a tty" }),
+        ConsoleSize: Schema.optional(
+            Schema.NullOr(
+                Schema.Array(
+                    MobyNumber.BigIntFromWireString.check(
+                        Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+                    )
+                ).check(Schema.isLengthBetween(2, 2))
+            ).annotate({ description: "Terminal size [height, width], unused if Tty == false" })
+        ),
+    },
+    {
+        identifier: "ContainerExecStartOptions",
+        title: "types.ExecStartCheck",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#ExecStartCheck",
+        description:
+            "ExecStartCheck is a temp struct used by execStart\nConfig fields is part of ExecConfig in runconfig package",
+    }
+) {}
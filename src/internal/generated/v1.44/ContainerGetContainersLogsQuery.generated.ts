@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerGetContainersLogsQuery = Schema.Struct({
+    stdout: Schema.optional(Schema.Boolean),
+    stderr: Schema.optional(Schema.Boolean),
+    follow: Schema.optional(Schema.Boolean),
+    timestamps: Schema.optional(Schema.Boolean),
+    since: Schema.optional(Schema.String),
+    until: Schema.optional(Schema.String),
+    tail: Schema.optional(Schema.String),
+    details: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "ContainerGetContainersLogsQuery",
+    title: "container.getContainersLogs",
+    description: "Query parameters of GET /containers/{name:.*}/logs",
+});
+
+export type ContainerGetContainersLogsQuery = Schema.Schema.Type<typeof ContainerGetContainersLogsQuery>;
@@ -0,0 +1,321 @@
+import * as Effect from "effect/Effect";
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as PortSchemas from "../../schemas/port.ts";
+import * as BlkiodevThrottleDevice from "./BlkiodevThrottleDevice.generated.ts";
+import * as BlkiodevWeightDevice from "./BlkiodevWeightDevice.generated.ts";
+import * as ContainerCgroupnsMode from "./ContainerCgroupnsMode.generated.ts";
+import * as ContainerDeviceMapping from "./ContainerDeviceMapping.generated.ts";
+import * as ContainerDeviceRequest from "./ContainerDeviceRequest.generated.ts";
+import * as ContainerIsolation from "./ContainerIsolation.generated.ts";
+import * as ContainerLogConfig from "./ContainerLogConfig.generated.ts";
+import * as ContainerRestartPolicy from "./ContainerRestartPolicy.generated.ts";
+import * as MountMount from "./MountMount.generated.ts";
+import * as UnitsUlimit from "./UnitsUlimit.generated.ts";
+
+export class ContainerHostConfig extends Schema.Class<ContainerHostConfig>("ContainerHostConfig")(
+    {
+        Binds: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "List of volume bindings for this container" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        ContainerIDFile: Schema.String.annotate({ description: "File (path) where the containerId is written" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        LogConfig: Schema.NullOr(ContainerLogConfig.ContainerLogConfig)
+            .annotate({ description: "Configuration of the logs for this container" })
+            .pipe(
+                Schema.withConstructorDefault(
+                    Effect.sync(() => new ContainerLogConfig.ContainerLogConfig({ Type: "json-file", Config: null }))
+                )
+            ),
+        NetworkMode: Schema.String.annotate({ description: "Network mode to use for the container" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => "default"))
+        ),
+        PortBindings: Schema.NullOr(PortSchemas.PortMap)
+            .annotate({ description: "Port mapping between the exposed port (container) and the host" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        RestartPolicy: Schema.NullOr(ContainerRestartPolicy.ContainerRestartPolicy)
+            .annotate({ description: "Restart policy to be used for the container" })
+            .pipe(
+                Schema.withConstructorDefault(
+                    Effect.sync(
+                        () => new ContainerRestartPolicy.ContainerRestartPolicy({ Name: "no", MaximumRetryCount: 0n })
+                    )
+                )
+            ),
+        AutoRemove: Schema.Boolean.annotate({ description: "Automatically remove container when it exits" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => false))
+        ),
+        VolumeDriver: Schema.String.annotate({ description: "Name of the volume driver used to mount volumes" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        VolumesFrom: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "List of volumes to take from other container" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        ConsoleSize: Schema.Array(
+            MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))
+        )
+            .check(Schema.isLengthBetween(2, 2))
+            .annotate({ description: "Initial console size (height,width)" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => [0n, 0n]))),
+        Annotations: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+                description: "Arbitrary non-identifying metadata attached to container and provided to the runtime",
+            })
+        ),
+        CapAdd: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "List of kernel capabilities to add to the container" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        CapDrop: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "List of kernel capabilities to remove from the container" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        CgroupnsMode: ContainerCgroupnsMode.ContainerCgroupnsMode.annotate({
+            description: "Cgroup namespace mode to use for the container",
+        }).pipe(Schema.withConstructorDefault(Effect.sync(() => ""))),
+        Dns: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "List of DNS server to lookup" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        DnsOptions: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "List of DNSOption to look for" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        DnsSearch: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "List of DNSSearch to look for" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        ExtraHosts: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "List of extra hosts" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        GroupAdd: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "List of additional groups that the container process will run as" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        IpcMode: Schema.String.annotate({ description: "IPC namespace to use for the container" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        Cgroup: Schema.String.annotate({ description: "Cgroup to use for the container" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        Links: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "List of links (in the name:alias form)" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        OomScoreAdj: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "Container preference for OOM-killing" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        PidMode: Schema.String.annotate({ description: "PID namespace to use for the container" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        Privileged: Schema.Boolean.annotate({ description: "Is the container in privileged mode" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => false))
+        ),
+        PublishAllPorts: Schema.Boolean.annotate({
+            description: "Should docker publish all exposed port for the container",
+        }).pipe(Schema.withConstructorDefault(Effect.sync(() => false))),
+        ReadonlyRootfs: Schema.Boolean.annotate({ description: "Is the container root filesystem in read-only" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => false))
+        ),
+        SecurityOpt: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "List of string values to customize labels for MLS systems, such as SELinux." })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        StorageOpt: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+                description: "Storage driver options per container.",
+            })
+        ),
+        Tmpfs: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+                description: "List of tmpfs (mounts) used for the container",
+            })
+        ),
+        UTSMode: Schema.String.annotate({ description: "UTS namespace to use for the container" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        UsernsMode: Schema.String.annotate({ description: "The user namespace to use for the container" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        ShmSize: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "Total shm memory usage" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        Sysctls: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+                description: "List of Namespaced sysctls used for the container",
+            })
+        ),
+        Runtime: Schema.optional(Schema.String.annotate({ description: "Runtime to use with this container" })),
+        Isolation: ContainerIsolation.ContainerIsolation.annotate({
+            description: "Isolation technology of the container (e.g. default, hyperv)",
+        }).pipe(Schema.withConstructorDefault(Effect.sync(() => ""))),
+        CpuShares: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "CPU shares (relative weight vs. other containers)" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        Memory: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "Memory limit (in bytes)" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        NanoCpus: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "CPU quota in units of 10<sup>-9</sup> CPUs." })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        CgroupParent: Schema.String.annotate({ description: "Parent cgroup." }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        BlkioWeight: MobyNumber.NumberFromWireString.check(
+            Schema.isInt(),
+            Schema.isBetween({ minimum: 0, maximum: 2 ** 16 - 1 })
+        )
+            .annotate({ description: "Block IO weight (relative weight vs. other containers)" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0))),
+        BlkioWeightDevice: Schema.NullOr(Schema.Array(Schema.NullOr(BlkiodevWeightDevice.BlkiodevWeightDevice))).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => []))
+        ),
+        BlkioDeviceReadBps: Schema.NullOr(
+            Schema.Array(Schema.NullOr(BlkiodevThrottleDevice.BlkiodevThrottleDevice))
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        BlkioDeviceWriteBps: Schema.NullOr(
+            Schema.Array(Schema.NullOr(BlkiodevThrottleDevice.BlkiodevThrottleDevice))
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        BlkioDeviceReadIOps: Schema.NullOr(
+            Schema.Array(Schema.NullOr(BlkiodevThrottleDevice.BlkiodevThrottleDevice))
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        BlkioDeviceWriteIOps: Schema.NullOr(
+            Schema.Array(Schema.NullOr(BlkiodevThrottleDevice.BlkiodevThrottleDevice))
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        CpuPeriod: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "CPU CFS (Completely Fair Scheduler) period" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        CpuQuota: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "CPU CFS (Completely Fair Scheduler) quota" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        CpuRealtimePeriod: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "CPU real-time period" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        CpuRealtimeRuntime: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "CPU real-time runtime" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        CpusetCpus: Schema.String.annotate({ description: "CpusetCpus 0-2, 0,1" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        CpusetMems: Schema.String.annotate({ description: "CpusetMems 0-2, 0,1" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        Devices: Schema.NullOr(Schema.Array(Schema.NullOr(ContainerDeviceMapping.ContainerDeviceMapping)))
+            .annotate({ description: "List of devices to map inside the container" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        DeviceCgroupRules: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "List of rule to be added to the device cgroup" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        DeviceRequests: Schema.NullOr(Schema.Array(Schema.NullOr(ContainerDeviceRequest.ContainerDeviceRequest)))
+            .annotate({ description: "List of device requests for device drivers" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        KernelMemory: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({
+                description:
+                    "KernelMemory specifies the kernel memory limit (in bytes) for the container.\nDeprecated: kernel 5.4 deprecated kmem.limit_in_bytes.",
+            })
+        ),
+        KernelMemoryTCP: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({ description: "Hard limit for kernel TCP buffer memory (in bytes)" })
+        ),
+        MemoryReservation: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "Memory soft limit (in bytes)" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        MemorySwap: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "Total memory usage (memory + swap); set `-1` to enable unlimited swap" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        MemorySwappiness: Schema.NullOr(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            )
+        )
+            .annotate({ description: "Tuning container memory swappiness behaviour" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => -1n))),
+        OomKillDisable: Schema.NullOr(Schema.Boolean)
+            .annotate({ description: "Whether to disable OOM Killer or not" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => false))),
+        PidsLimit: Schema.NullOr(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            )
+        )
+            .annotate({
+                description:
+                    "Setting PIDs limit for a container; Set `0` or `-1` for unlimited, or `null` to not change.",
+            })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        Ulimits: Schema.NullOr(Schema.Array(Schema.NullOr(UnitsUlimit.UnitsUlimit)))
+            .annotate({ description: "List of ulimits to be set in the container" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        CpuCount: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "CPU count" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        CpuPercent: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "CPU percent" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        IOMaximumIOps: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        )
+            .annotate({ description: "Maximum IOps for the container system drive" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        IOMaximumBandwidth: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        )
+            .annotate({ description: "Maximum IO in bytes per second for the container system drive" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        Mounts: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.NullOr(MountMount.MountMount))).annotate({
+                description: "Mounts specs used by the container",
+            })
+        ),
+        MaskedPaths: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({
+                description:
+                    "MaskedPaths is the list of paths to be masked inside the container (this overrides the default set of paths)",
+            })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        ReadonlyPaths: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({
+                description:
+                    "ReadonlyPaths is the list of paths to be set as read-only inside the container (this overrides the default set of paths)",
+            })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        Init: Schema.optional(
+            Schema.NullOr(Schema.Boolean).annotate({
+                description: "Run a custom init inside the container, if null, use the daemon's configured settings",
+            })
+        ),
+    },
+    {
+        identifier: "ContainerHostConfig",
+        title: "container.HostConfig",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/container#HostConfig",
+        description:
+            'HostConfig the non-portable Config structure of a container.\nHere, "non-portable" means "dependent of the host we are running on".\nPortable information *should* appear in Config.',
+    }
+) {}
@@ -0,0 +1,68 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
+import * as ContainerConfig from "./ContainerConfig.generated.ts";
+import * as ContainerHostConfig from "./ContainerHostConfig.generated.ts";
+import * as TypesContainerNode from "./TypesContainerNode.generated.ts";
+import * as TypesContainerState from "./TypesContainerState.generated.ts";
+import * as TypesGraphDriverData from "./TypesGraphDriverData.generated.ts";
+import * as TypesMountPoint from "./TypesMountPoint.generated.ts";
+import * as TypesNetworkSettings from "./TypesNetworkSettings.generated.ts";
+
+export class ContainerInspectResponse extends Schema.Class<ContainerInspectResponse>("ContainerInspectResponse")(
+    {
+        Id: Schema.optional(MobyIdentifiers.ContainerIdentifier),
+        Created: Schema.optional(Schema.String),
+        Path: Schema.optional(Schema.String),
+        Args: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
+        State: Schema.optional(Schema.NullOr(TypesContainerState.TypesContainerState)),
+        Image: Schema.optional(Schema.String),
+        ResolvConfPath: Schema.optional(Schema.String),
+        HostnamePath: Schema.optional(Schema.String),
+        HostsPath: Schema.optional(Schema.String),
+        LogPath: Schema.optional(Schema.String),
+        Node: Schema.optional(
+            Schema.NullOr(TypesContainerNode.TypesContainerNode).annotate({
+                description: "Node is only propagated by Docker Swarm standalone API",
+            })
+        ),
+        Name: Schema.optional(Schema.String),
+        RestartCount: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            )
+        ),
+        Driver: Schema.optional(Schema.String),
+        Platform: Schema.optional(Schema.String),
+        MountLabel: Schema.optional(Schema.String),
+        ProcessLabel: Schema.optional(Schema.String),
+        AppArmorProfile: Schema.optional(Schema.String),
+        ExecIDs: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
+        HostConfig: Schema.optional(Schema.NullOr(ContainerHostConfig.ContainerHostConfig)),
+        GraphDriver: Schema.optional(Schema.NullOr(TypesGraphDriverData.TypesGraphDriverData)),
+        SizeRw: Schema.optional(
+            Schema.NullOr(
+                MobyNumber.BigIntFromWireString.check(
+                    Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+                )
+            )
+        ),
+        SizeRootFs: Schema.optional(
+            Schema.NullOr(
+                MobyNumber.BigIntFromWireString.check(
+                    Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+                )
+            )
+        ),
+        Mounts: Schema.NullOr(Schema.Array(Schema.NullOr(TypesMountPoint.TypesMountPoint))),
+        Config: Schema.NullOr(ContainerConfig.ContainerConfig),
+        NetworkSettings: Schema.NullOr(TypesNetworkSettings.TypesNetworkSettings),
+    },
+    {
+        identifier: "ContainerInspectResponse",
+        title: "types.ContainerJSON",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#ContainerJSON",
+        description: "ContainerJSON is newly used struct along with MountPoint",
+    }
+) {}
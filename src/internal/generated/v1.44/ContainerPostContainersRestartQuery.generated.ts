@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerPostContainersRestartQuery = Schema.Struct({
+    signal: Schema.optional(Schema.String),
+    t: Schema.optional(Schema.Finite),
+}).annotate({
+    identifier: "ContainerPostContainersRestartQuery",
+    title: "container.postContainersRestart",
+    description: "Query parameters of POST /containers/{name:.*}/restart",
+});
+
+export type ContainerPostContainersRestartQuery = Schema.Schema.Type<typeof ContainerPostContainersRestartQuery>;
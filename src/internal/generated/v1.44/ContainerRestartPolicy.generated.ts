@@ -0,0 +1,20 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as ContainerRestartPolicyMode from "./ContainerRestartPolicyMode.generated.ts";
+
+export class ContainerRestartPolicy extends Schema.Class<ContainerRestartPolicy>("ContainerRestartPolicy")(
+    {
+        Name: ContainerRestartPolicyMode.ContainerRestartPolicyMode,
+        MaximumRetryCount: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+    },
+    {
+        identifier: "ContainerRestartPolicy",
+        title: "container.RestartPolicy",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/container#RestartPolicy",
+        description: "RestartPolicy represents the restart policies of the container.",
+    }
+) {}
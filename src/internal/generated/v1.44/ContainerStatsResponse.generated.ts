@@ -0,0 +1,41 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as TypesBlkioStats from "./TypesBlkioStats.generated.ts";
+import * as TypesCPUStats from "./TypesCPUStats.generated.ts";
+import * as TypesMemoryStats from "./TypesMemoryStats.generated.ts";
+import * as TypesNetworkStats from "./TypesNetworkStats.generated.ts";
+import * as TypesPidsStats from "./TypesPidsStats.generated.ts";
+import * as TypesStorageStats from "./TypesStorageStats.generated.ts";
+
+export class ContainerStatsResponse extends Schema.Class<ContainerStatsResponse>("ContainerStatsResponse")(
+    {
+        read: Schema.NullOr(Schema.DateFromString).annotate({ description: "Common stats" }),
+        preread: Schema.NullOr(Schema.DateFromString),
+        pids_stats: Schema.NullOr(TypesPidsStats.TypesPidsStats).annotate({
+            description: "Linux specific stats, not populated on Windows.",
+        }),
+        blkio_stats: Schema.NullOr(TypesBlkioStats.TypesBlkioStats),
+        num_procs: MobyNumber.NumberFromWireString.check(
+            Schema.isInt(),
+            Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
+        ).annotate({ description: "Windows specific stats, not populated on Linux." }),
+        storage_stats: Schema.NullOr(TypesStorageStats.TypesStorageStats),
+        cpu_stats: Schema.NullOr(TypesCPUStats.TypesCPUStats).annotate({ description: "Shared stats" }),
+        precpu_stats: Schema.NullOr(TypesCPUStats.TypesCPUStats).annotate({ description: '"Pre"="Previous"' }),
+        memory_stats: Schema.NullOr(TypesMemoryStats.TypesMemoryStats),
+        name: Schema.optional(Schema.String),
+        id: Schema.optional(Schema.String),
+        networks: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.NullOr(TypesNetworkStats.TypesNetworkStats))).annotate({
+                description: "Networks request version >=1.21",
+            })
+        ),
+    },
+    {
+        identifier: "ContainerStatsResponse",
+        title: "types.StatsJSON",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#StatsJSON",
+        description: "StatsJSON is newly used Networks",
+    }
+) {}
@@ -0,0 +1,31 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class ContainerStopOptions extends Schema.Class<ContainerStopOptions>("ContainerStopOptions")(
+    {
+        Signal: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "Signal (optional) is the signal to send to the container to (gracefully)\nstop it before forcibly terminating the container with SIGKILL after the\ntimeout expires. If not value is set, the default (SIGTERM) is used.",
+            })
+        ),
+        Timeout: Schema.optional(
+            Schema.NullOr(
+                MobyNumber.BigIntFromWireString.check(
+                    Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+                )
+            ).annotate({
+                description:
+                    "Timeout (optional) is the timeout (in seconds) to wait for the container\nto stop gracefully before forcibly terminating it with SIGKILL.\n\n- Use nil to use the default timeout (10 seconds).\n- Use '-1' to wait indefinitely.\n- Use '0' to not wait for the container to exit gracefully, and\n  immediately proceeds to forcibly terminating the container.\n- Other positive values are used as timeout (in seconds).",
+            })
+        ),
+    },
+    {
+        identifier: "ContainerStopOptions",
+        title: "container.StopOptions",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/container#StopOptions",
+        description: "StopOptions holds the options to stop or restart a container.",
+    }
+) {}
@@ -0,0 +1,44 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
+import * as TypesContainerHostConfig from "./TypesContainerHostConfig.generated.ts";
+import * as TypesMountPoint from "./TypesMountPoint.generated.ts";
+import * as TypesPort from "./TypesPort.generated.ts";
+import * as TypesSummaryNetworkSettings from "./TypesSummaryNetworkSettings.generated.ts";
+
+export class ContainerSummary extends Schema.Class<ContainerSummary>("ContainerSummary")(
+    {
+        Id: MobyIdentifiers.ContainerIdentifier,
+        Names: Schema.NullOr(Schema.Array(Schema.String)),
+        Image: Schema.String,
+        ImageID: MobyIdentifiers.ImageIdentifier,
+        Command: Schema.String,
+        Created: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        Ports: Schema.NullOr(Schema.Array(Schema.NullOr(TypesPort.TypesPort))),
+        SizeRw: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            )
+        ),
+        SizeRootFs: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            )
+        ),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+        State: Schema.String,
+        Status: Schema.String,
+        HostConfig: TypesContainerHostConfig.TypesContainerHostConfig,
+        NetworkSettings: Schema.NullOr(TypesSummaryNetworkSettings.TypesSummaryNetworkSettings),
+        Mounts: Schema.NullOr(Schema.Array(Schema.NullOr(TypesMountPoint.TypesMountPoint))),
+    },
+    {
+        identifier: "ContainerSummary",
+        title: "types.Container",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#Container",
+        description: 'Container contains response of Engine API:\nGET "/containers/json"',
+    }
+) {}
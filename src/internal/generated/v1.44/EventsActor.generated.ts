@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+
+export class EventsActor extends Schema.Class<EventsActor>("EventsActor")(
+    {
+        ID: Schema.String,
+        Attributes: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+    },
+    {
+        identifier: "EventsActor",
+        title: "events.Actor",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/events#Actor",
+        description:
+            "Actor describes something that generates events,\nlike a container, or a network, or a volume.\nIt has a defined name and a set of attributes.\nThe container attributes are its labels, other actors\ncan generate these attributes from other properties.",
+    }
+) {}
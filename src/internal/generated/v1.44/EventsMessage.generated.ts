@@ -0,0 +1,38 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as EventsAction from "./EventsAction.generated.ts";
+import * as EventsActor from "./EventsActor.generated.ts";
+import * as EventsType from "./EventsType.generated.ts";
+
+export class EventsMessage extends Schema.Class<EventsMessage>("EventsMessage")(
+    {
+        status: Schema.optional(Schema.String.annotate({ description: "Deprecated: use Action instead." })),
+        id: Schema.optional(Schema.String.annotate({ description: "Deprecated: use Actor.ID instead." })),
+        from: Schema.optional(
+            Schema.String.annotate({ description: 'Deprecated: use Actor.Attributes["image"] instead.' })
+        ),
+        Type: EventsType.EventsType,
+        Action: EventsAction.EventsAction,
+        Actor: Schema.NullOr(EventsActor.EventsActor),
+        scope: Schema.optional(
+            Schema.String.annotate({ description: "Engine events are local scope. Cluster events are swarm scope." })
+        ),
+        time: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            )
+        ),
+        timeNano: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            )
+        ),
+    },
+    {
+        identifier: "EventsMessage",
+        title: "events.Message",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/events#Message",
+        description: "Message represents the information an event contains",
+    }
+) {}
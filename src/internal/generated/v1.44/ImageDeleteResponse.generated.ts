@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export class ImageDeleteResponse extends Schema.Class<ImageDeleteResponse>("ImageDeleteResponse")(
+    {
+        Deleted: Schema.optional(Schema.String.annotate({ description: "The image ID of an image that was deleted" })),
+        Untagged: Schema.optional(
+            Schema.String.annotate({ description: "The image ID of an image that was untagged" })
+        ),
+    },
+    {
+        identifier: "ImageDeleteResponse",
+        title: "image.DeleteResponse",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/image#DeleteResponse",
+        description: "DeleteResponse delete response\nswagger:model DeleteResponse",
+    }
+) {}
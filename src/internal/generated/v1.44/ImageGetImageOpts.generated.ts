@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+import * as V1Platform from "./V1Platform.generated.ts";
+
+export class ImageGetImageOpts extends Schema.Class<ImageGetImageOpts>("ImageGetImageOpts")(
+    {
+        Platform: Schema.NullOr(V1Platform.V1Platform),
+        Details: Schema.Boolean,
+    },
+    {
+        identifier: "ImageGetImageOpts",
+        title: "image.GetImageOpts",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/image#GetImageOpts",
+        description: "GetImageOpts holds parameters to inspect an image.",
+    }
+) {}
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const ImageGetImagesGetQuery = Schema.Struct({
+    names: Schema.optional(Schema.Array(Schema.String)),
+}).annotate({
+    identifier: "ImageGetImagesGetQuery",
+    title: "image.getImagesGet",
+    description: "Query parameters of GET /images/get, GET /images/{name:.*}/get",
+});
+
+export type ImageGetImagesGetQuery = Schema.Schema.Type<typeof ImageGetImagesGetQuery>;
@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export const ImageGetImagesSearchQuery = Schema.Struct({
+    limit: Schema.optional(Schema.Finite),
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+    term: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "ImageGetImagesSearchQuery",
+    title: "image.getImagesSearch",
+    description: "Query parameters of GET /images/search",
+});
+
+export type ImageGetImagesSearchQuery = Schema.Schema.Type<typeof ImageGetImagesSearchQuery>;
@@ -0,0 +1,27 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class ImageHistoryResponseItem extends Schema.Class<ImageHistoryResponseItem>("ImageHistoryResponseItem")(
+    {
+        Comment: Schema.String.annotate({ description: "comment\nRequired: true" }),
+        Created: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({ description: "created\nRequired: true" }),
+        CreatedBy: Schema.String.annotate({ description: "created by\nRequired: true" }),
+        Id: MobyIdentifiers.ImageIdentifier.annotate({ description: "Id\nRequired: true" }),
+        Size: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({ description: "size\nRequired: true" }),
+        Tags: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "tags\nRequired: true" }),
+    },
+    {
+        identifier: "ImageHistoryResponseItem",
+        title: "image.HistoryResponseItem",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/image#HistoryResponseItem",
+        description:
+            "HistoryResponseItem individual image layer information in response to ImageHistory operation\nswagger:model HistoryResponseItem",
+    }
+) {}
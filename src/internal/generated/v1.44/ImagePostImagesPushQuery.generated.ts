@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const ImagePostImagesPushQuery = Schema.Struct({
+    tag: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "ImagePostImagesPushQuery",
+    title: "image.postImagesPush",
+    description: "Query parameters of POST /images/{name:.*}/push",
+});
+
+export type ImagePostImagesPushQuery = Schema.Schema.Type<typeof ImagePostImagesPushQuery>;
@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export const ImagePostImagesTagQuery = Schema.Struct({
+    repo: Schema.optional(Schema.String),
+    tag: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "ImagePostImagesTagQuery",
+    title: "image.postImagesTag",
+    description: "Query parameters of POST /images/{name:.*}/tag",
+});
+
+export type ImagePostImagesTagQuery = Schema.Schema.Type<typeof ImagePostImagesTagQuery>;
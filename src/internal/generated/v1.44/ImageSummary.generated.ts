@@ -0,0 +1,66 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class ImageSummary extends Schema.Class<ImageSummary>("ImageSummary")(
+    {
+        Containers: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "Number of containers using this image. Includes both stopped and running\ncontainers.\n\nThis size is not calculated by default, and depends on which API endpoint\nis used. `-1` indicates that the value has not been set / calculated.\n\nRequired: true",
+        }),
+        Created: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "Date and time at which the image was created as a Unix timestamp\n(number of seconds sinds EPOCH).\n\nRequired: true",
+        }),
+        Id: MobyIdentifiers.ImageIdentifier.annotate({
+            description:
+                "ID is the content-addressable ID of an image.\n\nThis identifier is a content-addressable digest calculated from the\nimage's configuration (which includes the digests of layers used by\nthe image).\n\nNote that this digest differs from the `RepoDigests` below, which\nholds digests of image manifests that reference the image.\n\nRequired: true",
+        }),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+            description: "User-defined key/value metadata.\nRequired: true",
+        }),
+        ParentId: Schema.String.annotate({
+            description:
+                "ID of the parent image.\n\nDepending on how the image was created, this field may be empty and\nis only set for images that were built/created locally. This field\nis empty if the image was pulled from an image registry.\n\nRequired: true",
+        }),
+        RepoDigests: Schema.NullOr(Schema.Array(MobyIdentifiers.Digest)).annotate({
+            description:
+                "List of content-addressable digests of locally available image manifests\nthat the image is referenced from. Multiple manifests can refer to the\nsame image.\n\nThese digests are usually only available if the image was either pulled\nfrom a registry, or if the image was pushed to a registry, which is when\nthe manifest is generated and its digest calculated.\n\nRequired: true",
+        }),
+        RepoTags: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description:
+                'List of image names/tags in the local image cache that reference this\nimage.\n\nMultiple image tags can refer to the same image, and this list may be\nempty if no tags reference the image, in which case the image is\n"untagged", in which case it can still be referenced by its ID.\n\nRequired: true',
+        }),
+        SharedSize: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "Total size of image layers that are shared between this image and other\nimages.\n\nThis size is not calculated by default. `-1` indicates that the value\nhas not been set / calculated.\n\nRequired: true",
+        }),
+        Size: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description: "Total size of the image including all layers it is composed of.\n\nRequired: true",
+        }),
+        VirtualSize: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({
+                deprecatedIn: "1.44",
+                description:
+                    "Total size of the image including all layers it is composed of.\n\nDeprecated: this field is omitted in API v1.44, but kept for backward compatibility. Use Size instead.",
+            })
+        ),
+    },
+    {
+        identifier: "ImageSummary",
+        title: "image.Summary",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/image#Summary",
+        description: "Summary summary\nswagger:model Summary",
+    }
+) {}
@@ -0,0 +1,21 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class JsonmessageJSONError extends Schema.Class<JsonmessageJSONError>("JsonmessageJSONError")(
+    {
+        code: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            )
+        ),
+        message: Schema.optional(Schema.String),
+    },
+    {
+        identifier: "JsonmessageJSONError",
+        title: "jsonmessage.JSONError",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/pkg/jsonmessage#JSONError",
+        description:
+            "JSONError wraps a concrete Code and Message, Code is\nan integer error code, Message is the error message.",
+    }
+) {}
@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export class MountClusterOptions extends Schema.Class<MountClusterOptions>("MountClusterOptions")(
+    {},
+    {
+        identifier: "MountClusterOptions",
+        title: "mount.ClusterOptions",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/mount#ClusterOptions",
+        description: "ClusterOptions specifies options for a Cluster volume.",
+    }
+) {}
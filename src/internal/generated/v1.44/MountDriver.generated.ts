@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class MountDriver extends Schema.Class<MountDriver>("MountDriver")(
+    {
+        Name: Schema.optional(Schema.String),
+        Options: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.String))),
+    },
+    {
+        identifier: "MountDriver",
+        title: "mount.Driver",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/mount#Driver",
+        description: "Driver represents a volume driver.",
+    }
+) {}
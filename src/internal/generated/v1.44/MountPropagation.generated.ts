@@ -0,0 +1,26 @@
+import * as Schema from "effect/Schema";
+
+export const MountPropagation = Schema.Literals([
+    "rprivate",
+    "private",
+    "rshared",
+    "shared",
+    "rslave",
+    "slave",
+]).annotate({
+    identifier: "MountPropagation",
+    title: "mount.Propagation",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/mount#Propagation",
+    description: "Propagation represents the propagation of a mount.",
+});
+
+export type MountPropagation = Schema.Schema.Type<typeof MountPropagation>;
+
+export const MountPropagationConstants = Object.freeze({
+    PropagationRPrivate: "rprivate",
+    PropagationPrivate: "private",
+    PropagationRShared: "rshared",
+    PropagationShared: "shared",
+    PropagationRSlave: "rslave",
+    PropagationSlave: "slave",
+} as const);
@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class NetworkConfigReference extends Schema.Class<NetworkConfigReference>("NetworkConfigReference")(
+    {
+        Network: Schema.String,
+    },
+    {
+        identifier: "NetworkConfigReference",
+        title: "network.ConfigReference",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/network#ConfigReference",
+        description: "ConfigReference specifies the source which provides a network's configuration",
+    }
+) {}
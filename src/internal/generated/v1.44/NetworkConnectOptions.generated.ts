@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+import * as NetworkEndpointSettings from "./NetworkEndpointSettings.generated.ts";
+
+export class NetworkConnectOptions extends Schema.Class<NetworkConnectOptions>("NetworkConnectOptions")(
+    {
+        Container: Schema.String,
+        EndpointConfig: Schema.optional(Schema.NullOr(NetworkEndpointSettings.NetworkEndpointSettings)),
+    },
+    {
+        identifier: "NetworkConnectOptions",
+        title: "types.NetworkConnect",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#NetworkConnect",
+        description: "NetworkConnect represents the data to be used to connect a container to the network",
+    }
+) {}
@@ -0,0 +1,35 @@
+import * as Effect from "effect/Effect";
+import * as Schema from "effect/Schema";
+
+import * as NetworkConfigReference from "./NetworkConfigReference.generated.ts";
+import * as NetworkIPAM from "./NetworkIPAM.generated.ts";
+
+export class NetworkCreateRequest extends Schema.Class<NetworkCreateRequest>("NetworkCreateRequest")(
+    {
+        CheckDuplicate: Schema.optional(
+            Schema.Boolean.annotate({
+                description:
+                    "Deprecated: CheckDuplicate is deprecated since API v1.44, but it defaults to true when sent by the client\npackage to older daemons.",
+            })
+        ),
+        Driver: Schema.String.pipe(Schema.withConstructorDefault(Effect.sync(() => "bridge"))),
+        Scope: Schema.String,
+        EnableIPv6: Schema.Boolean,
+        IPAM: Schema.NullOr(NetworkIPAM.NetworkIPAM),
+        Internal: Schema.Boolean,
+        Attachable: Schema.Boolean,
+        Ingress: Schema.Boolean,
+        ConfigOnly: Schema.Boolean.pipe(Schema.withConstructorDefault(Effect.sync(() => false))),
+        ConfigFrom: Schema.NullOr(NetworkConfigReference.NetworkConfigReference),
+        Options: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+        Name: Schema.String,
+    },
+    {
+        identifier: "NetworkCreateRequest",
+        title: "types.NetworkCreateRequest",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#NetworkCreateRequest",
+        description: "NetworkCreateRequest is the request message sent to the server for network create call.",
+    }
+) {}
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export class NetworkEndpointIPAMConfig extends Schema.Class<NetworkEndpointIPAMConfig>("NetworkEndpointIPAMConfig")(
+    {
+        IPv4Address: Schema.optional(Schema.String),
+        IPv6Address: Schema.optional(Schema.String),
+        LinkLocalIPs: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
+    },
+    {
+        identifier: "NetworkEndpointIPAMConfig",
+        title: "network.EndpointIPAMConfig",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/network#EndpointIPAMConfig",
+        description: "EndpointIPAMConfig represents IPAM configurations for the endpoint",
+    }
+) {}
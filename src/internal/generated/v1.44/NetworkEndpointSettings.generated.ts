@@ -0,0 +1,44 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as NetworkEndpointIPAMConfig from "./NetworkEndpointIPAMConfig.generated.ts";
+
+export class NetworkEndpointSettings extends Schema.Class<NetworkEndpointSettings>("NetworkEndpointSettings")(
+    {
+        IPAMConfig: Schema.NullOr(NetworkEndpointIPAMConfig.NetworkEndpointIPAMConfig).annotate({
+            description: "Configurations",
+        }),
+        Links: Schema.NullOr(Schema.Array(Schema.String)),
+        Aliases: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description: "Aliases holds the list of extra, user-specified DNS names for this endpoint.",
+        }),
+        MacAddress: Schema.String.annotate({
+            description:
+                "MacAddress may be used to specify a MAC address when the container is created.\nOnce the container is running, it becomes operational data (it may contain a\ngenerated address).",
+        }),
+        NetworkID: Schema.String.annotate({ description: "Operational data" }),
+        EndpointID: Schema.String,
+        Gateway: Schema.String,
+        IPAddress: Schema.String,
+        IPPrefixLen: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        IPv6Gateway: Schema.String,
+        GlobalIPv6Address: Schema.String,
+        GlobalIPv6PrefixLen: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        DriverOpts: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+        DNSNames: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description:
+                "DNSNames holds all the (non fully qualified) DNS names associated to this endpoint. First entry is used to\ngenerate PTR records.",
+        }),
+    },
+    {
+        identifier: "NetworkEndpointSettings",
+        title: "network.EndpointSettings",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/network#EndpointSettings",
+        description: "EndpointSettings stores the network endpoint details",
+    }
+) {}
@@ -0,0 +1,69 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as NetworkConfigReference from "./NetworkConfigReference.generated.ts";
+import * as NetworkIPAM from "./NetworkIPAM.generated.ts";
+import * as NetworkPeerInfo from "./NetworkPeerInfo.generated.ts";
+import * as NetworkServiceInfo from "./NetworkServiceInfo.generated.ts";
+import * as TypesEndpointResource from "./TypesEndpointResource.generated.ts";
+
+export class NetworkInspect extends Schema.Class<NetworkInspect>("NetworkInspect")(
+    {
+        Name: Schema.String.annotate({ description: "Name is the requested name of the network" }),
+        Id: MobyIdentifiers.NetworkIdentifier.annotate({
+            description: "ID uniquely identifies a network on a single machine",
+        }),
+        Created: Schema.NullOr(Schema.DateFromString).annotate({
+            description: "Created is the time the network created",
+        }),
+        Scope: Schema.String.annotate({
+            description:
+                "Scope describes the level at which the network exists (e.g. `swarm` for cluster-wide or `local` for machine level)",
+        }),
+        Driver: Schema.String.annotate({
+            description: "Driver is the Driver name used to create the network (e.g. `bridge`, `overlay`)",
+        }),
+        EnableIPv6: Schema.Boolean.annotate({ description: "EnableIPv6 represents whether to enable IPv6" }),
+        IPAM: Schema.NullOr(NetworkIPAM.NetworkIPAM).annotate({
+            description: "IPAM is the network's IP Address Management",
+        }),
+        Internal: Schema.Boolean.annotate({ description: "Internal represents if the network is used internal only" }),
+        Attachable: Schema.Boolean.annotate({
+            description:
+                "Attachable represents if the global scope is manually attachable by regular containers from workers in swarm mode.",
+        }),
+        Ingress: Schema.Boolean.annotate({
+            description: "Ingress indicates the network is providing the routing-mesh for the swarm cluster.",
+        }),
+        ConfigFrom: Schema.NullOr(NetworkConfigReference.NetworkConfigReference).annotate({
+            description: "ConfigFrom specifies the source which will provide the configuration for this network.",
+        }),
+        ConfigOnly: Schema.Boolean.annotate({
+            description:
+                "ConfigOnly networks are place-holder networks for network configurations to be used by other networks. ConfigOnly networks cannot be used directly to run containers or services.",
+        }),
+        Containers: Schema.NullOr(
+            Schema.Record(Schema.String, Schema.NullOr(TypesEndpointResource.TypesEndpointResource))
+        ).annotate({ description: "Containers contains endpoints belonging to the network" }),
+        Options: Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+            description: "Options holds the network specific options to use for when creating the network",
+        }),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+            description: "Labels holds metadata specific to the network being created",
+        }),
+        Peers: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.NullOr(NetworkPeerInfo.NetworkPeerInfo))).annotate({
+                description: "List of peer nodes for an overlay network",
+            })
+        ),
+        Services: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.NullOr(NetworkServiceInfo.NetworkServiceInfo)))
+        ),
+    },
+    {
+        identifier: "NetworkInspect",
+        title: "types.NetworkResource",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#NetworkResource",
+        description: 'NetworkResource is the body of the "get network" http response message',
+    }
+) {}
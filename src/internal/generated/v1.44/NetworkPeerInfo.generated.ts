@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class NetworkPeerInfo extends Schema.Class<NetworkPeerInfo>("NetworkPeerInfo")(
+    {
+        Name: Schema.String,
+        IP: Schema.String,
+    },
+    {
+        identifier: "NetworkPeerInfo",
+        title: "network.PeerInfo",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/network#PeerInfo",
+        description: "PeerInfo represents one peer of an overlay network",
+    }
+) {}
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export class NetworkTask extends Schema.Class<NetworkTask>("NetworkTask")(
+    {
+        Name: Schema.String,
+        EndpointID: Schema.String,
+        EndpointIP: Schema.String,
+        Info: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+    },
+    {
+        identifier: "NetworkTask",
+        title: "network.Task",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/network#Task",
+        description: "Task carries the information about one backend task",
+    }
+) {}
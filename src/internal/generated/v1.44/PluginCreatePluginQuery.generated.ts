@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const PluginCreatePluginQuery = Schema.Struct({
+    name: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "PluginCreatePluginQuery",
+    title: "plugin.createPlugin",
+    description: "Query parameters of POST /plugins/create",
+});
+
+export type PluginCreatePluginQuery = Schema.Schema.Type<typeof PluginCreatePluginQuery>;
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const PluginListPluginsQuery = Schema.Struct({
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+}).annotate({
+    identifier: "PluginListPluginsQuery",
+    title: "plugin.listPlugins",
+    description: "Query parameters of GET /plugins",
+});
+
+export type PluginListPluginsQuery = Schema.Schema.Type<typeof PluginListPluginsQuery>;
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const PluginUpgradePluginQuery = Schema.Struct({
+    remote: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "PluginUpgradePluginQuery",
+    title: "plugin.upgradePlugin",
+    description: "Query parameters of POST /plugins/{name:.*}/upgrade",
+});
+
+export type PluginUpgradePluginQuery = Schema.Schema.Type<typeof PluginUpgradePluginQuery>;
@@ -0,0 +1,32 @@
+import * as Schema from "effect/Schema";
+
+export class RegistryAuthConfig extends Schema.Class<RegistryAuthConfig>("RegistryAuthConfig")(
+    {
+        username: Schema.optional(Schema.String),
+        password: Schema.optional(Schema.String),
+        auth: Schema.optional(Schema.String),
+        email: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "Email is an optional value associated with the username.\nThis field is deprecated and will be removed in a later\nversion of docker.",
+            })
+        ),
+        serveraddress: Schema.optional(Schema.String),
+        identitytoken: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "IdentityToken is used to authenticate the user and get\nan access token for the registry.",
+            })
+        ),
+        registrytoken: Schema.optional(
+            Schema.String.annotate({ description: "RegistryToken is a bearer token to be sent to a registry" })
+        ),
+    },
+    {
+        identifier: "RegistryAuthConfig",
+        title: "registry.AuthConfig",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/registry#AuthConfig",
+        description: "AuthConfig contains authorization information for connecting to a Registry.",
+    }
+) {}
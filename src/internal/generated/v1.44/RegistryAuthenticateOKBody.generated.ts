@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export class RegistryAuthenticateOKBody extends Schema.Class<RegistryAuthenticateOKBody>("RegistryAuthenticateOKBody")(
+    {
+        IdentityToken: Schema.String.annotate({
+            description: "An opaque token used to authenticate a user after a successful login\nRequired: true",
+        }),
+        Status: Schema.String.annotate({ description: "The status of the authentication\nRequired: true" }),
+    },
+    {
+        identifier: "RegistryAuthenticateOKBody",
+        title: "registry.AuthenticateOKBody",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/registry#AuthenticateOKBody",
+        description: "AuthenticateOKBody authenticate o k body\nswagger:model AuthenticateOKBody",
+    }
+) {}
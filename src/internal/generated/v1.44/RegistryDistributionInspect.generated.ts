@@ -0,0 +1,27 @@
+import * as Schema from "effect/Schema";
+
+import * as V1Descriptor from "./V1Descriptor.generated.ts";
+import * as V1Platform from "./V1Platform.generated.ts";
+
+export class RegistryDistributionInspect extends Schema.Class<RegistryDistributionInspect>(
+    "RegistryDistributionInspect"
+)(
+    {
+        Descriptor: Schema.NullOr(V1Descriptor.V1Descriptor).annotate({
+            description:
+                "Descriptor contains information about the manifest, including\nthe content addressable digest",
+        }),
+        Platforms: Schema.NullOr(Schema.Array(Schema.NullOr(V1Platform.V1Platform))).annotate({
+            description:
+                "Platforms contains the list of platforms supported by the image,\nobtained by parsing the manifest",
+        }),
+    },
+    {
+        identifier: "RegistryDistributionInspect",
+        title: "registry.DistributionInspect",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/registry#DistributionInspect",
+        description:
+            "DistributionInspect describes the result obtained from contacting the\nregistry to retrieve image metadata",
+    }
+) {}
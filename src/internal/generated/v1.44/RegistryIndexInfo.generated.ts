@@ -0,0 +1,22 @@
+import * as Schema from "effect/Schema";
+
+export class RegistryIndexInfo extends Schema.Class<RegistryIndexInfo>("RegistryIndexInfo")(
+    {
+        Name: Schema.String.annotate({ description: 'Name is the name of the registry, such as "docker.io"' }),
+        Mirrors: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description: "Mirrors is a list of mirrors, expressed as URIs",
+        }),
+        Secure: Schema.Boolean.annotate({
+            description:
+                "Secure is set to false if the registry is part of the list of\ninsecure registries. Insecure registries accept HTTP and/or accept\nHTTPS with certificates from unknown CAs.",
+        }),
+        Official: Schema.Boolean.annotate({ description: "Official indicates whether this is an official registry" }),
+    },
+    {
+        identifier: "RegistryIndexInfo",
+        title: "registry.IndexInfo",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/registry#IndexInfo",
+        description:
+            'IndexInfo contains information about a registry\n\nRepositoryInfo Examples:\n\n\t{\n\t  "Index" : {\n\t    "Name" : "docker.io",\n\t    "Mirrors" : ["https://registry-2.docker.io/v1/", "https://registry-3.docker.io/v1/"],\n\t    "Secure" : true,\n\t    "Official" : true,\n\t  },\n\t  "RemoteName" : "library/debian",\n\t  "LocalName" : "debian",\n\t  "CanonicalName" : "docker.io/debian"\n\t  "Official" : true,\n\t}\n\n\t{\n\t  "Index" : {\n\t    "Name" : "127.0.0.1:5000",\n\t    "Mirrors" : [],\n\t    "Secure" : false,\n\t    "Official" : false,\n\t  },\n\t  "RemoteName" : "user/repo",\n\t  "LocalName" : "127.0.0.1:5000/user/repo",\n\t  "CanonicalName" : "127.0.0.1:5000/user/repo",\n\t  "Official" : false,\n\t}',
+    }
+) {}
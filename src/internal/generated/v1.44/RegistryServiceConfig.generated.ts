@@ -0,0 +1,24 @@
+import * as Schema from "effect/Schema";
+
+import * as EffectSchemas from "effect-schemas";
+
+import * as RegistryIndexInfo from "./RegistryIndexInfo.generated.ts";
+
+export class RegistryServiceConfig extends Schema.Class<RegistryServiceConfig>("RegistryServiceConfig")(
+    {
+        AllowNondistributableArtifactsCIDRs: Schema.NullOr(
+            Schema.Array(Schema.NullOr(EffectSchemas.Internet.CidrBlockFromString))
+        ),
+        AllowNondistributableArtifactsHostnames: Schema.NullOr(Schema.Array(Schema.String)),
+        InsecureRegistryCIDRs: Schema.NullOr(Schema.Array(Schema.NullOr(EffectSchemas.Internet.CidrBlockFromString))),
+        IndexConfigs: Schema.NullOr(Schema.Record(Schema.String, Schema.NullOr(RegistryIndexInfo.RegistryIndexInfo))),
+        Mirrors: Schema.NullOr(Schema.Array(Schema.String)),
+    },
+    {
+        identifier: "RegistryServiceConfig",
+        title: "registry.ServiceConfig",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/registry#ServiceConfig",
+        description: "ServiceConfig stores daemon registry services configuration.",
+    }
+) {}
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export class RuntimePluginPrivilege extends Schema.Class<RuntimePluginPrivilege>("RuntimePluginPrivilege")(
+    {
+        name: Schema.optional(Schema.String),
+        description: Schema.optional(Schema.String),
+        value: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
+    },
+    {
+        identifier: "RuntimePluginPrivilege",
+        title: "runtime.PluginPrivilege",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm/runtime#PluginPrivilege",
+        description: "PluginPrivilege describes a permission the user has to accept\nupon installing a plugin.",
+    }
+) {}
@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmAppArmorMode = Schema.Literals(["default", "disabled"]).annotate({
+    identifier: "SwarmAppArmorMode",
+    title: "swarm.AppArmorMode",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#AppArmorMode",
+    description: "AppArmorMode is type used for the enumeration of possible AppArmor modes in\nAppArmorOpts",
+});
+
+export type SwarmAppArmorMode = Schema.Schema.Type<typeof SwarmAppArmorMode>;
+
+export const SwarmAppArmorModeConstants = Object.freeze({
+    AppArmorModeDefault: "default",
+    AppArmorModeDisabled: "disabled",
+} as const);
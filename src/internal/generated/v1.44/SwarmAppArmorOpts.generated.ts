@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmAppArmorMode from "./SwarmAppArmorMode.generated.ts";
+
+export class SwarmAppArmorOpts extends Schema.Class<SwarmAppArmorOpts>("SwarmAppArmorOpts")(
+    {
+        Mode: Schema.optional(SwarmAppArmorMode.SwarmAppArmorMode),
+    },
+    {
+        identifier: "SwarmAppArmorOpts",
+        title: "swarm.AppArmorOpts",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#AppArmorOpts",
+        description:
+            "AppArmorOpts defines the options for configuring AppArmor on a swarm-managed\ncontainer.  Currently, custom AppArmor profiles are not supported.",
+    }
+) {}
@@ -0,0 +1,21 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as SwarmConfigSpec from "./SwarmConfigSpec.generated.ts";
+import * as SwarmVersion from "./SwarmVersion.generated.ts";
+
+export class SwarmConfig extends Schema.Class<SwarmConfig>("SwarmConfig")(
+    {
+        ID: MobyIdentifiers.ConfigIdentifier,
+        Version: Schema.NullOr(SwarmVersion.SwarmVersion),
+        CreatedAt: Schema.NullOr(Schema.DateFromString),
+        UpdatedAt: Schema.NullOr(Schema.DateFromString),
+        Spec: Schema.NullOr(SwarmConfigSpec.SwarmConfigSpec),
+    },
+    {
+        identifier: "SwarmConfig",
+        title: "swarm.Config",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#Config",
+        description: "Config represents a config.",
+    }
+) {}
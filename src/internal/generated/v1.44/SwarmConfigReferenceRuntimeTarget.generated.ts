@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmConfigReferenceRuntimeTarget extends Schema.Class<SwarmConfigReferenceRuntimeTarget>(
+    "SwarmConfigReferenceRuntimeTarget"
+)(
+    {},
+    {
+        identifier: "SwarmConfigReferenceRuntimeTarget",
+        title: "swarm.ConfigReferenceRuntimeTarget",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#ConfigReferenceRuntimeTarget",
+        description:
+            "ConfigReferenceRuntimeTarget is a target for a config specifying that it\nisn't mounted into the container but instead has some other purpose.",
+    }
+) {}
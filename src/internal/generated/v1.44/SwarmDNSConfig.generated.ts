@@ -0,0 +1,28 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmDNSConfig extends Schema.Class<SwarmDNSConfig>("SwarmDNSConfig")(
+    {
+        Nameservers: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "Nameservers specifies the IP addresses of the name servers",
+            })
+        ),
+        Search: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "Search specifies the search list for host-name lookup",
+            })
+        ),
+        Options: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "Options allows certain internal resolver variables to be modified",
+            })
+        ),
+    },
+    {
+        identifier: "SwarmDNSConfig",
+        title: "swarm.DNSConfig",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#DNSConfig",
+        description:
+            "DNSConfig specifies DNS related configurations in resolver configuration file (resolv.conf)\nDetailed documentation is available in:\nhttp://man7.org/linux/man-pages/man5/resolv.conf.5.html\n`nameserver`, `search`, `options` have been supported.\nTODO: `domain` is not supported yet.",
+    }
+) {}
@@ -0,0 +1,24 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class SwarmDiscreteGenericResource extends Schema.Class<SwarmDiscreteGenericResource>(
+    "SwarmDiscreteGenericResource"
+)(
+    {
+        Kind: Schema.optional(Schema.String),
+        Value: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            )
+        ),
+    },
+    {
+        identifier: "SwarmDiscreteGenericResource",
+        title: "swarm.DiscreteGenericResource",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#DiscreteGenericResource",
+        description:
+            'DiscreteGenericResource represents a "user defined" resource which is defined\nas an integer\n"Kind" is used to describe the Kind of a resource (e.g: "GPU", "FPGA", "SSD", ...)\nValue is used to count the resource (SSD=5, HDD=3, ...)',
+    }
+) {}
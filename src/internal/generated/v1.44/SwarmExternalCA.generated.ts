@@ -0,0 +1,28 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmExternalCAProtocol from "./SwarmExternalCAProtocol.generated.ts";
+
+export class SwarmExternalCA extends Schema.Class<SwarmExternalCA>("SwarmExternalCA")(
+    {
+        Protocol: SwarmExternalCAProtocol.SwarmExternalCAProtocol.annotate({
+            description: "Protocol is the protocol used by this external CA.",
+        }),
+        URL: Schema.String.annotate({ description: "URL is the URL where the external CA can be reached." }),
+        Options: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+                description:
+                    "Options is a set of additional key/value pairs whose interpretation\ndepends on the specified CA type.",
+            })
+        ),
+        CACert: Schema.String.annotate({
+            description:
+                "CACert specifies which root CA is used by this external CA.  This certificate must\nbe in PEM format.",
+        }),
+    },
+    {
+        identifier: "SwarmExternalCA",
+        title: "swarm.ExternalCA",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#ExternalCA",
+        description: "ExternalCA defines external CA to be used by the cluster.",
+    }
+) {}
@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmExternalCAProtocol = Schema.Literal("cfssl").annotate({
+    identifier: "SwarmExternalCAProtocol",
+    title: "swarm.ExternalCAProtocol",
+    documentation:
+        "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#ExternalCAProtocol",
+    description: "ExternalCAProtocol represents type of external CA.",
+});
+
+export type SwarmExternalCAProtocol = Schema.Schema.Type<typeof SwarmExternalCAProtocol>;
+
+export const SwarmExternalCAProtocolConstants = Object.freeze({
+    ExternalCAProtocolCFSSL: "cfssl",
+} as const);
@@ -0,0 +1,19 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmDiscreteGenericResource from "./SwarmDiscreteGenericResource.generated.ts";
+import * as SwarmNamedGenericResource from "./SwarmNamedGenericResource.generated.ts";
+
+export class SwarmGenericResource extends Schema.Class<SwarmGenericResource>("SwarmGenericResource")(
+    {
+        NamedResourceSpec: Schema.optional(Schema.NullOr(SwarmNamedGenericResource.SwarmNamedGenericResource)),
+        DiscreteResourceSpec: Schema.optional(Schema.NullOr(SwarmDiscreteGenericResource.SwarmDiscreteGenericResource)),
+    },
+    {
+        identifier: "SwarmGenericResource",
+        title: "swarm.GenericResource",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#GenericResource",
+        description:
+            'GenericResource represents a "user defined" resource which can\nbe either an integer (e.g: SSD=3) or a string (e.g: SSD=sda1)',
+    }
+) {}
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmGetSecretsQuery = Schema.Struct({
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+}).annotate({
+    identifier: "SwarmGetSecretsQuery",
+    title: "swarm.getSecrets",
+    description: "Query parameters of GET /secrets",
+});
+
+export type SwarmGetSecretsQuery = Schema.Schema.Type<typeof SwarmGetSecretsQuery>;
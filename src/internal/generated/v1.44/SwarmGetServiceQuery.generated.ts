@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmGetServiceQuery = Schema.Struct({
+    insertDefaults: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "SwarmGetServiceQuery",
+    title: "swarm.getService",
+    description: "Query parameters of GET /services/{id}",
+});
+
+export type SwarmGetServiceQuery = Schema.Schema.Type<typeof SwarmGetServiceQuery>;
@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmGlobalService extends Schema.Class<SwarmGlobalService>("SwarmGlobalService")(
+    {},
+    {
+        identifier: "SwarmGlobalService",
+        title: "swarm.GlobalService",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#GlobalService",
+        description: "GlobalService is a kind of ServiceMode.",
+    }
+) {}
@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmDriver from "./SwarmDriver.generated.ts";
+import * as SwarmIPAMConfig from "./SwarmIPAMConfig.generated.ts";
+
+export class SwarmIPAMOptions extends Schema.Class<SwarmIPAMOptions>("SwarmIPAMOptions")(
+    {
+        Driver: Schema.NullOr(SwarmDriver.SwarmDriver),
+        Configs: Schema.optional(Schema.NullOr(Schema.Array(Schema.NullOr(SwarmIPAMConfig.SwarmIPAMConfig)))),
+    },
+    {
+        identifier: "SwarmIPAMOptions",
+        title: "swarm.IPAMOptions",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#IPAMOptions",
+        description: "IPAMOptions represents ipam options.",
+    }
+) {}
@@ -0,0 +1,21 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmVersion from "./SwarmVersion.generated.ts";
+
+export class SwarmJobStatus extends Schema.Class<SwarmJobStatus>("SwarmJobStatus")(
+    {
+        JobIteration: Schema.NullOr(SwarmVersion.SwarmVersion).annotate({
+            description:
+                'JobIteration is a value increased each time a Job is executed,\nsuccessfully or otherwise. "Executed", in this case, means the job as a\nwhole has been started, not that an individual Task has been launched. A\njob is "Executed" when its ServiceSpec is updated. JobIteration can be\nused to disambiguate Tasks belonging to different executions of a job.\n\nThough JobIteration will increase with each subsequent execution, it may\nnot necessarily increase by 1, and so JobIteration should not be used to\nkeep track of the number of times a job has been executed.',
+        }),
+        LastExecution: Schema.NullOr(Schema.DateFromString).annotate({
+            description: "LastExecution is the time that the job was last executed, as observed by\nSwarm manager.",
+        }),
+    },
+    {
+        identifier: "SwarmJobStatus",
+        title: "swarm.JobStatus",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#JobStatus",
+        description: "JobStatus is the status of a job-type service.",
+    }
+) {}
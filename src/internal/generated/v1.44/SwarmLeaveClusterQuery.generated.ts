@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmLeaveClusterQuery = Schema.Struct({
+    force: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "SwarmLeaveClusterQuery",
+    title: "swarm.leaveCluster",
+    description: "Query parameters of POST /swarm/leave",
+});
+
+export type SwarmLeaveClusterQuery = Schema.Schema.Type<typeof SwarmLeaveClusterQuery>;
@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmReachability from "./SwarmReachability.generated.ts";
+
+export class SwarmManagerStatus extends Schema.Class<SwarmManagerStatus>("SwarmManagerStatus")(
+    {
+        Leader: Schema.optional(Schema.Boolean),
+        Reachability: Schema.optional(SwarmReachability.SwarmReachability),
+        Addr: Schema.optional(Schema.String),
+    },
+    {
+        identifier: "SwarmManagerStatus",
+        title: "swarm.ManagerStatus",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#ManagerStatus",
+        description: "ManagerStatus represents the status of a manager.",
+    }
+) {}
@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmVersion from "./SwarmVersion.generated.ts";
+
+export class SwarmMeta extends Schema.Class<SwarmMeta>("SwarmMeta")(
+    {
+        Version: Schema.NullOr(SwarmVersion.SwarmVersion),
+        CreatedAt: Schema.NullOr(Schema.DateFromString),
+        UpdatedAt: Schema.NullOr(Schema.DateFromString),
+    },
+    {
+        identifier: "SwarmMeta",
+        title: "swarm.Meta",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#Meta",
+        description: "Meta is a base object inherited by most of the other once.",
+    }
+) {}
@@ -0,0 +1,25 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as SwarmDriver from "./SwarmDriver.generated.ts";
+import * as SwarmIPAMOptions from "./SwarmIPAMOptions.generated.ts";
+import * as SwarmNetworkSpec from "./SwarmNetworkSpec.generated.ts";
+import * as SwarmVersion from "./SwarmVersion.generated.ts";
+
+export class SwarmNetwork extends Schema.Class<SwarmNetwork>("SwarmNetwork")(
+    {
+        ID: MobyIdentifiers.NetworkIdentifier,
+        Version: Schema.NullOr(SwarmVersion.SwarmVersion),
+        CreatedAt: Schema.NullOr(Schema.DateFromString),
+        UpdatedAt: Schema.NullOr(Schema.DateFromString),
+        Spec: Schema.NullOr(SwarmNetworkSpec.SwarmNetworkSpec),
+        DriverState: Schema.NullOr(SwarmDriver.SwarmDriver),
+        IPAMOptions: Schema.optional(Schema.NullOr(SwarmIPAMOptions.SwarmIPAMOptions)),
+    },
+    {
+        identifier: "SwarmNetwork",
+        title: "swarm.Network",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#Network",
+        description: "Network represents a network.",
+    }
+) {}
@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmNetwork from "./SwarmNetwork.generated.ts";
+
+export class SwarmNetworkAttachment extends Schema.Class<SwarmNetworkAttachment>("SwarmNetworkAttachment")(
+    {
+        Network: Schema.NullOr(SwarmNetwork.SwarmNetwork),
+        Addresses: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
+    },
+    {
+        identifier: "SwarmNetworkAttachment",
+        title: "swarm.NetworkAttachment",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#NetworkAttachment",
+        description: "NetworkAttachment represents a network attachment.",
+    }
+) {}
@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmNetworkAttachmentConfig extends Schema.Class<SwarmNetworkAttachmentConfig>(
+    "SwarmNetworkAttachmentConfig"
+)(
+    {
+        Target: Schema.optional(Schema.String),
+        Aliases: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
+        DriverOpts: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.String))),
+    },
+    {
+        identifier: "SwarmNetworkAttachmentConfig",
+        title: "swarm.NetworkAttachmentConfig",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#NetworkAttachmentConfig",
+        description: "NetworkAttachmentConfig represents the configuration of a network attachment.",
+    }
+) {}
@@ -0,0 +1,37 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
+import * as SwarmTopology from "./SwarmTopology.generated.ts";
+
+export class SwarmNodeCSIInfo extends Schema.Class<SwarmNodeCSIInfo>("SwarmNodeCSIInfo")(
+    {
+        PluginName: Schema.optional(
+            Schema.String.annotate({ description: "PluginName is the name of the CSI plugin." })
+        ),
+        NodeID: Schema.optional(
+            MobyIdentifiers.NodeIdentifier.annotate({
+                description:
+                    "NodeID is the ID of the node as reported by the CSI plugin. This is\ndifferent from the swarm node ID.",
+            })
+        ),
+        MaxVolumesPerNode: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({
+                description: "MaxVolumesPerNode is the maximum number of volumes that may be published\nto this node",
+            })
+        ),
+        AccessibleTopology: Schema.optional(
+            Schema.NullOr(SwarmTopology.SwarmTopology).annotate({
+                description: "AccessibleTopology indicates the location of this node in the CSI\nplugin's topology",
+            })
+        ),
+    },
+    {
+        identifier: "SwarmNodeCSIInfo",
+        title: "swarm.NodeCSIInfo",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#NodeCSIInfo",
+        description: "NodeCSIInfo represents information about a CSI plugin available on the node",
+    }
+) {}
@@ -0,0 +1,25 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmEngineDescription from "./SwarmEngineDescription.generated.ts";
+import * as SwarmNodeCSIInfo from "./SwarmNodeCSIInfo.generated.ts";
+import * as SwarmPlatform from "./SwarmPlatform.generated.ts";
+import * as SwarmResources from "./SwarmResources.generated.ts";
+import * as SwarmTLSInfo from "./SwarmTLSInfo.generated.ts";
+
+export class SwarmNodeDescription extends Schema.Class<SwarmNodeDescription>("SwarmNodeDescription")(
+    {
+        Hostname: Schema.optional(Schema.String),
+        Platform: Schema.NullOr(SwarmPlatform.SwarmPlatform),
+        Resources: Schema.NullOr(SwarmResources.SwarmResources),
+        Engine: Schema.NullOr(SwarmEngineDescription.SwarmEngineDescription),
+        TLSInfo: Schema.NullOr(SwarmTLSInfo.SwarmTLSInfo),
+        CSIInfo: Schema.optional(Schema.NullOr(Schema.Array(Schema.NullOr(SwarmNodeCSIInfo.SwarmNodeCSIInfo)))),
+    },
+    {
+        identifier: "SwarmNodeDescription",
+        title: "swarm.NodeDescription",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#NodeDescription",
+        description: "NodeDescription represents the description of a node.",
+    }
+) {}
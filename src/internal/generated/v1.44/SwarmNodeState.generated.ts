@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmNodeState = Schema.Literals(["unknown", "down", "ready", "disconnected"]).annotate({
+    identifier: "SwarmNodeState",
+    title: "swarm.NodeState",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#NodeState",
+    description: "NodeState represents the state of a node.",
+});
+
+export type SwarmNodeState = Schema.Schema.Type<typeof SwarmNodeState>;
+
+export const SwarmNodeStateConstants = Object.freeze({
+    NodeStateUnknown: "unknown",
+    NodeStateDown: "down",
+    NodeStateReady: "ready",
+    NodeStateDisconnected: "disconnected",
+} as const);
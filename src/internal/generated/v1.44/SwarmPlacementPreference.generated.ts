@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmSpreadOver from "./SwarmSpreadOver.generated.ts";
+
+export class SwarmPlacementPreference extends Schema.Class<SwarmPlacementPreference>("SwarmPlacementPreference")(
+    {
+        Spread: Schema.NullOr(SwarmSpreadOver.SwarmSpreadOver),
+    },
+    {
+        identifier: "SwarmPlacementPreference",
+        title: "swarm.PlacementPreference",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#PlacementPreference",
+        description: "PlacementPreference provides a way to make the scheduler aware of factors\nsuch as topology.",
+    }
+) {}
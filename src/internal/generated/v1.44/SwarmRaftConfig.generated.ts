@@ -0,0 +1,46 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class SwarmRaftConfig extends Schema.Class<SwarmRaftConfig>("SwarmRaftConfig")(
+    {
+        SnapshotInterval: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+            ).annotate({ description: "SnapshotInterval is the number of log entries between snapshots." })
+        ),
+        KeepOldSnapshots: Schema.optional(
+            Schema.NullOr(
+                MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))
+            ).annotate({
+                description: "KeepOldSnapshots is the number of snapshots to keep beyond the\ncurrent snapshot.",
+            })
+        ),
+        LogEntriesForSlowFollowers: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+            ).annotate({
+                description:
+                    "LogEntriesForSlowFollowers is the number of log entries to keep\naround to sync up slow followers after a snapshot is created.",
+            })
+        ),
+        ElectionTick: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "ElectionTick is the number of ticks that a follower will wait for a message\nfrom the leader before becoming a candidate and starting an election.\nElectionTick must be greater than HeartbeatTick.\n\nA tick currently defaults to one second, so these translate directly to\nseconds currently, but this is NOT guaranteed.",
+        }),
+        HeartbeatTick: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "HeartbeatTick is the number of ticks between heartbeats. Every\nHeartbeatTick ticks, the leader will send a heartbeat to the\nfollowers.\n\nA tick currently defaults to one second, so these translate directly to\nseconds currently, but this is NOT guaranteed.",
+        }),
+    },
+    {
+        identifier: "SwarmRaftConfig",
+        title: "swarm.RaftConfig",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#RaftConfig",
+        description: "RaftConfig represents raft configuration.",
+    }
+) {}
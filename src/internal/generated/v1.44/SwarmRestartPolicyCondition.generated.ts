@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmRestartPolicyCondition = Schema.Literals(["none", "on-failure", "any"]).annotate({
+    identifier: "SwarmRestartPolicyCondition",
+    title: "swarm.RestartPolicyCondition",
+    documentation:
+        "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#RestartPolicyCondition",
+    description: "RestartPolicyCondition represents when to restart.",
+});
+
+export type SwarmRestartPolicyCondition = Schema.Schema.Type<typeof SwarmRestartPolicyCondition>;
+
+export const SwarmRestartPolicyConditionConstants = Object.freeze({
+    RestartPolicyConditionNone: "none",
+    RestartPolicyConditionOnFailure: "on-failure",
+    RestartPolicyConditionAny: "any",
+} as const);
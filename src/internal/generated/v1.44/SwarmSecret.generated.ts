@@ -0,0 +1,21 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as SwarmSecretSpec from "./SwarmSecretSpec.generated.ts";
+import * as SwarmVersion from "./SwarmVersion.generated.ts";
+
+export class SwarmSecret extends Schema.Class<SwarmSecret>("SwarmSecret")(
+    {
+        ID: MobyIdentifiers.SecretIdentifier,
+        Version: Schema.NullOr(SwarmVersion.SwarmVersion),
+        CreatedAt: Schema.NullOr(Schema.DateFromString),
+        UpdatedAt: Schema.NullOr(Schema.DateFromString),
+        Spec: Schema.NullOr(SwarmSecretSpec.SwarmSecretSpec),
+    },
+    {
+        identifier: "SwarmSecret",
+        title: "swarm.Secret",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#Secret",
+        description: "Secret represents a secret.",
+    }
+) {}
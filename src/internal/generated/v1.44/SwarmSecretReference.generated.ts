@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmSecretReferenceFileTarget from "./SwarmSecretReferenceFileTarget.generated.ts";
+
+export class SwarmSecretReference extends Schema.Class<SwarmSecretReference>("SwarmSecretReference")(
+    {
+        File: Schema.NullOr(SwarmSecretReferenceFileTarget.SwarmSecretReferenceFileTarget),
+        SecretID: Schema.String,
+        SecretName: Schema.String,
+    },
+    {
+        identifier: "SwarmSecretReference",
+        title: "swarm.SecretReference",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#SecretReference",
+        description: "SecretReference is a reference to a secret in swarm",
+    }
+) {}
@@ -0,0 +1,29 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmDriver from "./SwarmDriver.generated.ts";
+
+export class SwarmSecretSpec extends Schema.Class<SwarmSecretSpec>("SwarmSecretSpec")(
+    {
+        Name: Schema.optional(Schema.String),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+        Data: Schema.optional(Schema.NullOr(Schema.Uint8ArrayFromBase64)),
+        Driver: Schema.optional(
+            Schema.NullOr(SwarmDriver.SwarmDriver).annotate({
+                description:
+                    "name of the secrets driver used to fetch the secret's value from an external secret store",
+            })
+        ),
+        Templating: Schema.optional(
+            Schema.NullOr(SwarmDriver.SwarmDriver).annotate({
+                description:
+                    "Templating controls whether and how to evaluate the secret payload as\na template. If it is not set, no templating is used.",
+            })
+        ),
+    },
+    {
+        identifier: "SwarmSecretSpec",
+        title: "swarm.SecretSpec",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#SecretSpec",
+        description: "SecretSpec represents a secret specification from a secret in swarm",
+    }
+) {}
@@ -0,0 +1,27 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmCAConfig from "./SwarmCAConfig.generated.ts";
+import * as SwarmDispatcherConfig from "./SwarmDispatcherConfig.generated.ts";
+import * as SwarmEncryptionConfig from "./SwarmEncryptionConfig.generated.ts";
+import * as SwarmOrchestrationConfig from "./SwarmOrchestrationConfig.generated.ts";
+import * as SwarmRaftConfig from "./SwarmRaftConfig.generated.ts";
+import * as SwarmTaskDefaults from "./SwarmTaskDefaults.generated.ts";
+
+export class SwarmSpec extends Schema.Class<SwarmSpec>("SwarmSpec")(
+    {
+        Name: Schema.optional(Schema.String),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+        Orchestration: Schema.NullOr(SwarmOrchestrationConfig.SwarmOrchestrationConfig),
+        Raft: Schema.NullOr(SwarmRaftConfig.SwarmRaftConfig),
+        Dispatcher: Schema.NullOr(SwarmDispatcherConfig.SwarmDispatcherConfig),
+        CAConfig: Schema.NullOr(SwarmCAConfig.SwarmCAConfig),
+        TaskDefaults: Schema.NullOr(SwarmTaskDefaults.SwarmTaskDefaults),
+        EncryptionConfig: Schema.NullOr(SwarmEncryptionConfig.SwarmEncryptionConfig),
+    },
+    {
+        identifier: "SwarmSpec",
+        title: "swarm.Spec",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#Spec",
+        description: "Spec represents the spec of a swarm.",
+    }
+) {}
@@ -0,0 +1,26 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmTLSInfo extends Schema.Class<SwarmTLSInfo>("SwarmTLSInfo")(
+    {
+        TrustRoot: Schema.optional(
+            Schema.String.annotate({ description: "TrustRoot is the trusted CA root certificate in PEM format" })
+        ),
+        CertIssuerSubject: Schema.optional(
+            Schema.NullOr(Schema.StringFromBase64).annotate({
+                description: "CertIssuer is the raw subject bytes of the issuer",
+            })
+        ),
+        CertIssuerPublicKey: Schema.optional(
+            Schema.NullOr(Schema.StringFromBase64).annotate({
+                description: "CertIssuerPublicKey is the raw public key bytes of the issuer",
+            })
+        ),
+    },
+    {
+        identifier: "SwarmTLSInfo",
+        title: "swarm.TLSInfo",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#TLSInfo",
+        description:
+            "TLSInfo represents the TLS information about what CA certificate is trusted,\nand who the issuer for a TLS certificate is",
+    }
+) {}
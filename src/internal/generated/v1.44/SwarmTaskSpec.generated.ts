@@ -0,0 +1,50 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as RuntimePluginSpec from "./RuntimePluginSpec.generated.ts";
+import * as SwarmContainerSpec from "./SwarmContainerSpec.generated.ts";
+import * as SwarmDriver from "./SwarmDriver.generated.ts";
+import * as SwarmNetworkAttachmentConfig from "./SwarmNetworkAttachmentConfig.generated.ts";
+import * as SwarmNetworkAttachmentSpec from "./SwarmNetworkAttachmentSpec.generated.ts";
+import * as SwarmPlacement from "./SwarmPlacement.generated.ts";
+import * as SwarmResourceRequirements from "./SwarmResourceRequirements.generated.ts";
+import * as SwarmRestartPolicy from "./SwarmRestartPolicy.generated.ts";
+import * as SwarmRuntimeType from "./SwarmRuntimeType.generated.ts";
+
+export class SwarmTaskSpec extends Schema.Class<SwarmTaskSpec>("SwarmTaskSpec")(
+    {
+        ContainerSpec: Schema.optional(
+            Schema.NullOr(SwarmContainerSpec.SwarmContainerSpec).annotate({
+                description:
+                    "ContainerSpec, NetworkAttachmentSpec, and PluginSpec are mutually exclusive.\nPluginSpec is only used when the `Runtime` field is set to `plugin`\nNetworkAttachmentSpec is used if the `Runtime` field is set to\n`attachment`.",
+            })
+        ),
+        PluginSpec: Schema.optional(Schema.NullOr(RuntimePluginSpec.RuntimePluginSpec)),
+        NetworkAttachmentSpec: Schema.optional(Schema.NullOr(SwarmNetworkAttachmentSpec.SwarmNetworkAttachmentSpec)),
+        Resources: Schema.optional(Schema.NullOr(SwarmResourceRequirements.SwarmResourceRequirements)),
+        RestartPolicy: Schema.optional(Schema.NullOr(SwarmRestartPolicy.SwarmRestartPolicy)),
+        Placement: Schema.optional(Schema.NullOr(SwarmPlacement.SwarmPlacement)),
+        Networks: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.NullOr(SwarmNetworkAttachmentConfig.SwarmNetworkAttachmentConfig)))
+        ),
+        LogDriver: Schema.optional(
+            Schema.NullOr(SwarmDriver.SwarmDriver).annotate({
+                description:
+                    "LogDriver specifies the LogDriver to use for tasks created from this\nspec. If not present, the one on cluster default on swarm.Spec will be\nused, finally falling back to the engine default if not specified.",
+            })
+        ),
+        ForceUpdate: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ).annotate({
+            description:
+                "ForceUpdate is a counter that triggers an update even if no relevant\nparameters have been changed.",
+        }),
+        Runtime: Schema.optional(SwarmRuntimeType.SwarmRuntimeType),
+    },
+    {
+        identifier: "SwarmTaskSpec",
+        title: "swarm.TaskSpec",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#TaskSpec",
+        description: "TaskSpec represents the spec of a task.",
+    }
+) {}
@@ -0,0 +1,44 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmTaskState = Schema.Literals([
+    "new",
+    "allocated",
+    "pending",
+    "assigned",
+    "accepted",
+    "preparing",
+    "ready",
+    "starting",
+    "running",
+    "complete",
+    "shutdown",
+    "failed",
+    "rejected",
+    "remove",
+    "orphaned",
+]).annotate({
+    identifier: "SwarmTaskState",
+    title: "swarm.TaskState",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/swarm#TaskState",
+    description: "TaskState represents the state of a task.",
+});
+
+export type SwarmTaskState = Schema.Schema.Type<typeof SwarmTaskState>;
+
+export const SwarmTaskStateConstants = Object.freeze({
+    TaskStateNew: "new",
+    TaskStateAllocated: "allocated",
+    TaskStatePending: "pending",
+    TaskStateAssigned: "assigned",
+    TaskStateAccepted: "accepted",
+    TaskStatePreparing: "preparing",
+    TaskStateReady: "ready",
+    TaskStateStarting: "starting",
+    TaskStateRunning: "running",
+    TaskStateComplete: "complete",
+    TaskStateShutdown: "shutdown",
+    TaskStateFailed: "failed",
+    TaskStateRejected: "rejected",
+    TaskStateRemove: "remove",
+    TaskStateOrphaned: "orphaned",
+} as const);
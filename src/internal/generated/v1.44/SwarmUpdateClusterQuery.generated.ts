@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmUpdateClusterQuery = Schema.Struct({
+    version: Schema.optional(Schema.Finite),
+    rotateWorkerToken: Schema.optional(Schema.Boolean),
+    rotateManagerToken: Schema.optional(Schema.Boolean),
+    rotateManagerUnlockKey: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "SwarmUpdateClusterQuery",
+    title: "swarm.updateCluster",
+    description: "Query parameters of POST /swarm/update",
+});
+
+export type SwarmUpdateClusterQuery = Schema.Schema.Type<typeof SwarmUpdateClusterQuery>;
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmUpdateNodeQuery = Schema.Struct({
+    version: Schema.optional(Schema.Finite),
+}).annotate({
+    identifier: "SwarmUpdateNodeQuery",
+    title: "swarm.updateNode",
+    description: "Query parameters of POST /nodes/{id}/update",
+});
+
+export type SwarmUpdateNodeQuery = Schema.Schema.Type<typeof SwarmUpdateNodeQuery>;
@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmUpdateServiceQuery = Schema.Struct({
+    version: Schema.optional(Schema.Finite),
+    registryAuthFrom: Schema.optional(Schema.String),
+    rollback: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "SwarmUpdateServiceQuery",
+    title: "swarm.updateService",
+    description: "Query parameters of POST /services/{id}/update",
+});
+
+export type SwarmUpdateServiceQuery = Schema.Schema.Type<typeof SwarmUpdateServiceQuery>;
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const SystemGetDiskUsageQuery = Schema.Struct({
+    type: Schema.optional(Schema.Array(Schema.String)),
+}).annotate({
+    identifier: "SystemGetDiskUsageQuery",
+    title: "system.getDiskUsage",
+    description: "Query parameters of GET /system/df",
+});
+
+export type SystemGetDiskUsageQuery = Schema.Schema.Type<typeof SystemGetDiskUsageQuery>;
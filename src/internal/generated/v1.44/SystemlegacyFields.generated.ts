@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export class SystemlegacyFields extends Schema.Class<SystemlegacyFields>("SystemlegacyFields")(
+    {
+        ExecutionDriver: Schema.optional(
+            Schema.String.annotate({
+                description: "Deprecated: deprecated since API v1.25, but returned for older versions.",
+            })
+        ),
+    },
+    {
+        identifier: "SystemlegacyFields",
+        title: "system.legacyFields",
+        documentation: "",
+    }
+) {}
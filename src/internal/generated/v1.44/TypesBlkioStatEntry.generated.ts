@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class TypesBlkioStatEntry extends Schema.Class<TypesBlkioStatEntry>("TypesBlkioStatEntry")(
+    {
+        major: MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })),
+        minor: MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })),
+        op: Schema.String,
+        value: MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })),
+    },
+    {
+        identifier: "TypesBlkioStatEntry",
+        title: "types.BlkioStatEntry",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#BlkioStatEntry",
+        description: "BlkioStatEntry is one small entity to store a piece of Blkio stats\nNot used on Windows.",
+    }
+) {}
@@ -0,0 +1,25 @@
+import * as Schema from "effect/Schema";
+
+import * as TypesBlkioStatEntry from "./TypesBlkioStatEntry.generated.ts";
+
+export class TypesBlkioStats extends Schema.Class<TypesBlkioStats>("TypesBlkioStats")(
+    {
+        io_service_bytes_recursive: Schema.NullOr(
+            Schema.Array(Schema.NullOr(TypesBlkioStatEntry.TypesBlkioStatEntry))
+        ).annotate({ description: "number of bytes transferred to and from the block device" }),
+        io_serviced_recursive: Schema.NullOr(Schema.Array(Schema.NullOr(TypesBlkioStatEntry.TypesBlkioStatEntry))),
+        io_queue_recursive: Schema.NullOr(Schema.Array(Schema.NullOr(TypesBlkioStatEntry.TypesBlkioStatEntry))),
+        io_service_time_recursive: Schema.NullOr(Schema.Array(Schema.NullOr(TypesBlkioStatEntry.TypesBlkioStatEntry))),
+        io_wait_time_recursive: Schema.NullOr(Schema.Array(Schema.NullOr(TypesBlkioStatEntry.TypesBlkioStatEntry))),
+        io_merged_recursive: Schema.NullOr(Schema.Array(Schema.NullOr(TypesBlkioStatEntry.TypesBlkioStatEntry))),
+        io_time_recursive: Schema.NullOr(Schema.Array(Schema.NullOr(TypesBlkioStatEntry.TypesBlkioStatEntry))),
+        sectors_recursive: Schema.NullOr(Schema.Array(Schema.NullOr(TypesBlkioStatEntry.TypesBlkioStatEntry))),
+    },
+    {
+        identifier: "TypesBlkioStats",
+        title: "types.BlkioStats",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#BlkioStats",
+        description:
+            "BlkioStats stores All IO service stats for data read and write.\nThis is a Linux specific structure as the differences between expressing\nblock I/O on Windows and Linux are sufficiently significant to make\nlittle sense attempting to morph into a combined structure.",
+    }
+) {}
@@ -0,0 +1,19 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class TypesBuildCachePruneReport extends Schema.Class<TypesBuildCachePruneReport>("TypesBuildCachePruneReport")(
+    {
+        CachesDeleted: Schema.NullOr(Schema.Array(Schema.String)),
+        SpaceReclaimed: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ),
+    },
+    {
+        identifier: "TypesBuildCachePruneReport",
+        title: "types.BuildCachePruneReport",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#BuildCachePruneReport",
+        description: 'BuildCachePruneReport contains the response for Engine API:\nPOST "/build/prune"',
+    }
+) {}
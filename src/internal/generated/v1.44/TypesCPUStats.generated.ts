@@ -0,0 +1,33 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as TypesCPUUsage from "./TypesCPUUsage.generated.ts";
+import * as TypesThrottlingData from "./TypesThrottlingData.generated.ts";
+
+export class TypesCPUStats extends Schema.Class<TypesCPUStats>("TypesCPUStats")(
+    {
+        cpu_usage: Schema.NullOr(TypesCPUUsage.TypesCPUUsage).annotate({
+            description: "CPU Usage. Linux and Windows.",
+        }),
+        system_cpu_usage: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+            ).annotate({ description: "System Usage. Linux only." })
+        ),
+        online_cpus: Schema.optional(
+            MobyNumber.NumberFromWireString.check(
+                Schema.isInt(),
+                Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
+            ).annotate({ description: "Online CPUs. Linux only." })
+        ),
+        throttling_data: Schema.NullOr(TypesThrottlingData.TypesThrottlingData).annotate({
+            description: "Throttling Data. Linux only.",
+        }),
+    },
+    {
+        identifier: "TypesCPUStats",
+        title: "types.CPUStats",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#CPUStats",
+        description: "CPUStats aggregates and wraps all CPU related info of container",
+    }
+) {}
@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export class TypesConfigListOptions extends Schema.Class<TypesConfigListOptions>("TypesConfigListOptions")(
+    {
+        Filters: Schema.NullOr(Schema.Record(Schema.String, Schema.Record(Schema.String, Schema.Boolean))),
+    },
+    {
+        identifier: "TypesConfigListOptions",
+        title: "types.ConfigListOptions",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#ConfigListOptions",
+        description: "ConfigListOptions holds parameters to list configs",
+    }
+) {}
@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export class TypesContainerHostConfig extends Schema.Class<TypesContainerHostConfig>("TypesContainerHostConfig")(
+    {
+        NetworkMode: Schema.optional(Schema.String),
+    },
+    {
+        identifier: "TypesContainerHostConfig",
+        title: "types.Container.HostConfig",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#Container",
+    }
+) {}
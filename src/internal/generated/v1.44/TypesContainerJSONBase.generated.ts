@@ -0,0 +1,60 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
+import * as ContainerHostConfig from "./ContainerHostConfig.generated.ts";
+import * as TypesContainerNode from "./TypesContainerNode.generated.ts";
+import * as TypesContainerState from "./TypesContainerState.generated.ts";
+import * as TypesGraphDriverData from "./TypesGraphDriverData.generated.ts";
+
+export class TypesContainerJSONBase extends Schema.Class<TypesContainerJSONBase>("TypesContainerJSONBase")(
+    {
+        Id: MobyIdentifiers.ContainerIdentifier,
+        Created: Schema.String,
+        Path: Schema.String,
+        Args: Schema.NullOr(Schema.Array(Schema.String)),
+        State: Schema.NullOr(TypesContainerState.TypesContainerState),
+        Image: Schema.String,
+        ResolvConfPath: Schema.String,
+        HostnamePath: Schema.String,
+        HostsPath: Schema.String,
+        LogPath: Schema.String,
+        Node: Schema.optional(
+            Schema.NullOr(TypesContainerNode.TypesContainerNode).annotate({
+                description: "Node is only propagated by Docker Swarm standalone API",
+            })
+        ),
+        Name: Schema.String,
+        RestartCount: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        Driver: Schema.String,
+        Platform: Schema.String,
+        MountLabel: Schema.String,
+        ProcessLabel: Schema.String,
+        AppArmorProfile: Schema.String,
+        ExecIDs: Schema.NullOr(Schema.Array(Schema.String)),
+        HostConfig: Schema.NullOr(ContainerHostConfig.ContainerHostConfig),
+        GraphDriver: Schema.NullOr(TypesGraphDriverData.TypesGraphDriverData),
+        SizeRw: Schema.optional(
+            Schema.NullOr(
+                MobyNumber.BigIntFromWireString.check(
+                    Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+                )
+            )
+        ),
+        SizeRootFs: Schema.optional(
+            Schema.NullOr(
+                MobyNumber.BigIntFromWireString.check(
+                    Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+                )
+            )
+        ),
+    },
+    {
+        identifier: "TypesContainerJSONBase",
+        title: "types.ContainerJSONBase",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#ContainerJSONBase",
+        description: 'ContainerJSONBase contains response of Engine API:\nGET "/containers/{name:.*}/json"',
+    }
+) {}
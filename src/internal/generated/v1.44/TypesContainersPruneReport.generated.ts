@@ -0,0 +1,19 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class TypesContainersPruneReport extends Schema.Class<TypesContainersPruneReport>("TypesContainersPruneReport")(
+    {
+        ContainersDeleted: Schema.NullOr(Schema.Array(Schema.String)),
+        SpaceReclaimed: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ),
+    },
+    {
+        identifier: "TypesContainersPruneReport",
+        title: "types.ContainersPruneReport",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#ContainersPruneReport",
+        description: 'ContainersPruneReport contains the response for Engine API:\nPOST "/containers/prune"',
+    }
+) {}
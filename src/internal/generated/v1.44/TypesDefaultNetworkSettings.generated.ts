@@ -0,0 +1,34 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class TypesDefaultNetworkSettings extends Schema.Class<TypesDefaultNetworkSettings>(
+    "TypesDefaultNetworkSettings"
+)(
+    {
+        EndpointID: Schema.String.annotate({
+            description: "EndpointID uniquely represents a service endpoint in a Sandbox",
+        }),
+        Gateway: Schema.String.annotate({ description: "Gateway holds the gateway address for the network" }),
+        GlobalIPv6Address: Schema.String.annotate({
+            description: "GlobalIPv6Address holds network's global IPv6 address",
+        }),
+        GlobalIPv6PrefixLen: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({ description: "GlobalIPv6PrefixLen represents mask length of network's global IPv6 address" }),
+        IPAddress: Schema.String.annotate({ description: "IPAddress holds the IPv4 address for the network" }),
+        IPPrefixLen: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({ description: "IPPrefixLen represents mask length of network's IPv4 address" }),
+        IPv6Gateway: Schema.String.annotate({ description: "IPv6Gateway holds gateway address specific for IPv6" }),
+        MacAddress: Schema.String.annotate({ description: "MacAddress holds the MAC address for the network" }),
+    },
+    {
+        identifier: "TypesDefaultNetworkSettings",
+        title: "types.DefaultNetworkSettings",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#DefaultNetworkSettings",
+        description:
+            "DefaultNetworkSettings holds network information\nduring the 2 release deprecation period.\nIt will be removed in Docker 1.11.",
+    }
+) {}
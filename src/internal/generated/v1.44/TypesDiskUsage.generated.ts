@@ -0,0 +1,30 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as ContainerSummary from "./ContainerSummary.generated.ts";
+import * as ImageSummary from "./ImageSummary.generated.ts";
+import * as TypesBuildCache from "./TypesBuildCache.generated.ts";
+import * as VolumeVolume from "./VolumeVolume.generated.ts";
+
+export class TypesDiskUsage extends Schema.Class<TypesDiskUsage>("TypesDiskUsage")(
+    {
+        LayersSize: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        Images: Schema.NullOr(Schema.Array(Schema.NullOr(ImageSummary.ImageSummary))),
+        Containers: Schema.NullOr(Schema.Array(Schema.NullOr(ContainerSummary.ContainerSummary))),
+        Volumes: Schema.NullOr(Schema.Array(Schema.NullOr(VolumeVolume.VolumeVolume))),
+        BuildCache: Schema.NullOr(Schema.Array(Schema.NullOr(TypesBuildCache.TypesBuildCache))),
+        BuilderSize: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({ description: "Deprecated: deprecated in API 1.38, and no longer used since API 1.40." })
+        ),
+    },
+    {
+        identifier: "TypesDiskUsage",
+        title: "types.DiskUsage",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#DiskUsage",
+        description: 'DiskUsage contains response of Engine API:\nGET "/system/df"',
+    }
+) {}
@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export class TypesEndpointResource extends Schema.Class<TypesEndpointResource>("TypesEndpointResource")(
+    {
+        Name: Schema.String,
+        EndpointID: Schema.String,
+        MacAddress: Schema.String,
+        IPv4Address: Schema.String,
+        IPv6Address: Schema.String,
+    },
+    {
+        identifier: "TypesEndpointResource",
+        title: "types.EndpointResource",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#EndpointResource",
+        description: "EndpointResource contains network resources allocated and used for a container in a network",
+    }
+) {}
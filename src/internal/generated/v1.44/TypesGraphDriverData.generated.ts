@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+export class TypesGraphDriverData extends Schema.Class<TypesGraphDriverData>("TypesGraphDriverData")(
+    {
+        Data: Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+            description:
+                "Low-level storage metadata, provided as key/value pairs.\n\nThis information is driver-specific, and depends on the storage-driver\nin use, and should be used for informational purposes only.\n\nRequired: true",
+        }),
+        Name: Schema.String.annotate({ description: "Name of the storage driver.\nRequired: true" }),
+    },
+    {
+        identifier: "TypesGraphDriverData",
+        title: "types.GraphDriverData",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#GraphDriverData",
+        description:
+            "GraphDriverData Information about the storage driver used to store the container's and\nimage's filesystem.\n\nswagger:model GraphDriverData",
+    }
+) {}
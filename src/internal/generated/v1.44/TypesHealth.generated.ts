@@ -0,0 +1,22 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as TypesHealthcheckResult from "./TypesHealthcheckResult.generated.ts";
+
+export class TypesHealth extends Schema.Class<TypesHealth>("TypesHealth")(
+    {
+        Status: Schema.String.annotate({ description: "Status is one of Starting, Healthy or Unhealthy" }),
+        FailingStreak: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({ description: "FailingStreak is the number of consecutive failures" }),
+        Log: Schema.NullOr(Schema.Array(Schema.NullOr(TypesHealthcheckResult.TypesHealthcheckResult))).annotate({
+            description: "Log contains the last few results (oldest first)",
+        }),
+    },
+    {
+        identifier: "TypesHealth",
+        title: "types.Health",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#Health",
+        description: "Health stores information about the container's healthcheck results",
+    }
+) {}
@@ -0,0 +1,23 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class TypesHealthcheckResult extends Schema.Class<TypesHealthcheckResult>("TypesHealthcheckResult")(
+    {
+        Start: Schema.NullOr(Schema.DateFromString).annotate({ description: "Start is the time this check started" }),
+        End: Schema.NullOr(Schema.DateFromString).annotate({ description: "End is the time this check ended" }),
+        ExitCode: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "ExitCode meanings: 0=healthy, 1=unhealthy, 2=reserved (considered unhealthy), else=error running probe",
+        }),
+        Output: Schema.String.annotate({ description: "Output from last check" }),
+    },
+    {
+        identifier: "TypesHealthcheckResult",
+        title: "types.HealthcheckResult",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#HealthcheckResult",
+        description: "HealthcheckResult stores information about a single run of a healthcheck probe",
+    }
+) {}
@@ -0,0 +1,24 @@
+import * as Schema from "effect/Schema";
+
+export class TypesImageListOptions extends Schema.Class<TypesImageListOptions>("TypesImageListOptions")(
+    {
+        All: Schema.Boolean.annotate({
+            description: "All controls whether all images in the graph are filtered, or just\nthe heads.",
+        }),
+        Filters: Schema.NullOr(Schema.Record(Schema.String, Schema.Record(Schema.String, Schema.Boolean))).annotate({
+            description: "Filters is a JSON-encoded set of filter arguments.",
+        }),
+        SharedSize: Schema.Boolean.annotate({
+            description: "SharedSize indicates whether the shared size of images should be computed.",
+        }),
+        ContainerCount: Schema.Boolean.annotate({
+            description: "ContainerCount indicates whether container count should be computed.",
+        }),
+    },
+    {
+        identifier: "TypesImageListOptions",
+        title: "types.ImageListOptions",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#ImageListOptions",
+        description: "ImageListOptions holds parameters to list images with.",
+    }
+) {}
@@ -0,0 +1,19 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as ImageDeleteResponse from "./ImageDeleteResponse.generated.ts";
+
+export class TypesImagesPruneReport extends Schema.Class<TypesImagesPruneReport>("TypesImagesPruneReport")(
+    {
+        ImagesDeleted: Schema.NullOr(Schema.Array(Schema.NullOr(ImageDeleteResponse.ImageDeleteResponse))),
+        SpaceReclaimed: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ),
+    },
+    {
+        identifier: "TypesImagesPruneReport",
+        title: "types.ImagesPruneReport",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#ImagesPruneReport",
+        description: 'ImagesPruneReport contains the response for Engine API:\nPOST "/images/prune"',
+    }
+) {}
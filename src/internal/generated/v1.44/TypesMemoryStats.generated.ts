@@ -0,0 +1,60 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class TypesMemoryStats extends Schema.Class<TypesMemoryStats>("TypesMemoryStats")(
+    {
+        usage: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+            ).annotate({ description: "current res_counter usage for memory" })
+        ),
+        max_usage: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+            ).annotate({ description: "maximum usage ever recorded." })
+        ),
+        stats: Schema.optional(
+            Schema.NullOr(
+                Schema.Record(
+                    Schema.String,
+                    MobyNumber.BigIntFromWireString.check(
+                        Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+                    )
+                )
+            ).annotate({
+                description: "TODO(vishh): Export these as stronger types.\nall the stats exported via memory.stat.",
+            })
+        ),
+        failcnt: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+            ).annotate({ description: "number of times memory usage hits limits." })
+        ),
+        limit: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))
+        ),
+        commitbytes: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+            ).annotate({ description: "committed bytes" })
+        ),
+        commitpeakbytes: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+            ).annotate({ description: "peak committed bytes" })
+        ),
+        privateworkingset: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+            ).annotate({ description: "private working set" })
+        ),
+    },
+    {
+        identifier: "TypesMemoryStats",
+        title: "types.MemoryStats",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#MemoryStats",
+        description:
+            "MemoryStats aggregates all memory stats since container inception on Linux.\nWindows returns stats for commit and private working set only.",
+    }
+) {}
@@ -0,0 +1,52 @@
+import * as Schema from "effect/Schema";
+
+import * as MountPropagation from "./MountPropagation.generated.ts";
+import * as MountType from "./MountType.generated.ts";
+
+export class TypesMountPoint extends Schema.Class<TypesMountPoint>("TypesMountPoint")(
+    {
+        Type: Schema.optional(
+            MountType.MountType.annotate({
+                description:
+                    "Type is the type of mount, see `Type<foo>` definitions in\ngithub.com/docker/docker/api/types/mount.Type",
+            })
+        ),
+        Name: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "Name is the name reference to the underlying data defined by `Source`\ne.g., the volume name.",
+            })
+        ),
+        Source: Schema.String.annotate({
+            description:
+                "Source is the source location of the mount.\n\nFor volumes, this contains the storage location of the volume (within\n`/var/lib/docker/volumes/`). For bind-mounts, and `npipe`, this contains\nthe source (host) part of the bind-mount. For `tmpfs` mount points, this\nfield is empty.",
+        }),
+        Destination: Schema.String.annotate({
+            description:
+                "Destination is the path relative to the container root (`/`) where the\nSource is mounted inside the container.",
+        }),
+        Driver: Schema.optional(
+            Schema.String.annotate({
+                description: "Driver is the volume driver used to create the volume (if it is a volume).",
+            })
+        ),
+        Mode: Schema.String.annotate({
+            description:
+                'Mode is a comma separated list of options supplied by the user when\ncreating the bind/volume mount.\n\nThe default is platform-specific (`"z"` on Linux, empty on Windows).',
+        }),
+        RW: Schema.Boolean.annotate({
+            description: "RW indicates whether the mount is mounted writable (read-write).",
+        }),
+        Propagation: MountPropagation.MountPropagation.annotate({
+            description:
+                "Propagation describes how mounts are propagated from the host into the\nmount point, and vice-versa. Refer to the Linux kernel documentation\nfor details:\nhttps://www.kernel.org/doc/Documentation/filesystems/sharedsubtree.txt\n\nThis field is not used on Windows.",
+        }),
+    },
+    {
+        identifier: "TypesMountPoint",
+        title: "types.MountPoint",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#MountPoint",
+        description:
+            "MountPoint represents a mount point configuration inside the container.\nThis is used for reporting the mountpoints in use by a container.",
+    }
+) {}
@@ -0,0 +1,33 @@
+import * as Effect from "effect/Effect";
+import * as Schema from "effect/Schema";
+
+import * as NetworkConfigReference from "./NetworkConfigReference.generated.ts";
+import * as NetworkIPAM from "./NetworkIPAM.generated.ts";
+
+export class TypesNetworkCreate extends Schema.Class<TypesNetworkCreate>("TypesNetworkCreate")(
+    {
+        CheckDuplicate: Schema.optional(
+            Schema.Boolean.annotate({
+                description:
+                    "Deprecated: CheckDuplicate is deprecated since API v1.44, but it defaults to true when sent by the client\npackage to older daemons.",
+            })
+        ),
+        Driver: Schema.String.pipe(Schema.withConstructorDefault(Effect.sync(() => "bridge"))),
+        Scope: Schema.String,
+        EnableIPv6: Schema.Boolean,
+        IPAM: Schema.NullOr(NetworkIPAM.NetworkIPAM),
+        Internal: Schema.Boolean,
+        Attachable: Schema.Boolean,
+        Ingress: Schema.Boolean,
+        ConfigOnly: Schema.Boolean.pipe(Schema.withConstructorDefault(Effect.sync(() => false))),
+        ConfigFrom: Schema.NullOr(NetworkConfigReference.NetworkConfigReference),
+        Options: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+    },
+    {
+        identifier: "TypesNetworkCreate",
+        title: "types.NetworkCreate",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#NetworkCreate",
+        description: 'NetworkCreate is the expected body of the "create network" http request message',
+    }
+) {}
@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+
+export class TypesNetworkCreateResponse extends Schema.Class<TypesNetworkCreateResponse>("TypesNetworkCreateResponse")(
+    {
+        Id: Schema.String,
+        Warning: Schema.String,
+    },
+    {
+        identifier: "TypesNetworkCreateResponse",
+        title: "types.NetworkCreateResponse",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#NetworkCreateResponse",
+        description: "NetworkCreateResponse is the response message sent by the server for network create call",
+    }
+) {}
@@ -0,0 +1,46 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class TypesNetworkStats extends Schema.Class<TypesNetworkStats>("TypesNetworkStats")(
+    {
+        rx_bytes: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ).annotate({ description: "Bytes received. Windows and Linux." }),
+        rx_packets: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ).annotate({ description: "Packets received. Windows and Linux." }),
+        rx_errors: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ).annotate({
+            description:
+                "Received errors. Not used on Windows. Note that we don't `omitempty` this\nfield as it is expected in the >=v1.21 API stats structure.",
+        }),
+        rx_dropped: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ).annotate({ description: "Incoming packets dropped. Windows and Linux." }),
+        tx_bytes: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ).annotate({ description: "Bytes sent. Windows and Linux." }),
+        tx_packets: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ).annotate({ description: "Packets sent. Windows and Linux." }),
+        tx_errors: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ).annotate({
+            description:
+                "Sent errors. Not used on Windows. Note that we don't `omitempty` this\nfield as it is expected in the >=v1.21 API stats structure.",
+        }),
+        tx_dropped: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ).annotate({ description: "Outgoing packets dropped. Windows and Linux." }),
+        endpoint_id: Schema.optional(Schema.String.annotate({ description: "Endpoint ID. Not used on Linux." })),
+        instance_id: Schema.optional(Schema.String.annotate({ description: "Instance ID. Not used on Linux." })),
+    },
+    {
+        identifier: "TypesNetworkStats",
+        title: "types.NetworkStats",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#NetworkStats",
+        description: "NetworkStats aggregates the network stats of one container",
+    }
+) {}
@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class TypesNetworksPruneReport extends Schema.Class<TypesNetworksPruneReport>("TypesNetworksPruneReport")(
+    {
+        NetworksDeleted: Schema.NullOr(Schema.Array(Schema.String)),
+    },
+    {
+        identifier: "TypesNetworksPruneReport",
+        title: "types.NetworksPruneReport",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#NetworksPruneReport",
+        description: 'NetworksPruneReport contains the response for Engine API:\nPOST "/networks/prune"',
+    }
+) {}
@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export class TypesNodeListOptions extends Schema.Class<TypesNodeListOptions>("TypesNodeListOptions")(
+    {
+        Filters: Schema.NullOr(Schema.Record(Schema.String, Schema.Record(Schema.String, Schema.Boolean))),
+    },
+    {
+        identifier: "TypesNodeListOptions",
+        title: "types.NodeListOptions",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#NodeListOptions",
+        description: "NodeListOptions holds parameters to list nodes with.",
+    }
+) {}
@@ -0,0 +1,27 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class TypesPidsStats extends Schema.Class<TypesPidsStats>("TypesPidsStats")(
+    {
+        current: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+            ).annotate({ description: "Current is the number of pids in the cgroup" })
+        ),
+        limit: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+            ).annotate({
+                description:
+                    'Limit is the hard limit on the number of pids in the cgroup.\nA "Limit" of 0 means that there is no limit.',
+            })
+        ),
+    },
+    {
+        identifier: "TypesPidsStats",
+        title: "types.PidsStats",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#PidsStats",
+        description: "PidsStats contains the stats of a container's pids",
+    }
+) {}
@@ -0,0 +1,29 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as TypesPluginConfig from "./TypesPluginConfig.generated.ts";
+import * as TypesPluginSettings from "./TypesPluginSettings.generated.ts";
+
+export class TypesPlugin extends Schema.Class<TypesPlugin>("TypesPlugin")(
+    {
+        Config: Schema.NullOr(TypesPluginConfig.TypesPluginConfig).annotate({ description: "config\nRequired: true" }),
+        Enabled: Schema.Boolean.annotate({
+            description:
+                "True if the plugin is running. False if the plugin is not running, only installed.\nRequired: true",
+        }),
+        Id: Schema.optional(MobyIdentifiers.PluginIdentifier.annotate({ description: "Id" })),
+        Name: Schema.String.annotate({ description: "name\nRequired: true" }),
+        PluginReference: Schema.optional(
+            Schema.String.annotate({ description: "plugin remote reference used to push/pull the plugin" })
+        ),
+        Settings: Schema.NullOr(TypesPluginSettings.TypesPluginSettings).annotate({
+            description: "settings\nRequired: true",
+        }),
+    },
+    {
+        identifier: "TypesPlugin",
+        title: "types.Plugin",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#Plugin",
+        description: "Plugin A plugin for the Engine API\nswagger:model Plugin",
+    }
+) {}
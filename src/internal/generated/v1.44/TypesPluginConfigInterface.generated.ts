@@ -0,0 +1,21 @@
+import * as Schema from "effect/Schema";
+
+export class TypesPluginConfigInterface extends Schema.Class<TypesPluginConfigInterface>("TypesPluginConfigInterface")(
+    {
+        ProtocolScheme: Schema.optional(
+            Schema.String.annotate({ description: "Protocol to use for clients connecting to the plugin." })
+        ),
+        Socket: Schema.String.annotate({ description: "socket\nRequired: true" }),
+        Types: Schema.NullOr(
+            Schema.Array(Schema.NullOr(Schema.TemplateLiteral([Schema.String, ".", Schema.String, "/", Schema.String])))
+        ).annotate({ description: "types\nRequired: true" }),
+    },
+    {
+        identifier: "TypesPluginConfigInterface",
+        title: "types.PluginConfigInterface",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#PluginConfigInterface",
+        description:
+            "PluginConfigInterface The interface between Docker and the plugin\nswagger:model PluginConfigInterface",
+    }
+) {}
@@ -0,0 +1,21 @@
+import * as Schema from "effect/Schema";
+
+import * as TypesPluginDevice from "./TypesPluginDevice.generated.ts";
+
+export class TypesPluginConfigLinux extends Schema.Class<TypesPluginConfigLinux>("TypesPluginConfigLinux")(
+    {
+        AllowAllDevices: Schema.Boolean.annotate({ description: "allow all devices\nRequired: true" }),
+        Capabilities: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description: "capabilities\nRequired: true",
+        }),
+        Devices: Schema.NullOr(Schema.Array(Schema.NullOr(TypesPluginDevice.TypesPluginDevice))).annotate({
+            description: "devices\nRequired: true",
+        }),
+    },
+    {
+        identifier: "TypesPluginConfigLinux",
+        title: "types.PluginConfigLinux",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#PluginConfigLinux",
+        description: "PluginConfigLinux plugin config linux\nswagger:model PluginConfigLinux",
+    }
+) {}
@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class TypesPluginConfigNetwork extends Schema.Class<TypesPluginConfigNetwork>("TypesPluginConfigNetwork")(
+    {
+        Type: Schema.String.annotate({ description: "type\nRequired: true" }),
+    },
+    {
+        identifier: "TypesPluginConfigNetwork",
+        title: "types.PluginConfigNetwork",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#PluginConfigNetwork",
+        description: "PluginConfigNetwork plugin config network\nswagger:model PluginConfigNetwork",
+    }
+) {}
@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class TypesPluginConfigRootfs extends Schema.Class<TypesPluginConfigRootfs>("TypesPluginConfigRootfs")(
+    {
+        diff_ids: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "diff ids" }),
+        type: Schema.optional(Schema.String.annotate({ description: "type" })),
+    },
+    {
+        identifier: "TypesPluginConfigRootfs",
+        title: "types.PluginConfigRootfs",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#PluginConfigRootfs",
+        description: "PluginConfigRootfs plugin config rootfs\nswagger:model PluginConfigRootfs",
+    }
+) {}
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export class TypesPluginDevice extends Schema.Class<TypesPluginDevice>("TypesPluginDevice")(
+    {
+        Description: Schema.String.annotate({ description: "description\nRequired: true" }),
+        Name: Schema.String.annotate({ description: "name\nRequired: true" }),
+        Path: Schema.NullOr(Schema.String).annotate({ description: "path\nRequired: true" }),
+        Settable: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "settable\nRequired: true" }),
+    },
+    {
+        identifier: "TypesPluginDevice",
+        title: "types.PluginDevice",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#PluginDevice",
+        description: "PluginDevice plugin device\nswagger:model PluginDevice",
+    }
+) {}
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export class TypesPluginEnv extends Schema.Class<TypesPluginEnv>("TypesPluginEnv")(
+    {
+        Description: Schema.String.annotate({ description: "description\nRequired: true" }),
+        Name: Schema.String.annotate({ description: "name\nRequired: true" }),
+        Settable: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "settable\nRequired: true" }),
+        Value: Schema.NullOr(Schema.String).annotate({ description: "value\nRequired: true" }),
+    },
+    {
+        identifier: "TypesPluginEnv",
+        title: "types.PluginEnv",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#PluginEnv",
+        description: "PluginEnv plugin env\nswagger:model PluginEnv",
+    }
+) {}
@@ -0,0 +1,23 @@
+import * as Schema from "effect/Schema";
+
+import * as TypesPluginDevice from "./TypesPluginDevice.generated.ts";
+import * as TypesPluginMount from "./TypesPluginMount.generated.ts";
+
+export class TypesPluginSettings extends Schema.Class<TypesPluginSettings>("TypesPluginSettings")(
+    {
+        Args: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "args\nRequired: true" }),
+        Devices: Schema.NullOr(Schema.Array(Schema.NullOr(TypesPluginDevice.TypesPluginDevice))).annotate({
+            description: "devices\nRequired: true",
+        }),
+        Env: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "env\nRequired: true" }),
+        Mounts: Schema.NullOr(Schema.Array(Schema.NullOr(TypesPluginMount.TypesPluginMount))).annotate({
+            description: "mounts\nRequired: true",
+        }),
+    },
+    {
+        identifier: "TypesPluginSettings",
+        title: "types.PluginSettings",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#PluginSettings",
+        description: "PluginSettings Settings that can be modified by users.\nswagger:model PluginSettings",
+    }
+) {}
@@ -0,0 +1,28 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class TypesPort extends Schema.Class<TypesPort>("TypesPort")(
+    {
+        IP: Schema.optional(
+            Schema.String.annotate({ description: "Host IP address that the container's port is mapped to" })
+        ),
+        PrivatePort: MobyNumber.NumberFromWireString.check(
+            Schema.isInt(),
+            Schema.isBetween({ minimum: 0, maximum: 2 ** 16 - 1 })
+        ).annotate({ description: "Port on the container\nRequired: true" }),
+        PublicPort: Schema.optional(
+            MobyNumber.NumberFromWireString.check(
+                Schema.isInt(),
+                Schema.isBetween({ minimum: 0, maximum: 2 ** 16 - 1 })
+            ).annotate({ description: "Port exposed on the host" })
+        ),
+        Type: Schema.String.annotate({ description: "type\nRequired: true" }),
+    },
+    {
+        identifier: "TypesPort",
+        title: "types.Port",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#Port",
+        description: "Port An open port on a container\nswagger:model Port",
+    }
+) {}
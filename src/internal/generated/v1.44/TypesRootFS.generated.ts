@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class TypesRootFS extends Schema.Class<TypesRootFS>("TypesRootFS")(
+    {
+        Type: Schema.optional(Schema.String),
+        Layers: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
+    },
+    {
+        identifier: "TypesRootFS",
+        title: "types.RootFS",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#RootFS",
+        description: "RootFS returns Image's RootFS description including the layer IDs.",
+    }
+) {}
@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export class TypesSecretListOptions extends Schema.Class<TypesSecretListOptions>("TypesSecretListOptions")(
+    {
+        Filters: Schema.NullOr(Schema.Record(Schema.String, Schema.Record(Schema.String, Schema.Boolean))),
+    },
+    {
+        identifier: "TypesSecretListOptions",
+        title: "types.SecretListOptions",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#SecretListOptions",
+        description: "SecretListOptions holds parameters to list secrets",
+    }
+) {}
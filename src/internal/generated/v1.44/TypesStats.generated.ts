@@ -0,0 +1,33 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as TypesBlkioStats from "./TypesBlkioStats.generated.ts";
+import * as TypesCPUStats from "./TypesCPUStats.generated.ts";
+import * as TypesMemoryStats from "./TypesMemoryStats.generated.ts";
+import * as TypesPidsStats from "./TypesPidsStats.generated.ts";
+import * as TypesStorageStats from "./TypesStorageStats.generated.ts";
+
+export class TypesStats extends Schema.Class<TypesStats>("TypesStats")(
+    {
+        read: Schema.NullOr(Schema.DateFromString).annotate({ description: "Common stats" }),
+        preread: Schema.NullOr(Schema.DateFromString),
+        pids_stats: Schema.NullOr(TypesPidsStats.TypesPidsStats).annotate({
+            description: "Linux specific stats, not populated on Windows.",
+        }),
+        blkio_stats: Schema.NullOr(TypesBlkioStats.TypesBlkioStats),
+        num_procs: MobyNumber.NumberFromWireString.check(
+            Schema.isInt(),
+            Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
+        ).annotate({ description: "Windows specific stats, not populated on Linux." }),
+        storage_stats: Schema.NullOr(TypesStorageStats.TypesStorageStats),
+        cpu_stats: Schema.NullOr(TypesCPUStats.TypesCPUStats).annotate({ description: "Shared stats" }),
+        precpu_stats: Schema.NullOr(TypesCPUStats.TypesCPUStats).annotate({ description: '"Pre"="Previous"' }),
+        memory_stats: Schema.NullOr(TypesMemoryStats.TypesMemoryStats),
+    },
+    {
+        identifier: "TypesStats",
+        title: "types.Stats",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#Stats",
+        description: "Stats is Ultimate struct aggregating all types of stats of one container",
+    }
+) {}
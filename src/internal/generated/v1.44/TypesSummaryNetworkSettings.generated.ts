@@ -0,0 +1,20 @@
+import * as Schema from "effect/Schema";
+
+import * as NetworkEndpointSettings from "./NetworkEndpointSettings.generated.ts";
+
+export class TypesSummaryNetworkSettings extends Schema.Class<TypesSummaryNetworkSettings>(
+    "TypesSummaryNetworkSettings"
+)(
+    {
+        Networks: Schema.NullOr(
+            Schema.Record(Schema.String, Schema.NullOr(NetworkEndpointSettings.NetworkEndpointSettings))
+        ),
+    },
+    {
+        identifier: "TypesSummaryNetworkSettings",
+        title: "types.SummaryNetworkSettings",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#SummaryNetworkSettings",
+        description: "SummaryNetworkSettings provides a summary of container's networks\nin /containers/json",
+    }
+) {}
@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export class TypesTaskListOptions extends Schema.Class<TypesTaskListOptions>("TypesTaskListOptions")(
+    {
+        Filters: Schema.NullOr(Schema.Record(Schema.String, Schema.Record(Schema.String, Schema.Boolean))),
+    },
+    {
+        identifier: "TypesTaskListOptions",
+        title: "types.TaskListOptions",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#TaskListOptions",
+        description: "TaskListOptions holds parameters to list tasks with.",
+    }
+) {}
@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class TypesVolumesPruneReport extends Schema.Class<TypesVolumesPruneReport>("TypesVolumesPruneReport")(
+    {
+        VolumesDeleted: Schema.NullOr(Schema.Array(Schema.String)),
+        SpaceReclaimed: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ),
+    },
+    {
+        identifier: "TypesVolumesPruneReport",
+        title: "types.VolumesPruneReport",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types#VolumesPruneReport",
+        description: 'VolumesPruneReport contains the response for Engine API:\nPOST "/volumes/prune"',
+    }
+) {}
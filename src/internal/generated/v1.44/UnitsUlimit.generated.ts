@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class UnitsUlimit extends Schema.Class<UnitsUlimit>("UnitsUlimit")(
     {
@@ -15,6 +15,7 @@ export class UnitsUlimit extends Schema.Class<UnitsUlimit>("UnitsUlimit")(
     {
         identifier: "UnitsUlimit",
         title: "units.Ulimit",
-        documentation: "",
+        documentation: "https://pkg.go.dev/github.com/docker/go-units@v0.5.0#Ulimit",
+        description: "Ulimit is a human friendly version of Rlimit.",
     }
 ) {}
@@ -0,0 +1,30 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as VolumePublishState from "./VolumePublishState.generated.ts";
+
+export class VolumePublishStatus extends Schema.Class<VolumePublishStatus>("VolumePublishStatus")(
+    {
+        NodeID: Schema.optional(
+            MobyIdentifiers.NodeIdentifier.annotate({
+                description: "NodeID is the ID of the swarm node this Volume is published to.",
+            })
+        ),
+        State: Schema.optional(
+            VolumePublishState.VolumePublishState.annotate({ description: "State is the publish state of the volume." })
+        ),
+        PublishContext: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+                description:
+                    "PublishContext is the PublishContext returned by the CSI plugin when\na volume is published.",
+            })
+        ),
+    },
+    {
+        identifier: "VolumePublishStatus",
+        title: "volume.PublishStatus",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/volume#PublishStatus",
+        description: "PublishStatus represents the status of the volume as published to an\nindividual node",
+    }
+) {}
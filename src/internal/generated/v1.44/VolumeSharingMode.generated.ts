@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+export const VolumeSharingMode = Schema.Literals(["none", "readonly", "onewriter", "all"]).annotate({
+    identifier: "VolumeSharingMode",
+    title: "volume.SharingMode",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/volume#SharingMode",
+    description:
+        "SharingMode defines the Sharing of a Cluster Volume. This is how Tasks using a\nVolume at the same time can use it.",
+});
+
+export type VolumeSharingMode = Schema.Schema.Type<typeof VolumeSharingMode>;
+
+export const VolumeSharingModeConstants = Object.freeze({
+    SharingNone: "none",
+    SharingReadOnly: "readonly",
+    SharingOneWriter: "onewriter",
+    SharingAll: "all",
+} as const);
@@ -0,0 +1,27 @@
+import * as Schema from "effect/Schema";
+
+import * as VolumeTopology from "./VolumeTopology.generated.ts";
+
+export class VolumeTopologyRequirement extends Schema.Class<VolumeTopologyRequirement>("VolumeTopologyRequirement")(
+    {
+        Requisite: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.NullOr(VolumeTopology.VolumeTopology))).annotate({
+                description:
+                    'Requisite specifies a list of Topologies, at least one of which the\nvolume must be accessible from.\n\nTaken verbatim from the CSI Spec:\n\nSpecifies the list of topologies the provisioned volume MUST be\naccessible from.\nThis field is OPTIONAL. If TopologyRequirement is specified either\nrequisite or preferred or both MUST be specified.\n\nIf requisite is specified, the provisioned volume MUST be\naccessible from at least one of the requisite topologies.\n\nGiven\n  x = number of topologies provisioned volume is accessible from\n  n = number of requisite topologies\nThe CO MUST ensure n >= 1. The SP MUST ensure x >= 1\nIf x==n, then the SP MUST make the provisioned volume available to\nall topologies from the list of requisite topologies. If it is\nunable to do so, the SP MUST fail the CreateVolume call.\nFor example, if a volume should be accessible from a single zone,\nand requisite =\n  {"region": "R1", "zone": "Z2"}\nthen the provisioned volume MUST be accessible from the "region"\n"R1" and the "zone" "Z2".\nSimilarly, if a volume should be accessible from two zones, and\nrequisite =\n  {"region": "R1", "zone": "Z2"},\n  {"region": "R1", "zone": "Z3"}\nthen the provisioned volume MUST be accessible from the "region"\n"R1" and both "zone" "Z2" and "zone" "Z3".\n\nIf x<n, then the SP SHALL choose x unique topologies from the list\nof requisite topologies. If it is unable to do so, the SP MUST fail\nthe CreateVolume call.\nFor example, if a volume should be accessible from a single zone,\nand requisite =\n  {"region": "R1", "zone": "Z2"},\n  {"region": "R1", "zone": "Z3"}\nthen the SP may choose to make the provisioned volume available in\neither the "zone" "Z2" or the "zone" "Z3" in the "region" "R1".\nSimilarly, if a volume should be accessible from two zones, and\nrequisite =\n  {"region": "R1", "zone": "Z2"},\n  {"region": "R1", "zone": "Z3"},\n  {"region": "R1", "zone": "Z4"}\nthen the provisioned volume MUST be accessible from any combination\nof two unique topologies: e.g. "R1/Z2" and "R1/Z3", or "R1/Z2" and\n "R1/Z4", or "R1/Z3" and "R1/Z4".\n\nIf x>n, then the SP MUST make the provisioned volume available from\nall topologies from the list of requisite topologies and MAY choose\nthe remaining x-n unique topologies from the list of all possible\ntopologies. If it is unable to do so, the SP MUST fail the\nCreateVolume call.\nFor example, if a volume should be accessible from two zones, and\nrequisite =\n  {"region": "R1", "zone": "Z2"}\nthen the provisioned volume MUST be accessible from the "region"\n"R1" and the "zone" "Z2" and the SP may select the second zone\nindependently, e.g. "R1/Z4".',
+            })
+        ),
+        Preferred: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.NullOr(VolumeTopology.VolumeTopology))).annotate({
+                description:
+                    'Preferred is a list of Topologies that the volume should attempt to be\nprovisioned in.\n\nTaken from the CSI spec:\n\nSpecifies the list of topologies the CO would prefer the volume to\nbe provisioned in.\n\nThis field is OPTIONAL. If TopologyRequirement is specified either\nrequisite or preferred or both MUST be specified.\n\nAn SP MUST attempt to make the provisioned volume available using\nthe preferred topologies in order from first to last.\n\nIf requisite is specified, all topologies in preferred list MUST\nalso be present in the list of requisite topologies.\n\nIf the SP is unable to to make the provisioned volume available\nfrom any of the preferred topologies, the SP MAY choose a topology\nfrom the list of requisite topologies.\nIf the list of requisite topologies is not specified, then the SP\nMAY choose from the list of all possible topologies.\nIf the list of requisite topologies is specified and the SP is\nunable to to make the provisioned volume available from any of the\nrequisite topologies it MUST fail the CreateVolume call.\n\nExample 1:\nGiven a volume should be accessible from a single zone, and\nrequisite =\n  {"region": "R1", "zone": "Z2"},\n  {"region": "R1", "zone": "Z3"}\npreferred =\n  {"region": "R1", "zone": "Z3"}\nthen the the SP SHOULD first attempt to make the provisioned volume\navailable from "zone" "Z3" in the "region" "R1" and fall back to\n"zone" "Z2" in the "region" "R1" if that is not possible.\n\nExample 2:\nGiven a volume should be accessible from a single zone, and\nrequisite =\n  {"region": "R1", "zone": "Z2"},\n  {"region": "R1", "zone": "Z3"},\n  {"region": "R1", "zone": "Z4"},\n  {"region": "R1", "zone": "Z5"}\npreferred =\n  {"region": "R1", "zone": "Z4"},\n  {"region": "R1", "zone": "Z2"}\nthen the the SP SHOULD first attempt to make the provisioned volume\naccessible from "zone" "Z4" in the "region" "R1" and fall back to\n"zone" "Z2" in the "region" "R1" if that is not possible. If that\nis not possible, the SP may choose between either the "zone"\n"Z3" or "Z5" in the "region" "R1".\n\nExample 3:\nGiven a volume should be accessible from TWO zones (because an\nopaque parameter in CreateVolumeRequest, for example, specifies\nthe volume is accessible from two zones, aka synchronously\nreplicated), and\nrequisite =\n  {"region": "R1", "zone": "Z2"},\n  {"region": "R1", "zone": "Z3"},\n  {"region": "R1", "zone": "Z4"},\n  {"region": "R1", "zone": "Z5"}\npreferred =\n  {"region": "R1", "zone": "Z5"},\n  {"region": "R1", "zone": "Z3"}\nthen the the SP SHOULD first attempt to make the provisioned volume\naccessible from the combination of the two "zones" "Z5" and "Z3" in\nthe "region" "R1". If that\'s not possible, it should fall back to\na combination of "Z5" and other possibilities from the list of\nrequisite. If that\'s not possible, it should fall back  to a\ncombination of "Z3" and other possibilities from the list of\nrequisite. If that\'s not possible, it should fall back  to a\ncombination of other possibilities from the list of requisite.',
+            })
+        ),
+    },
+    {
+        identifier: "VolumeTopologyRequirement",
+        title: "volume.TopologyRequirement",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/volume#TopologyRequirement",
+        description: "TopologyRequirement expresses the user's requirements for a volume's\naccessible topology.",
+    }
+) {}
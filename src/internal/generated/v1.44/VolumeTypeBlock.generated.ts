@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export class VolumeTypeBlock extends Schema.Class<VolumeTypeBlock>("VolumeTypeBlock")(
+    {},
+    {
+        identifier: "VolumeTypeBlock",
+        title: "volume.TypeBlock",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v25.0.13+incompatible/api/types/volume#TypeBlock",
+        description: "TypeBlock defines options for using a volume as a block-type volume.\n\nIntentionally empty.",
+    }
+) {}
@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class BlkiodevThrottleDevice extends Schema.Class<BlkiodevThrottleDevice>("BlkiodevThrottleDevice")(
     {
@@ -10,6 +10,8 @@ export class BlkiodevThrottleDevice extends Schema.Class<BlkiodevThrottleDevice>
     {
         identifier: "BlkiodevThrottleDevice",
         title: "blkiodev.ThrottleDevice",
-        documentation: "",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/blkiodev#ThrottleDevice",
+        description: "ThrottleDevice is a structure that holds device:rate_per_second pair",
     }
 ) {}
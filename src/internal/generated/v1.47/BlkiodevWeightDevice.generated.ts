@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class BlkiodevWeightDevice extends Schema.Class<BlkiodevWeightDevice>("BlkiodevWeightDevice")(
     {
@@ -13,6 +13,8 @@ export class BlkiodevWeightDevice extends Schema.Class<BlkiodevWeightDevice>("Bl
     {
         identifier: "BlkiodevWeightDevice",
         title: "blkiodev.WeightDevice",
-        documentation: "",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/blkiodev#WeightDevice",
+        description: "WeightDevice is a structure that holds device:weight pair",
     }
 ) {}
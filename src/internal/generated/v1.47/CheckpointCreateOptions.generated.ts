@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export class CheckpointCreateOptions extends Schema.Class<CheckpointCreateOptions>("CheckpointCreateOptions")(
+    {
+        CheckpointID: Schema.String,
+        CheckpointDir: Schema.String,
+        Exit: Schema.Boolean,
+    },
+    {
+        identifier: "CheckpointCreateOptions",
+        title: "checkpoint.CreateOptions",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/checkpoint#CreateOptions",
+        description: "CreateOptions holds parameters to create a checkpoint from a container.",
+    }
+) {}
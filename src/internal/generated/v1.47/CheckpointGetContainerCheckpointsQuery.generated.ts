@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const CheckpointGetContainerCheckpointsQuery = Schema.Struct({
+    dir: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "CheckpointGetContainerCheckpointsQuery",
+    title: "checkpoint.getContainerCheckpoints",
+    description: "Query parameters of GET /containers/{name:.*}/checkpoints",
+});
+
+export type CheckpointGetContainerCheckpointsQuery = Schema.Schema.Type<typeof CheckpointGetContainerCheckpointsQuery>;
@@ -0,0 +1,34 @@
+import * as Schema from "effect/Schema";
+
+import * as ContainerBlkioStatEntry from "./ContainerBlkioStatEntry.generated.ts";
+
+export class ContainerBlkioStats extends Schema.Class<ContainerBlkioStats>("ContainerBlkioStats")(
+    {
+        io_service_bytes_recursive: Schema.NullOr(
+            Schema.Array(Schema.NullOr(ContainerBlkioStatEntry.ContainerBlkioStatEntry))
+        ).annotate({ description: "number of bytes transferred to and from the block device" }),
+        io_serviced_recursive: Schema.NullOr(
+            Schema.Array(Schema.NullOr(ContainerBlkioStatEntry.ContainerBlkioStatEntry))
+        ),
+        io_queue_recursive: Schema.NullOr(Schema.Array(Schema.NullOr(ContainerBlkioStatEntry.ContainerBlkioStatEntry))),
+        io_service_time_recursive: Schema.NullOr(
+            Schema.Array(Schema.NullOr(ContainerBlkioStatEntry.ContainerBlkioStatEntry))
+        ),
+        io_wait_time_recursive: Schema.NullOr(
+            Schema.Array(Schema.NullOr(ContainerBlkioStatEntry.ContainerBlkioStatEntry))
+        ),
+        io_merged_recursive: Schema.NullOr(
+            Schema.Array(Schema.NullOr(ContainerBlkioStatEntry.ContainerBlkioStatEntry))
+        ),
+        io_time_recursive: Schema.NullOr(Schema.Array(Schema.NullOr(ContainerBlkioStatEntry.ContainerBlkioStatEntry))),
+        sectors_recursive: Schema.NullOr(Schema.Array(Schema.NullOr(ContainerBlkioStatEntry.ContainerBlkioStatEntry))),
+    },
+    {
+        identifier: "ContainerBlkioStats",
+        title: "container.BlkioStats",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/container#BlkioStats",
+        description:
+            "BlkioStats stores All IO service stats for data read and write.\nThis is a Linux specific structure as the differences between expressing\nblock I/O on Windows and Linux are sufficiently significant to make\nlittle sense attempting to morph into a combined structure.",
+    }
+) {}
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerCgroupnsMode = Schema.Literals(["", "private", "host"]).annotate({
+    identifier: "ContainerCgroupnsMode",
+    title: "container.CgroupnsMode",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/container#CgroupnsMode",
+    description: "CgroupnsMode represents the cgroup namespace mode of the container",
+});
+
+export type ContainerCgroupnsMode = Schema.Schema.Type<typeof ContainerCgroupnsMode>;
+
+export const ContainerCgroupnsModeConstants = Object.freeze({
+    CgroupnsModeEmpty: "",
+    CgroupnsModePrivate: "private",
+    CgroupnsModeHost: "host",
+} as const);
@@ -0,0 +1,125 @@
+import * as Effect from "effect/Effect";
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as PortSchemas from "../../schemas/port.ts";
+import * as ContainerHostConfig from "./ContainerHostConfig.generated.ts";
+import * as NetworkNetworkingConfig from "./NetworkNetworkingConfig.generated.ts";
+import * as V1HealthcheckConfig from "./V1HealthcheckConfig.generated.ts";
+
+export class ContainerCreateRequest extends Schema.Class<ContainerCreateRequest>("ContainerCreateRequest")(
+    {
+        Hostname: Schema.optional(Schema.String.annotate({ description: "Hostname" })).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        Domainname: Schema.optional(Schema.String.annotate({ description: "Domainname" })).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        User: Schema.optional(
+            Schema.String.annotate({
+                description: "User that will run the command(s) inside the container, also support user:group",
+            })
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => ""))),
+        AttachStdin: Schema.optional(
+            Schema.Boolean.annotate({ description: "Attach the standard input, makes possible user interaction" })
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => false))),
+        AttachStdout: Schema.optional(Schema.Boolean.annotate({ description: "Attach the standard output" })).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => false))
+        ),
+        AttachStderr: Schema.optional(Schema.Boolean.annotate({ description: "Attach the standard error" })).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => false))
+        ),
+        ExposedPorts: Schema.optional(
+            Schema.NullOr(PortSchemas.PortSet).annotate({ description: "List of exposed ports" })
+        ),
+        Tty: Schema.optional(
+            Schema.Boolean.annotate({
+                description: "Attach standard streams to a tty, including stdin if it is not closed.",
+            })
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => false))),
+        OpenStdin: Schema.optional(Schema.Boolean.annotate({ description: "Open stdin" })).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => false))
+        ),
+        StdinOnce: Schema.optional(
+            Schema.Boolean.annotate({ description: "If true, close stdin after the 1 attached client disconnects." })
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => false))),
+        Env: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "List of environment variable to set in the container",
+            })
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        Cmd: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "Command to run when starting the container",
+            })
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        Healthcheck: Schema.optional(
+            Schema.NullOr(V1HealthcheckConfig.V1HealthcheckConfig).annotate({
+                description: "Healthcheck describes how to check the container is healthy",
+            })
+        ),
+        ArgsEscaped: Schema.optional(
+            Schema.Boolean.annotate({
+                description: "True if command is already escaped (meaning treat as a command line) (Windows specific).",
+            })
+        ),
+        Image: Schema.optional(
+            Schema.String.annotate({
+                description: "Name of the image as it was passed by the operator (e.g. could be symbolic)",
+            })
+        ),
+        Volumes: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.ObjectKeyword)).annotate({
+                description: "List of volumes (mounts) used for the container",
+            })
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        WorkingDir: Schema.optional(
+            Schema.String.annotate({ description: "Current directory (PWD) in the command will be launched" })
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => ""))),
+        Entrypoint: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "Entrypoint to run when starting the container",
+            })
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        NetworkDisabled: Schema.optional(Schema.Boolean.annotate({ description: "Is network disabled" })),
+        MacAddress: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "Mac Address of the container.\n\nDeprecated: this field is deprecated since API v1.44. Use EndpointSettings.MacAddress instead.",
+            })
+        ),
+        OnBuild: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "ONBUILD metadata that were defined on the image Dockerfile",
+            })
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        Labels: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+                description: "List of labels set to this container",
+            })
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        StopSignal: Schema.optional(Schema.String.annotate({ description: "Signal to stop a container" })),
+        StopTimeout: Schema.optional(
+            Schema.NullOr(
+                MobyNumber.BigIntFromWireString.check(
+                    Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+                )
+            ).annotate({ description: "Timeout (in seconds) to stop a container" })
+        ),
+        Shell: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "Shell for shell-form of RUN, CMD, ENTRYPOINT",
+            })
+        ),
+        HostConfig: Schema.optional(Schema.NullOr(ContainerHostConfig.ContainerHostConfig)),
+        NetworkingConfig: Schema.optional(Schema.NullOr(NetworkNetworkingConfig.NetworkNetworkingConfig)),
+    },
+    {
+        identifier: "ContainerCreateRequest",
+        title: "container.CreateRequest",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/container#CreateRequest",
+        description:
+            "CreateRequest is the request message sent to the server for container\ncreate calls. It is a config wrapper that holds the container [Config]\n(portable) and the corresponding [HostConfig] (non-portable) and\n[network.NetworkingConfig].",
+    }
+) {}
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export class ContainerDeviceMapping extends Schema.Class<ContainerDeviceMapping>("ContainerDeviceMapping")(
+    {
+        PathOnHost: Schema.String,
+        PathInContainer: Schema.String,
+        CgroupPermissions: Schema.String,
+    },
+    {
+        identifier: "ContainerDeviceMapping",
+        title: "container.DeviceMapping",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/container#DeviceMapping",
+        description: "DeviceMapping represents the device mapping between the host and the container.",
+    }
+) {}
@@ -0,0 +1,25 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class ContainerExecInspect extends Schema.Class<ContainerExecInspect>("ContainerExecInspect")(
+    {
+        ID: MobyIdentifiers.ExecIdentifier,
+        ContainerID: MobyIdentifiers.ContainerIdentifier,
+        Running: Schema.Boolean,
+        ExitCode: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        Pid: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+    },
+    {
+        identifier: "ContainerExecInspect",
+        title: "container.ExecInspect",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/container#ExecInspect",
+        description: "ExecInspect holds information returned by exec inspect.",
+    }
+) {}
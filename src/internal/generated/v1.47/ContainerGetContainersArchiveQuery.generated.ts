@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerGetContainersArchiveQuery = Schema.Struct({
+    path: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "ContainerGetContainersArchiveQuery",
+    title: "container.getContainersArchive",
+    description: "Query parameters of GET /containers/{name:.*}/archive",
+});
+
+export type ContainerGetContainersArchiveQuery = Schema.Schema.Type<typeof ContainerGetContainersArchiveQuery>;
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerGetContainersTopQuery = Schema.Struct({
+    ps_args: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "ContainerGetContainersTopQuery",
+    title: "container.getContainersTop",
+    description: "Query parameters of GET /containers/{name:.*}/top",
+});
+
+export type ContainerGetContainersTopQuery = Schema.Schema.Type<typeof ContainerGetContainersTopQuery>;
@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerIsolation = Schema.Literals(["", "default", "process", "hyperv"]).annotate({
+    identifier: "ContainerIsolation",
+    title: "container.Isolation",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/container#Isolation",
+    description:
+        "Isolation represents the isolation technology of a container. The supported\nvalues are platform specific",
+});
+
+export type ContainerIsolation = Schema.Schema.Type<typeof ContainerIsolation>;
+
+export const ContainerIsolationConstants = Object.freeze({
+    IsolationEmpty: "",
+    IsolationDefault: "default",
+    IsolationProcess: "process",
+    IsolationHyperV: "hyperv",
+} as const);
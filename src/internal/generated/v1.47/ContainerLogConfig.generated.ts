@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class ContainerLogConfig extends Schema.Class<ContainerLogConfig>("ContainerLogConfig")(
+    {
+        Type: Schema.String,
+        Config: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+    },
+    {
+        identifier: "ContainerLogConfig",
+        title: "container.LogConfig",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/container#LogConfig",
+        description: "LogConfig represents the logging configuration of the container.",
+    }
+) {}
@@ -0,0 +1,25 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class ContainerPathStat extends Schema.Class<ContainerPathStat>("ContainerPathStat")(
+    {
+        name: Schema.String,
+        size: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        mode: MobyNumber.NumberFromWireString.check(
+            Schema.isInt(),
+            Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
+        ),
+        mtime: Schema.NullOr(Schema.DateFromString),
+        linkTarget: Schema.String,
+    },
+    {
+        identifier: "ContainerPathStat",
+        title: "container.PathStat",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/container#PathStat",
+        description:
+            'PathStat is used to encode the header from\nGET "/containers/{name:.*}/archive"\n"Name" is the file or directory name.',
+    }
+) {}
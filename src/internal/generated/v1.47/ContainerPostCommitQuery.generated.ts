@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerPostCommitQuery = Schema.Struct({
+    repo: Schema.optional(Schema.String),
+    tag: Schema.optional(Schema.String),
+    container: Schema.optional(Schema.String),
+    pause: Schema.optional(Schema.Boolean),
+    author: Schema.optional(Schema.String),
+    comment: Schema.optional(Schema.String),
+    changes: Schema.optional(Schema.Array(Schema.String)),
+}).annotate({
+    identifier: "ContainerPostCommitQuery",
+    title: "container.postCommit",
+    description: "Query parameters of POST /commit",
+});
+
+export type ContainerPostCommitQuery = Schema.Schema.Type<typeof ContainerPostCommitQuery>;
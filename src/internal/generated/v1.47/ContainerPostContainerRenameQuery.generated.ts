@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerPostContainerRenameQuery = Schema.Struct({
+    name: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "ContainerPostContainerRenameQuery",
+    title: "container.postContainerRename",
+    description: "Query parameters of POST /containers/{name:.*}/rename",
+});
+
+export type ContainerPostContainerRenameQuery = Schema.Schema.Type<typeof ContainerPostContainerRenameQuery>;
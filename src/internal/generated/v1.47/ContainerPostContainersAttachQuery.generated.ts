@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerPostContainersAttachQuery = Schema.Struct({
+    detachKeys: Schema.optional(Schema.String),
+    stdin: Schema.optional(Schema.Boolean),
+    stdout: Schema.optional(Schema.Boolean),
+    stderr: Schema.optional(Schema.Boolean),
+    logs: Schema.optional(Schema.Boolean),
+    stream: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "ContainerPostContainersAttachQuery",
+    title: "container.postContainersAttach",
+    description: "Query parameters of POST /containers/{name:.*}/attach",
+});
+
+export type ContainerPostContainersAttachQuery = Schema.Schema.Type<typeof ContainerPostContainersAttachQuery>;
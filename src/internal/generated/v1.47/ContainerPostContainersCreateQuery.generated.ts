@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerPostContainersCreateQuery = Schema.Struct({
+    name: Schema.optional(Schema.String),
+    platform: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "ContainerPostContainersCreateQuery",
+    title: "container.postContainersCreate",
+    description: "Query parameters of POST /containers/create",
+});
+
+export type ContainerPostContainersCreateQuery = Schema.Schema.Type<typeof ContainerPostContainersCreateQuery>;
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerPostContainersKillQuery = Schema.Struct({
+    signal: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "ContainerPostContainersKillQuery",
+    title: "container.postContainersKill",
+    description: "Query parameters of POST /containers/{name:.*}/kill",
+});
+
+export type ContainerPostContainersKillQuery = Schema.Schema.Type<typeof ContainerPostContainersKillQuery>;
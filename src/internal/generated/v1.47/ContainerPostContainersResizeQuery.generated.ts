@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerPostContainersResizeQuery = Schema.Struct({
+    h: Schema.optional(Schema.Finite),
+    w: Schema.optional(Schema.Finite),
+}).annotate({
+    identifier: "ContainerPostContainersResizeQuery",
+    title: "container.postContainersResize",
+    description: "Query parameters of POST /containers/{name:.*}/resize",
+});
+
+export type ContainerPostContainersResizeQuery = Schema.Schema.Type<typeof ContainerPostContainersResizeQuery>;
@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerPostContainersStartQuery = Schema.Struct({
+    checkpoint: Schema.optional(Schema.String),
+    "checkpoint-dir": Schema.optional(Schema.String),
+}).annotate({
+    identifier: "ContainerPostContainersStartQuery",
+    title: "container.postContainersStart",
+    description: "Query parameters of POST /containers/{name:.*}/start",
+});
+
+export type ContainerPostContainersStartQuery = Schema.Schema.Type<typeof ContainerPostContainersStartQuery>;
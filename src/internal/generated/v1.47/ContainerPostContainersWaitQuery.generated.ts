@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerPostContainersWaitQuery = Schema.Struct({
+    condition: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "ContainerPostContainersWaitQuery",
+    title: "container.postContainersWait",
+    description: "Query parameters of POST /containers/{name:.*}/wait",
+});
+
+export type ContainerPostContainersWaitQuery = Schema.Schema.Type<typeof ContainerPostContainersWaitQuery>;
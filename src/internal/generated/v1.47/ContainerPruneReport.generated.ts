@@ -0,0 +1,19 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class ContainerPruneReport extends Schema.Class<ContainerPruneReport>("ContainerPruneReport")(
+    {
+        ContainersDeleted: Schema.NullOr(Schema.Array(Schema.String)),
+        SpaceReclaimed: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ),
+    },
+    {
+        identifier: "ContainerPruneReport",
+        title: "container.PruneReport",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/container#PruneReport",
+        description: 'PruneReport contains the response for Engine API:\nPOST "/containers/prune"',
+    }
+) {}
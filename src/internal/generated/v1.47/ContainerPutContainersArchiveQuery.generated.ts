@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerPutContainersArchiveQuery = Schema.Struct({
+    path: Schema.optional(Schema.String),
+    noOverwriteDirNonDir: Schema.optional(Schema.Boolean),
+    copyUIDGID: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "ContainerPutContainersArchiveQuery",
+    title: "container.putContainersArchive",
+    description: "Query parameters of PUT /containers/{name:.*}/archive",
+});
+
+export type ContainerPutContainersArchiveQuery = Schema.Schema.Type<typeof ContainerPutContainersArchiveQuery>;
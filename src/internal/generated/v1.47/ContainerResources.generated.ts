@@ -0,0 +1,160 @@
+import * as Effect from "effect/Effect";
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as BlkiodevThrottleDevice from "./BlkiodevThrottleDevice.generated.ts";
+import * as BlkiodevWeightDevice from "./BlkiodevWeightDevice.generated.ts";
+import * as ContainerDeviceMapping from "./ContainerDeviceMapping.generated.ts";
+import * as ContainerDeviceRequest from "./ContainerDeviceRequest.generated.ts";
+import * as UnitsUlimit from "./UnitsUlimit.generated.ts";
+
+export class ContainerResources extends Schema.Class<ContainerResources>("ContainerResources")(
+    {
+        CpuShares: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "CPU shares (relative weight vs. other containers)" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        Memory: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "Memory limit (in bytes)" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        NanoCpus: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "CPU quota in units of 10<sup>-9</sup> CPUs." })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        CgroupParent: Schema.String.annotate({ description: "Parent cgroup." }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        BlkioWeight: MobyNumber.NumberFromWireString.check(
+            Schema.isInt(),
+            Schema.isBetween({ minimum: 0, maximum: 2 ** 16 - 1 })
+        )
+            .annotate({ description: "Block IO weight (relative weight vs. other containers)" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0))),
+        BlkioWeightDevice: Schema.NullOr(Schema.Array(Schema.NullOr(BlkiodevWeightDevice.BlkiodevWeightDevice))).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => []))
+        ),
+        BlkioDeviceReadBps: Schema.NullOr(
+            Schema.Array(Schema.NullOr(BlkiodevThrottleDevice.BlkiodevThrottleDevice))
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        BlkioDeviceWriteBps: Schema.NullOr(
+            Schema.Array(Schema.NullOr(BlkiodevThrottleDevice.BlkiodevThrottleDevice))
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        BlkioDeviceReadIOps: Schema.NullOr(
+            Schema.Array(Schema.NullOr(BlkiodevThrottleDevice.BlkiodevThrottleDevice))
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        BlkioDeviceWriteIOps: Schema.NullOr(
+            Schema.Array(Schema.NullOr(BlkiodevThrottleDevice.BlkiodevThrottleDevice))
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        CpuPeriod: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "CPU CFS (Completely Fair Scheduler) period" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        CpuQuota: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "CPU CFS (Completely Fair Scheduler) quota" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        CpuRealtimePeriod: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "CPU real-time period" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        CpuRealtimeRuntime: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "CPU real-time runtime" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        CpusetCpus: Schema.String.annotate({ description: "CpusetCpus 0-2, 0,1" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        CpusetMems: Schema.String.annotate({ description: "CpusetMems 0-2, 0,1" }).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => ""))
+        ),
+        Devices: Schema.NullOr(Schema.Array(Schema.NullOr(ContainerDeviceMapping.ContainerDeviceMapping)))
+            .annotate({ description: "List of devices to map inside the container" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        DeviceCgroupRules: Schema.NullOr(Schema.Array(Schema.String))
+            .annotate({ description: "List of rule to be added to the device cgroup" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        DeviceRequests: Schema.NullOr(Schema.Array(Schema.NullOr(ContainerDeviceRequest.ContainerDeviceRequest)))
+            .annotate({ description: "List of device requests for device drivers" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        KernelMemory: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({
+                description:
+                    "KernelMemory specifies the kernel memory limit (in bytes) for the container.\nDeprecated: kernel 5.4 deprecated kmem.limit_in_bytes.",
+            })
+        ),
+        KernelMemoryTCP: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({ description: "Hard limit for kernel TCP buffer memory (in bytes)" })
+        ),
+        MemoryReservation: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "Memory soft limit (in bytes)" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        MemorySwap: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "Total memory usage (memory + swap); set `-1` to enable unlimited swap" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        MemorySwappiness: Schema.NullOr(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            )
+        )
+            .annotate({ description: "Tuning container memory swappiness behaviour" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => -1n))),
+        OomKillDisable: Schema.NullOr(Schema.Boolean)
+            .annotate({ description: "Whether to disable OOM Killer or not" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => false))),
+        PidsLimit: Schema.NullOr(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            )
+        )
+            .annotate({
+                description:
+                    "Setting PIDs limit for a container; Set `0` or `-1` for unlimited, or `null` to not change.",
+            })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        Ulimits: Schema.NullOr(Schema.Array(Schema.NullOr(UnitsUlimit.UnitsUlimit)))
+            .annotate({ description: "List of ulimits to be set in the container" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => []))),
+        CpuCount: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "CPU count" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        CpuPercent: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        )
+            .annotate({ description: "CPU percent" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        IOMaximumIOps: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        )
+            .annotate({ description: "Maximum IOps for the container system drive" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+        IOMaximumBandwidth: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        )
+            .annotate({ description: "Maximum IO in bytes per second for the container system drive" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => 0n))),
+    },
+    {
+        identifier: "ContainerResources",
+        title: "container.Resources",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/container#Resources",
+        description: "Resources contains container's resources (cgroups config, ulimits...)",
+    }
+) {}
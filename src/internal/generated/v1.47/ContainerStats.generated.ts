@@ -0,0 +1,33 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as ContainerBlkioStats from "./ContainerBlkioStats.generated.ts";
+import * as ContainerCPUStats from "./ContainerCPUStats.generated.ts";
+import * as ContainerMemoryStats from "./ContainerMemoryStats.generated.ts";
+import * as ContainerPidsStats from "./ContainerPidsStats.generated.ts";
+import * as ContainerStorageStats from "./ContainerStorageStats.generated.ts";
+
+export class ContainerStats extends Schema.Class<ContainerStats>("ContainerStats")(
+    {
+        read: Schema.NullOr(Schema.DateFromString).annotate({ description: "Common stats" }),
+        preread: Schema.NullOr(Schema.DateFromString),
+        pids_stats: Schema.NullOr(ContainerPidsStats.ContainerPidsStats).annotate({
+            description: "Linux specific stats, not populated on Windows.",
+        }),
+        blkio_stats: Schema.NullOr(ContainerBlkioStats.ContainerBlkioStats),
+        num_procs: MobyNumber.NumberFromWireString.check(
+            Schema.isInt(),
+            Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
+        ).annotate({ description: "Windows specific stats, not populated on Linux." }),
+        storage_stats: Schema.NullOr(ContainerStorageStats.ContainerStorageStats),
+        cpu_stats: Schema.NullOr(ContainerCPUStats.ContainerCPUStats).annotate({ description: "Shared stats" }),
+        precpu_stats: Schema.NullOr(ContainerCPUStats.ContainerCPUStats).annotate({ description: '"Pre"="Previous"' }),
+        memory_stats: Schema.NullOr(ContainerMemoryStats.ContainerMemoryStats),
+    },
+    {
+        identifier: "ContainerStats",
+        title: "container.Stats",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/container#Stats",
+        description: "Stats is Ultimate struct aggregating all types of stats of one container",
+    }
+) {}
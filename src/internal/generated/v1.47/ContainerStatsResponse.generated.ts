@@ -0,0 +1,43 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as ContainerBlkioStats from "./ContainerBlkioStats.generated.ts";
+import * as ContainerCPUStats from "./ContainerCPUStats.generated.ts";
+import * as ContainerMemoryStats from "./ContainerMemoryStats.generated.ts";
+import * as ContainerNetworkStats from "./ContainerNetworkStats.generated.ts";
+import * as ContainerPidsStats from "./ContainerPidsStats.generated.ts";
+import * as ContainerStorageStats from "./ContainerStorageStats.generated.ts";
+
+export class ContainerStatsResponse extends Schema.Class<ContainerStatsResponse>("ContainerStatsResponse")(
+    {
+        read: Schema.NullOr(Schema.DateFromString).annotate({ description: "Common stats" }),
+        preread: Schema.NullOr(Schema.DateFromString),
+        pids_stats: Schema.NullOr(ContainerPidsStats.ContainerPidsStats).annotate({
+            description: "Linux specific stats, not populated on Windows.",
+        }),
+        blkio_stats: Schema.NullOr(ContainerBlkioStats.ContainerBlkioStats),
+        num_procs: MobyNumber.NumberFromWireString.check(
+            Schema.isInt(),
+            Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
+        ).annotate({ description: "Windows specific stats, not populated on Linux." }),
+        storage_stats: Schema.NullOr(ContainerStorageStats.ContainerStorageStats),
+        cpu_stats: Schema.NullOr(ContainerCPUStats.ContainerCPUStats).annotate({ description: "Shared stats" }),
+        precpu_stats: Schema.NullOr(ContainerCPUStats.ContainerCPUStats).annotate({ description: '"Pre"="Previous"' }),
+        memory_stats: Schema.NullOr(ContainerMemoryStats.ContainerMemoryStats),
+        name: Schema.optional(Schema.String),
+        id: Schema.optional(Schema.String),
+        networks: Schema.optional(
+            Schema.NullOr(
+                Schema.Record(Schema.String, Schema.NullOr(ContainerNetworkStats.ContainerNetworkStats))
+            ).annotate({ description: "Networks request version >=1.21" })
+        ),
+    },
+    {
+        identifier: "ContainerStatsResponse",
+        title: "container.StatsResponse",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/container#StatsResponse",
+        description:
+            "StatsResponse is newly used Networks.\n\nTODO(thaJeztah): unify with [Stats]. This wrapper was to account for pre-api v1.21 changes, see https://github.com/moby/moby/commit/d3379946ec96fb6163cb8c4517d7d5a067045801",
+    }
+) {}
@@ -0,0 +1,24 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class ContainerThrottlingData extends Schema.Class<ContainerThrottlingData>("ContainerThrottlingData")(
+    {
+        periods: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ).annotate({ description: "Number of periods with throttling active" }),
+        throttled_periods: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ).annotate({ description: "Number of periods when the container hits its throttling limit." }),
+        throttled_time: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ).annotate({ description: "Aggregate time the container was throttled for in nanoseconds." }),
+    },
+    {
+        identifier: "ContainerThrottlingData",
+        title: "container.ThrottlingData",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/container#ThrottlingData",
+        description: "ThrottlingData stores CPU throttling stats of one running container.\nNot used on Windows.",
+    }
+) {}
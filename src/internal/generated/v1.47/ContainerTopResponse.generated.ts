@@ -0,0 +1,20 @@
+import * as Schema from "effect/Schema";
+
+export class ContainerTopResponse extends Schema.Class<ContainerTopResponse>("ContainerTopResponse")(
+    {
+        Processes: Schema.NullOr(Schema.Array(Schema.NullOr(Schema.Array(Schema.String)))).annotate({
+            description:
+                "Each process running in the container, where each is process\nis an array of values corresponding to the titles.\n\nRequired: true",
+        }),
+        Titles: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description: "The ps column titles\nRequired: true",
+        }),
+    },
+    {
+        identifier: "ContainerTopResponse",
+        title: "container.ContainerTopOKBody",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/container#ContainerTopOKBody",
+        description: "ContainerTopOKBody OK response to ContainerTop operation\nswagger:model ContainerTopOKBody",
+    }
+) {}
@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class ContainerWaitExitError extends Schema.Class<ContainerWaitExitError>("ContainerWaitExitError")(
+    {
+        Message: Schema.optional(Schema.String.annotate({ description: "Details of an error" })),
+    },
+    {
+        identifier: "ContainerWaitExitError",
+        title: "container.WaitExitError",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/container#WaitExitError",
+        description: "WaitExitError container waiting error, if any\nswagger:model WaitExitError",
+    }
+) {}
@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+
+export class ImageAttestationProperties extends Schema.Class<ImageAttestationProperties>("ImageAttestationProperties")(
+    {
+        For: MobyIdentifiers.Digest.annotate({
+            description: "For is the digest of the image manifest that this attestation is for.",
+        }),
+    },
+    {
+        identifier: "ImageAttestationProperties",
+        title: "image.AttestationProperties",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/image#AttestationProperties",
+    }
+) {}
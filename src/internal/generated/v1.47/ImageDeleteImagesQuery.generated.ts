@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export const ImageDeleteImagesQuery = Schema.Struct({
+    force: Schema.optional(Schema.Boolean),
+    noprune: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "ImageDeleteImagesQuery",
+    title: "image.deleteImages",
+    description: "Query parameters of DELETE /images/{name:.*}",
+});
+
+export type ImageDeleteImagesQuery = Schema.Schema.Type<typeof ImageDeleteImagesQuery>;
@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+
+export const ImageGetImagesJSONQuery = Schema.Struct({
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+    filter: Schema.optional(Schema.String),
+    "shared-size": Schema.optional(Schema.Boolean),
+    manifests: Schema.optional(Schema.Boolean),
+    all: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "ImageGetImagesJSONQuery",
+    title: "image.getImagesJSON",
+    description: "Query parameters of GET /images/json",
+});
+
+export type ImageGetImagesJSONQuery = Schema.Schema.Type<typeof ImageGetImagesJSONQuery>;
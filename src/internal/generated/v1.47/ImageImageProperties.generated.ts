@@ -0,0 +1,23 @@
+import * as Schema from "effect/Schema";
+
+import * as ImageImagePropertiesSize from "./ImageImagePropertiesSize.generated.ts";
+import * as V1Platform from "./V1Platform.generated.ts";
+
+export class ImageImageProperties extends Schema.Class<ImageImageProperties>("ImageImageProperties")(
+    {
+        Platform: Schema.NullOr(V1Platform.V1Platform).annotate({
+            description: "Platform is the OCI platform object describing the platform of the image.\n\nRequired: true",
+        }),
+        Size: ImageImagePropertiesSize.ImageImagePropertiesSize,
+        Containers: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description:
+                "Containers is an array containing the IDs of the containers that are\nusing this image.\n\nRequired: true",
+        }),
+    },
+    {
+        identifier: "ImageImageProperties",
+        title: "image.ImageProperties",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/image#ImageProperties",
+    }
+) {}
@@ -0,0 +1,20 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class ImageImagePropertiesSize extends Schema.Class<ImageImagePropertiesSize>("ImageImagePropertiesSize")(
+    {
+        Unpacked: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "Unpacked is the size (in bytes) of the locally unpacked\n(uncompressed) image content that's directly usable by the containers\nrunning this image.\nIt's independent of the distributable content - e.g.\nthe image might still have an unpacked data that's still used by\nsome container even when the distributable/compressed content is\nalready gone.\n\nRequired: true",
+        }),
+    },
+    {
+        identifier: "ImageImagePropertiesSize",
+        title: "image.ImageProperties.Size",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/image#ImageProperties",
+    }
+) {}
@@ -0,0 +1,101 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
+import * as ContainerConfig from "./ContainerConfig.generated.ts";
+import * as ImageMetadata from "./ImageMetadata.generated.ts";
+import * as TypesGraphDriverData from "./TypesGraphDriverData.generated.ts";
+import * as TypesRootFS from "./TypesRootFS.generated.ts";
+
+export class ImageInspectResponse extends Schema.Class<ImageInspectResponse>("ImageInspectResponse")(
+    {
+        Id: MobyIdentifiers.ImageIdentifier.annotate({
+            description:
+                "ID is the content-addressable ID of an image.\n\nThis identifier is a content-addressable digest calculated from the\nimage's configuration (which includes the digests of layers used by\nthe image).\n\nNote that this digest differs from the `RepoDigests` below, which\nholds digests of image manifests that reference the image.",
+        }),
+        RepoTags: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description:
+                'RepoTags is a list of image names/tags in the local image cache that\nreference this image.\n\nMultiple image tags can refer to the same image, and this list may be\nempty if no tags reference the image, in which case the image is\n"untagged", in which case it can still be referenced by its ID.',
+        }),
+        RepoDigests: Schema.NullOr(Schema.Array(MobyIdentifiers.Digest)).annotate({
+            description:
+                "RepoDigests is a list of content-addressable digests of locally available\nimage manifests that the image is referenced from. Multiple manifests can\nrefer to the same image.\n\nThese digests are usually only available if the image was either pulled\nfrom a registry, or if the image was pushed to a registry, which is when\nthe manifest is generated and its digest calculated.",
+        }),
+        Parent: Schema.String.annotate({
+            description:
+                "Parent is the ID of the parent image.\n\nDepending on how the image was created, this field may be empty and\nis only set for images that were built/created locally. This field\nis empty if the image was pulled from an image registry.",
+        }),
+        Comment: Schema.String.annotate({
+            description: "Comment is an optional message that can be set when committing or\nimporting the image.",
+        }),
+        Created: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "Created is the date and time at which the image was created, formatted in\nRFC 3339 nano-seconds (time.RFC3339Nano).\n\nThis information is only available if present in the image,\nand omitted otherwise.",
+            })
+        ),
+        Container: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "Container is the ID of the container that was used to create the image.\n\nDepending on how the image was created, this field may be empty.\n\nDeprecated: this field is omitted in API v1.45, but kept for backward compatibility.",
+            })
+        ),
+        ContainerConfig: Schema.optional(
+            Schema.NullOr(ContainerConfig.ContainerConfig).annotate({
+                description:
+                    "ContainerConfig is an optional field containing the configuration of the\ncontainer that was last committed when creating the image.\n\nPrevious versions of Docker builder used this field to store build cache,\nand it is not in active use anymore.\n\nDeprecated: this field is omitted in API v1.45, but kept for backward compatibility.",
+            })
+        ),
+        DockerVersion: Schema.String.annotate({
+            description:
+                "DockerVersion is the version of Docker that was used to build the image.\n\nDepending on how the image was created, this field may be empty.",
+        }),
+        Author: Schema.String.annotate({
+            description:
+                "Author is the name of the author that was specified when committing the\nimage, or as specified through MAINTAINER (deprecated) in the Dockerfile.",
+        }),
+        Config: Schema.NullOr(ContainerConfig.ContainerConfig),
+        Architecture: Schema.String.annotate({
+            description: "Architecture is the hardware CPU architecture that the image runs on.",
+        }),
+        Variant: Schema.optional(
+            Schema.String.annotate({ description: "Variant is the CPU architecture variant (presently ARM-only)." })
+        ),
+        Os: Schema.String.annotate({ description: "OS is the Operating System the image is built to run on." }),
+        OsVersion: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "OsVersion is the version of the Operating System the image is built to\nrun on (especially for Windows).",
+            })
+        ),
+        Size: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({ description: "Size is the total size of the image including all layers it is composed of." }),
+        VirtualSize: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({
+                deprecatedIn: "1.44",
+                description:
+                    "VirtualSize is the total size of the image including all layers it is\ncomposed of.\n\nDeprecated: this field is omitted in API v1.44, but kept for backward compatibility. Use Size instead.",
+            })
+        ),
+        GraphDriver: Schema.NullOr(TypesGraphDriverData.TypesGraphDriverData).annotate({
+            description:
+                "GraphDriver holds information about the storage driver used to store the\ncontainer's and image's filesystem.",
+        }),
+        RootFS: Schema.NullOr(TypesRootFS.TypesRootFS).annotate({
+            description: "RootFS contains information about the image's RootFS, including the\nlayer IDs.",
+        }),
+        Metadata: Schema.NullOr(ImageMetadata.ImageMetadata).annotate({
+            description:
+                "Metadata of the image in the local cache.\n\nThis information is local to the daemon, and not part of the image itself.",
+        }),
+    },
+    {
+        identifier: "ImageInspectResponse",
+        title: "types.ImageInspect",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types#ImageInspect",
+        description: 'ImageInspect contains response of Engine API:\nGET "/images/{name:.*}/json"',
+    }
+) {}
@@ -0,0 +1,28 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class ImageManifestSummarySize extends Schema.Class<ImageManifestSummarySize>("ImageManifestSummarySize")(
+    {
+        Content: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "Content is the size (in bytes) of all the locally present\ncontent in the content store (e.g. image config, layers)\nreferenced by this manifest and its children.\nThis only includes blobs in the content store.",
+        }),
+        Total: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "Total is the total size (in bytes) of all the locally present\ndata (both distributable and non-distributable) that's related to\nthis manifest and its children.\nThis equal to the sum of [Content] size AND all the sizes in the\n[Size] struct present in the Kind-specific data struct.\nFor example, for an image kind (Kind == ManifestKindImage),\nthis would include the size of the image content and unpacked\nimage snapshots ([Size.Content] + [ImageData.Size.Unpacked]).",
+        }),
+    },
+    {
+        identifier: "ImageManifestSummarySize",
+        title: "image.ManifestSummary.Size",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/image#ManifestSummary",
+        description:
+            "Size is the size information of the content related to this manifest.\nNote: These sizes only take the locally available content into account.\n\nRequired: true",
+    }
+) {}
@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export const ImagePostImagesCreateQuery = Schema.Struct({
+    fromImage: Schema.optional(Schema.String),
+    repo: Schema.optional(Schema.String),
+    tag: Schema.optional(Schema.String),
+    message: Schema.optional(Schema.String),
+    platform: Schema.optional(Schema.String),
+    fromSrc: Schema.optional(Schema.String),
+    changes: Schema.optional(Schema.Array(Schema.String)),
+}).annotate({
+    identifier: "ImagePostImagesCreateQuery",
+    title: "image.postImagesCreate",
+    description: "Query parameters of POST /images/create",
+});
+
+export type ImagePostImagesCreateQuery = Schema.Schema.Type<typeof ImagePostImagesCreateQuery>;
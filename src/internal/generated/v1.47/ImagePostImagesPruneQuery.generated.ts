@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const ImagePostImagesPruneQuery = Schema.Struct({
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+}).annotate({
+    identifier: "ImagePostImagesPruneQuery",
+    title: "image.postImagesPrune",
+    description: "Query parameters of POST /images/prune",
+});
+
+export type ImagePostImagesPruneQuery = Schema.Schema.Type<typeof ImagePostImagesPruneQuery>;
@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 import * as JsonmessageJSONError from "./JsonmessageJSONError.generated.ts";
 import * as JsonmessageJSONProgress from "./JsonmessageJSONProgress.generated.ts";
 
@@ -9,7 +9,7 @@ export class JSONMessage extends Schema.Class<JSONMessage>("JSONMessage")(
         stream: Schema.optional(Schema.String),
         status: Schema.optional(Schema.String),
         progressDetail: Schema.optional(Schema.NullOr(JsonmessageJSONProgress.JsonmessageJSONProgress)),
-        progress: Schema.optional(Schema.String),
+        progress: Schema.optional(Schema.String.annotate({ description: "deprecated" })),
         id: Schema.optional(Schema.String),
         from: Schema.optional(Schema.String),
         time: Schema.optional(
@@ -23,12 +23,19 @@ export class JSONMessage extends Schema.Class<JSONMessage>("JSONMessage")(
             )
         ),
         errorDetail: Schema.optional(Schema.NullOr(JsonmessageJSONError.JsonmessageJSONError)),
-        error: Schema.optional(Schema.String),
-        aux: Schema.optional(Schema.NullOr(Schema.Unknown)),
+        error: Schema.optional(Schema.String.annotate({ description: "deprecated" })),
+        aux: Schema.optional(
+            Schema.NullOr(Schema.Unknown).annotate({
+                description:
+                    "Aux contains out-of-band data, such as digests for push signing and image id after building.",
+            })
+        ),
     },
     {
         identifier: "JSONMessage",
         title: "jsonmessage.JSONMessage",
-        documentation: "",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/pkg/jsonmessage#JSONMessage",
+        description:
+            "JSONMessage defines a message struct. It describes\nthe created time, where it from, status, ID of the\nmessage. It's used for docker events.",
     }
 ) {}
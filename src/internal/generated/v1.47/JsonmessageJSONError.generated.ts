@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class JsonmessageJSONError extends Schema.Class<JsonmessageJSONError>("JsonmessageJSONError")(
     {
@@ -14,6 +14,8 @@ export class JsonmessageJSONError extends Schema.Class<JsonmessageJSONError>("Js
     {
         identifier: "JsonmessageJSONError",
         title: "jsonmessage.JSONError",
-        documentation: "",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/pkg/jsonmessage#JSONError",
+        description:
+            "JSONError wraps a concrete Code and Message, Code is\nan integer error code, Message is the error message.",
     }
 ) {}
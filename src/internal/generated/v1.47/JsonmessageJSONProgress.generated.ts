@@ -0,0 +1,39 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class JsonmessageJSONProgress extends Schema.Class<JsonmessageJSONProgress>("JsonmessageJSONProgress")(
+    {
+        current: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({ description: "Current is the current status and value of the progress made towards Total." })
+        ),
+        total: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({
+                description: "Total is the end value describing when we made 100% progress for an operation.",
+            })
+        ),
+        start: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({ description: "Start is the initial value for the operation." })
+        ),
+        hidecounts: Schema.optional(
+            Schema.Boolean.annotate({ description: "HideCounts. if true, hides the progress count indicator (xB/yB)." })
+        ),
+        units: Schema.optional(
+            Schema.String.annotate({
+                description: 'Units is the unit to print for progress. It defaults to "bytes" if empty.',
+            })
+        ),
+    },
+    {
+        identifier: "JsonmessageJSONProgress",
+        title: "jsonmessage.JSONProgress",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/pkg/jsonmessage#JSONProgress",
+        description: "JSONProgress describes a progress message in a JSON stream.",
+    }
+) {}
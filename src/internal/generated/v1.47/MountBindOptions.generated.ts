@@ -0,0 +1,30 @@
+import * as Schema from "effect/Schema";
+
+import * as MountPropagation from "./MountPropagation.generated.ts";
+
+export class MountBindOptions extends Schema.Class<MountBindOptions>("MountBindOptions")(
+    {
+        Propagation: Schema.optional(MountPropagation.MountPropagation),
+        NonRecursive: Schema.optional(Schema.Boolean),
+        CreateMountpoint: Schema.optional(Schema.Boolean),
+        ReadOnlyNonRecursive: Schema.optional(
+            Schema.Boolean.annotate({
+                description:
+                    "ReadOnlyNonRecursive makes the mount non-recursively read-only, but still leaves the mount recursive\n(unless NonRecursive is set to true in conjunction).",
+                since: "1.44",
+            })
+        ),
+        ReadOnlyForceRecursive: Schema.optional(
+            Schema.Boolean.annotate({
+                description:
+                    "ReadOnlyForceRecursive raises an error if the mount cannot be made recursively read-only.",
+            })
+        ),
+    },
+    {
+        identifier: "MountBindOptions",
+        title: "mount.BindOptions",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/mount#BindOptions",
+        description: 'BindOptions defines options specific to mounts of type "bind".',
+    }
+) {}
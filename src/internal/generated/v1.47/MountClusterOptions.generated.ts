@@ -5,6 +5,8 @@ export class MountClusterOptions extends Schema.Class<MountClusterOptions>("Moun
     {
         identifier: "MountClusterOptions",
         title: "mount.ClusterOptions",
-        documentation: "",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/mount#ClusterOptions",
+        description: "ClusterOptions specifies options for a Cluster volume.",
     }
 ) {}
@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export const MountConsistency = Schema.Literals(["consistent", "cached", "delegated", "default"]).annotate({
+    identifier: "MountConsistency",
+    title: "mount.Consistency",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/mount#Consistency",
+    description: "Consistency represents the consistency requirements of a mount.",
+});
+
+export type MountConsistency = Schema.Schema.Type<typeof MountConsistency>;
+
+export const MountConsistencyConstants = Object.freeze({
+    ConsistencyFull: "consistent",
+    ConsistencyCached: "cached",
+    ConsistencyDelegated: "delegated",
+    ConsistencyDefault: "default",
+} as const);
@@ -8,6 +8,7 @@ export class MountDriver extends Schema.Class<MountDriver>("MountDriver")(
     {
         identifier: "MountDriver",
         title: "mount.Driver",
-        documentation: "",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/mount#Driver",
+        description: "Driver represents a volume driver.",
     }
 ) {}
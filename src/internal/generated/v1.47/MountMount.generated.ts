@@ -0,0 +1,33 @@
+import * as Schema from "effect/Schema";
+
+import * as MountBindOptions from "./MountBindOptions.generated.ts";
+import * as MountClusterOptions from "./MountClusterOptions.generated.ts";
+import * as MountConsistency from "./MountConsistency.generated.ts";
+import * as MountTmpfsOptions from "./MountTmpfsOptions.generated.ts";
+import * as MountType from "./MountType.generated.ts";
+import * as MountVolumeOptions from "./MountVolumeOptions.generated.ts";
+
+export class MountMount extends Schema.Class<MountMount>("MountMount")(
+    {
+        Type: Schema.optional(MountType.MountType),
+        Source: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "Source specifies the name of the mount. Depending on mount type, this\nmay be a volume name or a host path, or even ignored.\nSource is not supported for tmpfs (must be an empty value)",
+            })
+        ),
+        Target: Schema.optional(Schema.String),
+        ReadOnly: Schema.optional(Schema.Boolean.annotate({ description: "attempts recursive read-only if possible" })),
+        Consistency: Schema.optional(MountConsistency.MountConsistency),
+        BindOptions: Schema.optional(Schema.NullOr(MountBindOptions.MountBindOptions)),
+        VolumeOptions: Schema.optional(Schema.NullOr(MountVolumeOptions.MountVolumeOptions)),
+        TmpfsOptions: Schema.optional(Schema.NullOr(MountTmpfsOptions.MountTmpfsOptions)),
+        ClusterOptions: Schema.optional(Schema.NullOr(MountClusterOptions.MountClusterOptions)),
+    },
+    {
+        identifier: "MountMount",
+        title: "mount.Mount",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/mount#Mount",
+        description: "Mount represents a mount (volume).",
+    }
+) {}
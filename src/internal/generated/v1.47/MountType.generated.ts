@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+export const MountType = Schema.Literals(["bind", "volume", "tmpfs", "npipe", "cluster"]).annotate({
+    identifier: "MountType",
+    title: "mount.Type",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/mount#Type",
+    description: "Type represents the type of a mount.",
+});
+
+export type MountType = Schema.Schema.Type<typeof MountType>;
+
+export const MountTypeConstants = Object.freeze({
+    TypeBind: "bind",
+    TypeVolume: "volume",
+    TypeTmpfs: "tmpfs",
+    TypeNamedPipe: "npipe",
+    TypeCluster: "cluster",
+} as const);
@@ -12,6 +12,7 @@ export class MountVolumeOptions extends Schema.Class<MountVolumeOptions>("MountV
     {
         identifier: "MountVolumeOptions",
         title: "mount.VolumeOptions",
-        documentation: "",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/mount#VolumeOptions",
+        description: "VolumeOptions represents the options for a mount of type volume.",
     }
 ) {}
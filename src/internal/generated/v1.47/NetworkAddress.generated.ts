@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class NetworkAddress extends Schema.Class<NetworkAddress>("NetworkAddress")(
+    {
+        Addr: Schema.String,
+        PrefixLen: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+    },
+    {
+        identifier: "NetworkAddress",
+        title: "network.Address",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/network#Address",
+        description: "Address represents an IP address",
+    }
+) {}
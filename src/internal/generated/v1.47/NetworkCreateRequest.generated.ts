@@ -0,0 +1,59 @@
+import * as Effect from "effect/Effect";
+import * as Schema from "effect/Schema";
+
+import * as NetworkConfigReference from "./NetworkConfigReference.generated.ts";
+import * as NetworkIPAM from "./NetworkIPAM.generated.ts";
+
+export class NetworkCreateRequest extends Schema.Class<NetworkCreateRequest>("NetworkCreateRequest")(
+    {
+        Driver: Schema.String.annotate({
+            description: "Driver is the driver-name used to create the network (e.g. `bridge`, `overlay`)",
+        }).pipe(Schema.withConstructorDefault(Effect.sync(() => "bridge"))),
+        Scope: Schema.String.annotate({
+            description:
+                "Scope describes the level at which the network exists (e.g. `swarm` for cluster-wide or `local` for machine level).",
+        }),
+        EnableIPv6: Schema.optional(
+            Schema.NullOr(Schema.Boolean).annotate({ description: "EnableIPv6 represents whether to enable IPv6." })
+        ),
+        IPAM: Schema.NullOr(NetworkIPAM.NetworkIPAM).annotate({
+            description: "IPAM is the network's IP Address Management.",
+        }),
+        Internal: Schema.Boolean.annotate({ description: "Internal represents if the network is used internal only." }),
+        Attachable: Schema.Boolean.annotate({
+            description:
+                "Attachable represents if the global scope is manually attachable by regular containers from workers in swarm mode.",
+        }),
+        Ingress: Schema.Boolean.annotate({
+            description: "Ingress indicates the network is providing the routing-mesh for the swarm cluster.",
+        }),
+        ConfigOnly: Schema.Boolean.annotate({
+            description:
+                "ConfigOnly creates a config-only network. Config-only networks are place-holder networks for network configurations to be used by other networks. ConfigOnly networks cannot be used directly to run containers or services.",
+        }).pipe(Schema.withConstructorDefault(Effect.sync(() => false))),
+        ConfigFrom: Schema.NullOr(NetworkConfigReference.NetworkConfigReference).annotate({
+            description:
+                "ConfigFrom specifies the source which will provide the configuration for this network. The specified network must be a config-only network; see [CreateOptions.ConfigOnly].",
+        }),
+        Options: Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+            description: "Options specifies the network-specific options to use for when creating the network.",
+        }),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+            description: "Labels holds metadata specific to the network being created.",
+        }),
+        Name: Schema.String.annotate({ description: "Name is the requested name of the network." }),
+        CheckDuplicate: Schema.optional(
+            Schema.NullOr(Schema.Boolean).annotate({
+                description:
+                    "Deprecated: CheckDuplicate is deprecated since API v1.44, but it defaults to true when sent by the client\npackage to older daemons.",
+            })
+        ),
+    },
+    {
+        identifier: "NetworkCreateRequest",
+        title: "network.CreateRequest",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/network#CreateRequest",
+        description: "CreateRequest is the request message sent to the server for network create call.",
+    }
+) {}
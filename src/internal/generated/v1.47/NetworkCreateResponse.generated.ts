@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+export class NetworkCreateResponse extends Schema.Class<NetworkCreateResponse>("NetworkCreateResponse")(
+    {
+        Id: Schema.String.annotate({ description: "The ID of the created network.\nRequired: true" }),
+        Warning: Schema.String.annotate({
+            description: "Warnings encountered when creating the container\nRequired: true",
+        }),
+    },
+    {
+        identifier: "NetworkCreateResponse",
+        title: "network.CreateResponse",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/network#CreateResponse",
+        description:
+            "CreateResponse NetworkCreateResponse\n\nOK response to NetworkCreate operation\nswagger:model CreateResponse",
+    }
+) {}
@@ -1,22 +1,23 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 import * as NetworkEndpointIPAMConfig from "./NetworkEndpointIPAMConfig.generated.ts";
 
 export class NetworkEndpointSettings extends Schema.Class<NetworkEndpointSettings>("NetworkEndpointSettings")(
     {
-        IPAMConfig: Schema.NullOr(NetworkEndpointIPAMConfig.NetworkEndpointIPAMConfig),
+        IPAMConfig: Schema.NullOr(NetworkEndpointIPAMConfig.NetworkEndpointIPAMConfig).annotate({
+            description: "Configurations",
+        }),
         Links: Schema.NullOr(Schema.Array(Schema.String)),
-        Aliases: Schema.NullOr(Schema.Array(Schema.String)),
-        MacAddress: Schema.String,
+        Aliases: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description: "Aliases holds the list of extra, user-specified DNS names for this endpoint.",
+        }),
+        MacAddress: Schema.String.annotate({
+            description:
+                "MacAddress may be used to specify a MAC address when the container is created.\nOnce the container is running, it becomes operational data (it may contain a\ngenerated address).",
+        }),
         DriverOpts: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
-        // optional for docker.io/library/docker:26-dind-rootless and 27 (added in docker v28)
-        GwPriority: Schema.optional(
-            MobyNumber.BigIntFromWireString.check(
-                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
-            )
-        ),
-        NetworkID: Schema.String,
+        NetworkID: Schema.String.annotate({ description: "Operational data" }),
         EndpointID: Schema.String,
         Gateway: Schema.String,
         IPAddress: Schema.String,
@@ -28,12 +29,16 @@ export class NetworkEndpointSettings extends Schema.Class<NetworkEndpointSetting
         GlobalIPv6PrefixLen: MobyNumber.BigIntFromWireString.check(
             Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
         ),
-        DNSNames: Schema.NullOr(Schema.Array(Schema.String)),
+        DNSNames: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description:
+                "DNSNames holds all the (non fully qualified) DNS names associated to this endpoint. First entry is used to\ngenerate PTR records.",
+        }),
     },
     {
         identifier: "NetworkEndpointSettings",
         title: "network.EndpointSettings",
         documentation:
-            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/network#EndpointSettings",
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/network#EndpointSettings",
+        description: "EndpointSettings stores the network endpoint details",
     }
 ) {}
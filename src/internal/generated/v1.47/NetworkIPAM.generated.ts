@@ -0,0 +1,19 @@
+import * as Schema from "effect/Schema";
+
+import * as NetworkIPAMConfig from "./NetworkIPAMConfig.generated.ts";
+
+export class NetworkIPAM extends Schema.Class<NetworkIPAM>("NetworkIPAM")(
+    {
+        Driver: Schema.String,
+        Options: Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+            description: "Per network IPAM driver options",
+        }),
+        Config: Schema.NullOr(Schema.Array(Schema.NullOr(NetworkIPAMConfig.NetworkIPAMConfig))),
+    },
+    {
+        identifier: "NetworkIPAM",
+        title: "network.IPAM",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/network#IPAM",
+        description: "IPAM represents IP Address Management",
+    }
+) {}
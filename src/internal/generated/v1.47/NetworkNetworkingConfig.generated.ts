@@ -0,0 +1,22 @@
+import * as Effect from "effect/Effect";
+import * as Schema from "effect/Schema";
+
+import * as NetworkEndpointSettings from "./NetworkEndpointSettings.generated.ts";
+
+export class NetworkNetworkingConfig extends Schema.Class<NetworkNetworkingConfig>("NetworkNetworkingConfig")(
+    {
+        EndpointsConfig: Schema.NullOr(
+            Schema.Record(Schema.String, Schema.NullOr(NetworkEndpointSettings.NetworkEndpointSettings))
+        )
+            .annotate({ description: "Endpoint configs for each connecting network" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+    },
+    {
+        identifier: "NetworkNetworkingConfig",
+        title: "network.NetworkingConfig",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/network#NetworkingConfig",
+        description:
+            "NetworkingConfig represents the container's networking configuration for each of its interfaces\nCarries the networking configs specified in the `docker run` and `docker network connect` commands",
+    }
+) {}
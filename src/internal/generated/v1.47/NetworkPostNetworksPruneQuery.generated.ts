@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const NetworkPostNetworksPruneQuery = Schema.Struct({
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+}).annotate({
+    identifier: "NetworkPostNetworksPruneQuery",
+    title: "network.postNetworksPrune",
+    description: "Query parameters of POST /networks/prune",
+});
+
+export type NetworkPostNetworksPruneQuery = Schema.Schema.Type<typeof NetworkPostNetworksPruneQuery>;
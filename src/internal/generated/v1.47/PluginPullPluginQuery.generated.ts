@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export const PluginPullPluginQuery = Schema.Struct({
+    remote: Schema.optional(Schema.String),
+    name: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "PluginPullPluginQuery",
+    title: "plugin.pullPlugin",
+    description: "Query parameters of POST /plugins/pull",
+});
+
+export type PluginPullPluginQuery = Schema.Schema.Type<typeof PluginPullPluginQuery>;
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const PluginRemovePluginQuery = Schema.Struct({
+    force: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "PluginRemovePluginQuery",
+    title: "plugin.removePlugin",
+    description: "Query parameters of DELETE /plugins/{name:.*}",
+});
+
+export type PluginRemovePluginQuery = Schema.Schema.Type<typeof PluginRemovePluginQuery>;
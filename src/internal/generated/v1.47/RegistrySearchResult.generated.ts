@@ -0,0 +1,27 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class RegistrySearchResult extends Schema.Class<RegistrySearchResult>("RegistrySearchResult")(
+    {
+        star_count: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({ description: "StarCount indicates the number of stars this repository has" }),
+        is_official: Schema.Boolean.annotate({
+            description: "IsOfficial is true if the result is from an official repository.",
+        }),
+        name: Schema.String.annotate({ description: "Name is the name of the repository" }),
+        is_automated: Schema.Boolean.annotate({
+            description:
+                'IsAutomated indicates whether the result is automated.\n\nDeprecated: the "is_automated" field is deprecated and will always be "false".',
+        }),
+        description: Schema.String.annotate({ description: "Description is a textual description of the repository" }),
+    },
+    {
+        identifier: "RegistrySearchResult",
+        title: "registry.SearchResult",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/registry#SearchResult",
+        description: "SearchResult describes a search result returned from a registry",
+    }
+) {}
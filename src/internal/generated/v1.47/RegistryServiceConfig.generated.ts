@@ -6,10 +6,10 @@ import * as RegistryIndexInfo from "./RegistryIndexInfo.generated.ts";
 
 export class RegistryServiceConfig extends Schema.Class<RegistryServiceConfig>("RegistryServiceConfig")(
     {
-        AllowNondistributableArtifactsCIDRs: Schema.optional(
-            Schema.NullOr(Schema.Array(Schema.NullOr(EffectSchemas.Internet.CidrBlockFromString)))
+        AllowNondistributableArtifactsCIDRs: Schema.NullOr(
+            Schema.Array(Schema.NullOr(EffectSchemas.Internet.CidrBlockFromString))
         ),
-        AllowNondistributableArtifactsHostnames: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
+        AllowNondistributableArtifactsHostnames: Schema.NullOr(Schema.Array(Schema.String)),
         InsecureRegistryCIDRs: Schema.NullOr(Schema.Array(Schema.NullOr(EffectSchemas.Internet.CidrBlockFromString))),
         IndexConfigs: Schema.NullOr(Schema.Record(Schema.String, Schema.NullOr(RegistryIndexInfo.RegistryIndexInfo))),
         Mirrors: Schema.NullOr(Schema.Array(Schema.String)),
@@ -18,6 +18,7 @@ export class RegistryServiceConfig extends Schema.Class<RegistryServiceConfig>("
         identifier: "RegistryServiceConfig",
         title: "registry.ServiceConfig",
         documentation:
-            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/registry#ServiceConfig",
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/registry#ServiceConfig",
+        description: "ServiceConfig stores daemon registry services configuration.",
     }
 ) {}
@@ -15,6 +15,9 @@ export class RuntimePluginSpec extends Schema.Class<RuntimePluginSpec>("RuntimeP
     {
         identifier: "RuntimePluginSpec",
         title: "runtime.PluginSpec",
-        documentation: "",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm/runtime#PluginSpec",
+        description:
+            "PluginSpec defines the base payload which clients can specify for creating\na service with the plugin runtime.",
     }
 ) {}
@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmAnnotations extends Schema.Class<SwarmAnnotations>("SwarmAnnotations")(
+    {
+        Name: Schema.optional(Schema.String),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+    },
+    {
+        identifier: "SwarmAnnotations",
+        title: "swarm.Annotations",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#Annotations",
+        description: "Annotations represents how to describe an object.",
+    }
+) {}
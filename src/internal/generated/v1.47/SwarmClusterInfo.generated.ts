@@ -0,0 +1,34 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as SwarmSpec from "./SwarmSpec.generated.ts";
+import * as SwarmTLSInfo from "./SwarmTLSInfo.generated.ts";
+import * as SwarmVersion from "./SwarmVersion.generated.ts";
+
+export class SwarmClusterInfo extends Schema.Class<SwarmClusterInfo>("SwarmClusterInfo")(
+    {
+        ID: Schema.String,
+        Version: Schema.NullOr(SwarmVersion.SwarmVersion),
+        CreatedAt: Schema.NullOr(Schema.DateFromString),
+        UpdatedAt: Schema.NullOr(Schema.DateFromString),
+        Spec: Schema.NullOr(SwarmSpec.SwarmSpec),
+        TLSInfo: Schema.NullOr(SwarmTLSInfo.SwarmTLSInfo),
+        RootRotationInProgress: Schema.Boolean,
+        DefaultAddrPool: Schema.NullOr(Schema.Array(Schema.String)),
+        SubnetSize: MobyNumber.NumberFromWireString.check(
+            Schema.isInt(),
+            Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
+        ),
+        DataPathPort: MobyNumber.NumberFromWireString.check(
+            Schema.isInt(),
+            Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
+        ),
+    },
+    {
+        identifier: "SwarmClusterInfo",
+        title: "swarm.ClusterInfo",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#ClusterInfo",
+        description:
+            'ClusterInfo represents info about the cluster for outputting in "info"\nit contains the same information as "Swarm", but without the JoinTokens',
+    }
+) {}
@@ -0,0 +1,23 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmDriver from "./SwarmDriver.generated.ts";
+
+export class SwarmConfigSpec extends Schema.Class<SwarmConfigSpec>("SwarmConfigSpec")(
+    {
+        Name: Schema.optional(Schema.String),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+        Data: Schema.optional(Schema.NullOr(Schema.Uint8ArrayFromBase64)),
+        Templating: Schema.optional(
+            Schema.NullOr(SwarmDriver.SwarmDriver).annotate({
+                description:
+                    "Templating controls whether and how to evaluate the config payload as\na template. If it is not set, no templating is used.",
+            })
+        ),
+    },
+    {
+        identifier: "SwarmConfigSpec",
+        title: "swarm.ConfigSpec",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#ConfigSpec",
+        description: "ConfigSpec represents a config specification from a config in swarm",
+    }
+) {}
@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmDriver extends Schema.Class<SwarmDriver>("SwarmDriver")(
+    {
+        Name: Schema.optional(Schema.String),
+        Options: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.String))),
+    },
+    {
+        identifier: "SwarmDriver",
+        title: "swarm.Driver",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#Driver",
+        description: "Driver represents a driver (network, logging, secrets backend).",
+    }
+) {}
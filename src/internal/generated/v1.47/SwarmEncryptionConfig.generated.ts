@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmEncryptionConfig extends Schema.Class<SwarmEncryptionConfig>("SwarmEncryptionConfig")(
+    {
+        AutoLockManagers: Schema.Boolean.annotate({
+            description:
+                "AutoLockManagers specifies whether or not managers TLS keys and raft data\nshould be encrypted at rest in such a way that they must be unlocked\nbefore the manager node starts up again.",
+        }),
+    },
+    {
+        identifier: "SwarmEncryptionConfig",
+        title: "swarm.EncryptionConfig",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#EncryptionConfig",
+        description: "EncryptionConfig controls at-rest encryption of data and keys.",
+    }
+) {}
@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmPortConfig from "./SwarmPortConfig.generated.ts";
+import * as SwarmResolutionMode from "./SwarmResolutionMode.generated.ts";
+
+export class SwarmEndpointSpec extends Schema.Class<SwarmEndpointSpec>("SwarmEndpointSpec")(
+    {
+        Mode: Schema.optional(SwarmResolutionMode.SwarmResolutionMode),
+        Ports: Schema.optional(Schema.NullOr(Schema.Array(Schema.NullOr(SwarmPortConfig.SwarmPortConfig)))),
+    },
+    {
+        identifier: "SwarmEndpointSpec",
+        title: "swarm.EndpointSpec",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#EndpointSpec",
+        description: "EndpointSpec represents the spec of an endpoint.",
+    }
+) {}
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmGetConfigsQuery = Schema.Struct({
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+}).annotate({
+    identifier: "SwarmGetConfigsQuery",
+    title: "swarm.getConfigs",
+    description: "Query parameters of GET /configs",
+});
+
+export type SwarmGetConfigsQuery = Schema.Schema.Type<typeof SwarmGetConfigsQuery>;
@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmGetServicesQuery = Schema.Struct({
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+    status: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "SwarmGetServicesQuery",
+    title: "swarm.getServices",
+    description: "Query parameters of GET /services",
+});
+
+export type SwarmGetServicesQuery = Schema.Schema.Type<typeof SwarmGetServicesQuery>;
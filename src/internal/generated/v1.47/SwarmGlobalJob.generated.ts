@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmGlobalJob extends Schema.Class<SwarmGlobalJob>("SwarmGlobalJob")(
+    {},
+    {
+        identifier: "SwarmGlobalJob",
+        title: "swarm.GlobalJob",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#GlobalJob",
+        description:
+            "GlobalJob is the type of a Service which executes a Task on every Node\nmatching the Service's placement constraints. These tasks run to completion\nand then exit.\n\nThis type is deliberately empty.",
+    }
+) {}
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmGlobalService extends Schema.Class<SwarmGlobalService>("SwarmGlobalService")(
+    {},
+    {
+        identifier: "SwarmGlobalService",
+        title: "swarm.GlobalService",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#GlobalService",
+        description: "GlobalService is a kind of ServiceMode.",
+    }
+) {}
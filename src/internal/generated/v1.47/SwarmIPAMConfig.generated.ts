@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmIPAMConfig extends Schema.Class<SwarmIPAMConfig>("SwarmIPAMConfig")(
+    {
+        Subnet: Schema.optional(Schema.String),
+        Range: Schema.optional(Schema.String),
+        Gateway: Schema.optional(Schema.String),
+    },
+    {
+        identifier: "SwarmIPAMConfig",
+        title: "swarm.IPAMConfig",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#IPAMConfig",
+        description: "IPAMConfig represents ipam configuration.",
+    }
+) {}
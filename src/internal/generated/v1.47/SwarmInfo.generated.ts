@@ -0,0 +1,36 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
+import * as SwarmClusterInfo from "./SwarmClusterInfo.generated.ts";
+import * as SwarmLocalNodeState from "./SwarmLocalNodeState.generated.ts";
+import * as SwarmPeer from "./SwarmPeer.generated.ts";
+
+export class SwarmInfo extends Schema.Class<SwarmInfo>("SwarmInfo")(
+    {
+        NodeID: MobyIdentifiers.NodeIdentifier,
+        NodeAddr: Schema.String,
+        LocalNodeState: SwarmLocalNodeState.SwarmLocalNodeState,
+        ControlAvailable: Schema.Boolean,
+        Error: Schema.String,
+        RemoteManagers: Schema.NullOr(Schema.Array(Schema.NullOr(SwarmPeer.SwarmPeer))),
+        Nodes: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            )
+        ),
+        Managers: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            )
+        ),
+        Cluster: Schema.optional(Schema.NullOr(SwarmClusterInfo.SwarmClusterInfo)),
+        Warnings: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
+    },
+    {
+        identifier: "SwarmInfo",
+        title: "swarm.Info",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#Info",
+        description: "Info represents generic information about swarm.",
+    }
+) {}
@@ -0,0 +1,37 @@
+import * as Effect from "effect/Effect";
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as SwarmNodeAvailability from "./SwarmNodeAvailability.generated.ts";
+import * as SwarmSpec from "./SwarmSpec.generated.ts";
+
+export class SwarmInitRequest extends Schema.Class<SwarmInitRequest>("SwarmInitRequest")(
+    {
+        ListenAddr: Schema.String.pipe(Schema.withConstructorDefault(Effect.sync(() => "0.0.0.0:2377"))),
+        AdvertiseAddr: Schema.String.pipe(Schema.withConstructorDefault(Effect.sync(() => ""))),
+        DataPathAddr: Schema.String.pipe(Schema.withConstructorDefault(Effect.sync(() => ""))),
+        DataPathPort: MobyNumber.NumberFromWireString.check(
+            Schema.isInt(),
+            Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => 0))),
+        ForceNewCluster: Schema.Boolean.pipe(Schema.withConstructorDefault(Effect.sync(() => false))),
+        Spec: Schema.NullOr(SwarmSpec.SwarmSpec).pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
+        AutoLockManagers: Schema.Boolean.pipe(Schema.withConstructorDefault(Effect.sync(() => false))),
+        Availability: SwarmNodeAvailability.SwarmNodeAvailability.pipe(
+            Schema.withConstructorDefault(Effect.sync(() => "active"))
+        ),
+        DefaultAddrPool: Schema.NullOr(Schema.Array(Schema.String)).pipe(
+            Schema.withConstructorDefault(Effect.sync(() => []))
+        ),
+        SubnetSize: MobyNumber.NumberFromWireString.check(
+            Schema.isInt(),
+            Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
+        ).pipe(Schema.withConstructorDefault(Effect.sync(() => 24))),
+    },
+    {
+        identifier: "SwarmInitRequest",
+        title: "swarm.InitRequest",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#InitRequest",
+        description: "InitRequest is the request used to init a swarm.",
+    }
+) {}
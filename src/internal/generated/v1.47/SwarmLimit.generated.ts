@@ -1,30 +1,29 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
-export class JsonmessageJSONProgress extends Schema.Class<JsonmessageJSONProgress>("JsonmessageJSONProgress")(
+export class SwarmLimit extends Schema.Class<SwarmLimit>("SwarmLimit")(
     {
-        current: Schema.optional(
+        NanoCPUs: Schema.optional(
             MobyNumber.BigIntFromWireString.check(
                 Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
             )
         ),
-        total: Schema.optional(
+        MemoryBytes: Schema.optional(
             MobyNumber.BigIntFromWireString.check(
                 Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
             )
         ),
-        start: Schema.optional(
+        Pids: Schema.optional(
             MobyNumber.BigIntFromWireString.check(
                 Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
             )
         ),
-        hidecounts: Schema.optional(Schema.Boolean),
-        units: Schema.optional(Schema.String),
     },
     {
-        identifier: "JsonmessageJSONProgress",
-        title: "jsonmessage.JSONProgress",
-        documentation: "",
+        identifier: "SwarmLimit",
+        title: "swarm.Limit",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#Limit",
+        description: "Limit describes limits on resources which can be requested by a task.",
     }
 ) {}
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmNamedGenericResource extends Schema.Class<SwarmNamedGenericResource>("SwarmNamedGenericResource")(
+    {
+        Kind: Schema.optional(Schema.String),
+        Value: Schema.optional(Schema.String),
+    },
+    {
+        identifier: "SwarmNamedGenericResource",
+        title: "swarm.NamedGenericResource",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#NamedGenericResource",
+        description:
+            'NamedGenericResource represents a "user defined" resource which is defined\nas a string.\n"Kind" is used to describe the Kind of a resource (e.g: "GPU", "FPGA", "SSD", ...)\nValue is used to identify the resource (GPU="UUID-1", FPGA="/dev/sdb5", ...)',
+    }
+) {}
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+
+export class SwarmNetworkAttachmentSpec extends Schema.Class<SwarmNetworkAttachmentSpec>("SwarmNetworkAttachmentSpec")(
+    {
+        ContainerID: MobyIdentifiers.ContainerIdentifier,
+    },
+    {
+        identifier: "SwarmNetworkAttachmentSpec",
+        title: "swarm.NetworkAttachmentSpec",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#NetworkAttachmentSpec",
+        description: "NetworkAttachmentSpec represents the runtime spec type for network\nattachment tasks",
+    }
+) {}
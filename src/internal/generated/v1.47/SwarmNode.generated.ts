@@ -0,0 +1,39 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as SwarmManagerStatus from "./SwarmManagerStatus.generated.ts";
+import * as SwarmNodeDescription from "./SwarmNodeDescription.generated.ts";
+import * as SwarmNodeSpec from "./SwarmNodeSpec.generated.ts";
+import * as SwarmNodeStatus from "./SwarmNodeStatus.generated.ts";
+import * as SwarmVersion from "./SwarmVersion.generated.ts";
+
+export class SwarmNode extends Schema.Class<SwarmNode>("SwarmNode")(
+    {
+        ID: MobyIdentifiers.NodeIdentifier,
+        Version: Schema.NullOr(SwarmVersion.SwarmVersion),
+        CreatedAt: Schema.NullOr(Schema.DateFromString),
+        UpdatedAt: Schema.NullOr(Schema.DateFromString),
+        Spec: Schema.NullOr(SwarmNodeSpec.SwarmNodeSpec).annotate({
+            description:
+                "Spec defines the desired state of the node as specified by the user.\nThe system will honor this and will *never* modify it.",
+        }),
+        Description: Schema.NullOr(SwarmNodeDescription.SwarmNodeDescription).annotate({
+            description: "Description encapsulates the properties of the Node as reported by the\nagent.",
+        }),
+        Status: Schema.NullOr(SwarmNodeStatus.SwarmNodeStatus).annotate({
+            description: "Status provides the current status of the node, as seen by the manager.",
+        }),
+        ManagerStatus: Schema.optional(
+            Schema.NullOr(SwarmManagerStatus.SwarmManagerStatus).annotate({
+                description:
+                    "ManagerStatus provides the current status of the node's manager\ncomponent, if the node is a manager.",
+            })
+        ),
+    },
+    {
+        identifier: "SwarmNode",
+        title: "swarm.Node",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#Node",
+        description: "Node represents a node.",
+    }
+) {}
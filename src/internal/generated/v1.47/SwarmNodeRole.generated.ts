@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmNodeRole = Schema.Literals(["worker", "manager"]).annotate({
+    identifier: "SwarmNodeRole",
+    title: "swarm.NodeRole",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#NodeRole",
+    description: "NodeRole represents the role of a node.",
+});
+
+export type SwarmNodeRole = Schema.Schema.Type<typeof SwarmNodeRole>;
+
+export const SwarmNodeRoleConstants = Object.freeze({
+    NodeRoleWorker: "worker",
+    NodeRoleManager: "manager",
+} as const);
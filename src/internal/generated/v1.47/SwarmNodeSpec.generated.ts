@@ -0,0 +1,19 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmNodeAvailability from "./SwarmNodeAvailability.generated.ts";
+import * as SwarmNodeRole from "./SwarmNodeRole.generated.ts";
+
+export class SwarmNodeSpec extends Schema.Class<SwarmNodeSpec>("SwarmNodeSpec")(
+    {
+        Name: Schema.optional(Schema.String),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+        Role: Schema.optional(SwarmNodeRole.SwarmNodeRole),
+        Availability: Schema.optional(SwarmNodeAvailability.SwarmNodeAvailability),
+    },
+    {
+        identifier: "SwarmNodeSpec",
+        title: "swarm.NodeSpec",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#NodeSpec",
+        description: "NodeSpec represents the spec of a node.",
+    }
+) {}
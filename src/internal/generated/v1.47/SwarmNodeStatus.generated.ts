@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmNodeState from "./SwarmNodeState.generated.ts";
+
+export class SwarmNodeStatus extends Schema.Class<SwarmNodeStatus>("SwarmNodeStatus")(
+    {
+        State: Schema.optional(SwarmNodeState.SwarmNodeState),
+        Message: Schema.optional(Schema.String),
+        Addr: Schema.optional(Schema.String),
+    },
+    {
+        identifier: "SwarmNodeStatus",
+        title: "swarm.NodeStatus",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#NodeStatus",
+        description: "NodeStatus represents the status of a node.",
+    }
+) {}
@@ -0,0 +1,25 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class SwarmOrchestrationConfig extends Schema.Class<SwarmOrchestrationConfig>("SwarmOrchestrationConfig")(
+    {
+        TaskHistoryRetentionLimit: Schema.optional(
+            Schema.NullOr(
+                MobyNumber.BigIntFromWireString.check(
+                    Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+                )
+            ).annotate({
+                description:
+                    "TaskHistoryRetentionLimit is the number of historic tasks to keep per instance or\nnode. If negative, never remove completed or failed tasks.",
+            })
+        ),
+    },
+    {
+        identifier: "SwarmOrchestrationConfig",
+        title: "swarm.OrchestrationConfig",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#OrchestrationConfig",
+        description: "OrchestrationConfig represents orchestration configuration.",
+    }
+) {}
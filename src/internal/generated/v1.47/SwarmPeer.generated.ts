@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+
+export class SwarmPeer extends Schema.Class<SwarmPeer>("SwarmPeer")(
+    {
+        NodeID: MobyIdentifiers.NodeIdentifier,
+        Addr: Schema.String,
+    },
+    {
+        identifier: "SwarmPeer",
+        title: "swarm.Peer",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#Peer",
+        description: "Peer represents a peer.",
+    }
+) {}
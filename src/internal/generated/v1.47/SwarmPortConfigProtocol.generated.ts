@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmPortConfigProtocol = Schema.Literals(["tcp", "udp", "sctp"]).annotate({
+    identifier: "SwarmPortConfigProtocol",
+    title: "swarm.PortConfigProtocol",
+    documentation:
+        "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#PortConfigProtocol",
+    description: "PortConfigProtocol represents the protocol of a port.",
+});
+
+export type SwarmPortConfigProtocol = Schema.Schema.Type<typeof SwarmPortConfigProtocol>;
+
+export const SwarmPortConfigProtocolConstants = Object.freeze({
+    PortConfigProtocolTCP: "tcp",
+    PortConfigProtocolUDP: "udp",
+    PortConfigProtocolSCTP: "sctp",
+} as const);
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmPortConfigPublishMode = Schema.Literals(["ingress", "host"]).annotate({
+    identifier: "SwarmPortConfigPublishMode",
+    title: "swarm.PortConfigPublishMode",
+    documentation:
+        "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#PortConfigPublishMode",
+    description: "PortConfigPublishMode represents the mode in which the port is to\nbe published.",
+});
+
+export type SwarmPortConfigPublishMode = Schema.Schema.Type<typeof SwarmPortConfigPublishMode>;
+
+export const SwarmPortConfigPublishModeConstants = Object.freeze({
+    PortConfigPublishModeIngress: "ingress",
+    PortConfigPublishModeHost: "host",
+} as const);
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmPortConfig from "./SwarmPortConfig.generated.ts";
+
+export class SwarmPortStatus extends Schema.Class<SwarmPortStatus>("SwarmPortStatus")(
+    {
+        Ports: Schema.optional(Schema.NullOr(Schema.Array(Schema.NullOr(SwarmPortConfig.SwarmPortConfig)))),
+    },
+    {
+        identifier: "SwarmPortStatus",
+        title: "swarm.PortStatus",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#PortStatus",
+        description:
+            "PortStatus represents the port status of a task's host ports whose\nservice has published host ports",
+    }
+) {}
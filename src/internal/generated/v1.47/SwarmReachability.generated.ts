@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmReachability = Schema.Literals(["unknown", "unreachable", "reachable"]).annotate({
+    identifier: "SwarmReachability",
+    title: "swarm.Reachability",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#Reachability",
+    description: "Reachability represents the reachability of a node.",
+});
+
+export type SwarmReachability = Schema.Schema.Type<typeof SwarmReachability>;
+
+export const SwarmReachabilityConstants = Object.freeze({
+    ReachabilityUnknown: "unknown",
+    ReachabilityUnreachable: "unreachable",
+    ReachabilityReachable: "reachable",
+} as const);
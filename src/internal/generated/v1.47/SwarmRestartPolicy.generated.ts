@@ -0,0 +1,35 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as SwarmRestartPolicyCondition from "./SwarmRestartPolicyCondition.generated.ts";
+
+export class SwarmRestartPolicy extends Schema.Class<SwarmRestartPolicy>("SwarmRestartPolicy")(
+    {
+        Condition: Schema.optional(SwarmRestartPolicyCondition.SwarmRestartPolicyCondition),
+        Delay: Schema.optional(
+            Schema.NullOr(
+                MobyNumber.BigIntFromWireString.check(
+                    Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+                )
+            )
+        ),
+        MaxAttempts: Schema.optional(
+            Schema.NullOr(
+                MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))
+            )
+        ),
+        Window: Schema.optional(
+            Schema.NullOr(
+                MobyNumber.BigIntFromWireString.check(
+                    Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+                )
+            )
+        ),
+    },
+    {
+        identifier: "SwarmRestartPolicy",
+        title: "swarm.RestartPolicy",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#RestartPolicy",
+        description: "RestartPolicy represents the restart policy.",
+    }
+) {}
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmRuntimeType = Schema.Literals(["container", "plugin", "attachment"]).annotate({
+    identifier: "SwarmRuntimeType",
+    title: "swarm.RuntimeType",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#RuntimeType",
+    description: "RuntimeType is the type of runtime used for the TaskSpec",
+});
+
+export type SwarmRuntimeType = Schema.Schema.Type<typeof SwarmRuntimeType>;
+
+export const SwarmRuntimeTypeConstants = Object.freeze({
+    RuntimeContainer: "container",
+    RuntimePlugin: "plugin",
+    RuntimeNetworkAttachment: "attachment",
+} as const);
@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmSELinuxContext extends Schema.Class<SwarmSELinuxContext>("SwarmSELinuxContext")(
+    {
+        Disable: Schema.Boolean,
+        User: Schema.String,
+        Role: Schema.String,
+        Type: Schema.String,
+        Level: Schema.String,
+    },
+    {
+        identifier: "SwarmSELinuxContext",
+        title: "swarm.SELinuxContext",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#SELinuxContext",
+        description: "SELinuxContext contains the SELinux labels of the container.",
+    }
+) {}
@@ -0,0 +1,40 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as SwarmEndpoint from "./SwarmEndpoint.generated.ts";
+import * as SwarmJobStatus from "./SwarmJobStatus.generated.ts";
+import * as SwarmServiceSpec from "./SwarmServiceSpec.generated.ts";
+import * as SwarmServiceStatus from "./SwarmServiceStatus.generated.ts";
+import * as SwarmUpdateStatus from "./SwarmUpdateStatus.generated.ts";
+import * as SwarmVersion from "./SwarmVersion.generated.ts";
+
+export class SwarmService extends Schema.Class<SwarmService>("SwarmService")(
+    {
+        ID: MobyIdentifiers.ServiceIdentifier,
+        Version: Schema.NullOr(SwarmVersion.SwarmVersion),
+        CreatedAt: Schema.NullOr(Schema.DateFromString),
+        UpdatedAt: Schema.NullOr(Schema.DateFromString),
+        Spec: Schema.NullOr(SwarmServiceSpec.SwarmServiceSpec),
+        PreviousSpec: Schema.optional(Schema.NullOr(SwarmServiceSpec.SwarmServiceSpec)),
+        Endpoint: Schema.NullOr(SwarmEndpoint.SwarmEndpoint),
+        UpdateStatus: Schema.optional(Schema.NullOr(SwarmUpdateStatus.SwarmUpdateStatus)),
+        ServiceStatus: Schema.optional(
+            Schema.NullOr(SwarmServiceStatus.SwarmServiceStatus).annotate({
+                description:
+                    "ServiceStatus is an optional, extra field indicating the number of\ndesired and running tasks. It is provided primarily as a shortcut to\ncalculating these values client-side, which otherwise would require\nlisting all tasks for a service, an operation that could be\ncomputation and network expensive.",
+            })
+        ),
+        JobStatus: Schema.optional(
+            Schema.NullOr(SwarmJobStatus.SwarmJobStatus).annotate({
+                description:
+                    "JobStatus is the status of a Service which is in one of ReplicatedJob or\nGlobalJob modes. It is absent on Replicated and Global services.",
+            })
+        ),
+    },
+    {
+        identifier: "SwarmService",
+        title: "swarm.Service",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#Service",
+        description: "Service represents a service.",
+    }
+) {}
@@ -0,0 +1,19 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmServiceCreateResponse extends Schema.Class<SwarmServiceCreateResponse>("SwarmServiceCreateResponse")(
+    {
+        ID: Schema.optional(Schema.String.annotate({ description: "The ID of the created service." })),
+        Warnings: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description:
+                'Optional warning message.\n\nFIXME(thaJeztah): this should have "omitempty" in the generated type.',
+        }),
+    },
+    {
+        identifier: "SwarmServiceCreateResponse",
+        title: "swarm.ServiceCreateResponse",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#ServiceCreateResponse",
+        description:
+            "ServiceCreateResponse contains the information returned to a client on the\ncreation of a new service.\n\nswagger:model ServiceCreateResponse",
+    }
+) {}
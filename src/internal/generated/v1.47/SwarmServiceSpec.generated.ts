@@ -0,0 +1,37 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmEndpointSpec from "./SwarmEndpointSpec.generated.ts";
+import * as SwarmNetworkAttachmentConfig from "./SwarmNetworkAttachmentConfig.generated.ts";
+import * as SwarmServiceMode from "./SwarmServiceMode.generated.ts";
+import * as SwarmTaskSpec from "./SwarmTaskSpec.generated.ts";
+import * as SwarmUpdateConfig from "./SwarmUpdateConfig.generated.ts";
+
+export class SwarmServiceSpec extends Schema.Class<SwarmServiceSpec>("SwarmServiceSpec")(
+    {
+        Name: Schema.optional(Schema.String),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+        TaskTemplate: Schema.NullOr(SwarmTaskSpec.SwarmTaskSpec).annotate({
+            description:
+                "TaskTemplate defines how the service should construct new tasks when\norchestrating this service.",
+        }),
+        Mode: Schema.NullOr(SwarmServiceMode.SwarmServiceMode),
+        UpdateConfig: Schema.optional(Schema.NullOr(SwarmUpdateConfig.SwarmUpdateConfig)),
+        RollbackConfig: Schema.optional(Schema.NullOr(SwarmUpdateConfig.SwarmUpdateConfig)),
+        Networks: Schema.optional(
+            Schema.NullOr(
+                Schema.Array(Schema.NullOr(SwarmNetworkAttachmentConfig.SwarmNetworkAttachmentConfig))
+            ).annotate({
+                deprecatedIn: "1.44",
+                description:
+                    "Networks specifies which networks the service should attach to.\n\nDeprecated: This field is deprecated since v1.44. The Networks field in TaskSpec should be used instead.",
+            })
+        ),
+        EndpointSpec: Schema.optional(Schema.NullOr(SwarmEndpointSpec.SwarmEndpointSpec)),
+    },
+    {
+        identifier: "SwarmServiceSpec",
+        title: "swarm.ServiceSpec",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#ServiceSpec",
+        description: "ServiceSpec represents the spec of a service.",
+    }
+) {}
@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmServiceUpdateResponse extends Schema.Class<SwarmServiceUpdateResponse>("SwarmServiceUpdateResponse")(
+    {
+        Warnings: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "Optional warning messages" }),
+    },
+    {
+        identifier: "SwarmServiceUpdateResponse",
+        title: "swarm.ServiceUpdateResponse",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#ServiceUpdateResponse",
+        description: "ServiceUpdateResponse service update response\nswagger:model ServiceUpdateResponse",
+    }
+) {}
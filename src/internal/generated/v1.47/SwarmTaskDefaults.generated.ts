@@ -0,0 +1,20 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmDriver from "./SwarmDriver.generated.ts";
+
+export class SwarmTaskDefaults extends Schema.Class<SwarmTaskDefaults>("SwarmTaskDefaults")(
+    {
+        LogDriver: Schema.optional(
+            Schema.NullOr(SwarmDriver.SwarmDriver).annotate({
+                description:
+                    "LogDriver selects the log driver to use for tasks created in the\norchestrator if unspecified by a service.\n\nUpdating this value will only have an affect on new tasks. Old tasks\nwill continue use their previously configured log driver until\nrecreated.",
+            })
+        ),
+    },
+    {
+        identifier: "SwarmTaskDefaults",
+        title: "swarm.TaskDefaults",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#TaskDefaults",
+        description: "TaskDefaults parameterizes cluster-level task creation with default values.",
+    }
+) {}
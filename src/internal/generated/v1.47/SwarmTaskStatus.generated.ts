@@ -0,0 +1,22 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmContainerStatus from "./SwarmContainerStatus.generated.ts";
+import * as SwarmPortStatus from "./SwarmPortStatus.generated.ts";
+import * as SwarmTaskState from "./SwarmTaskState.generated.ts";
+
+export class SwarmTaskStatus extends Schema.Class<SwarmTaskStatus>("SwarmTaskStatus")(
+    {
+        Timestamp: Schema.NullOr(Schema.DateFromString),
+        State: Schema.optional(SwarmTaskState.SwarmTaskState),
+        Message: Schema.optional(Schema.String),
+        Err: Schema.optional(Schema.String),
+        ContainerStatus: Schema.optional(Schema.NullOr(SwarmContainerStatus.SwarmContainerStatus)),
+        PortStatus: Schema.NullOr(SwarmPortStatus.SwarmPortStatus),
+    },
+    {
+        identifier: "SwarmTaskStatus",
+        title: "swarm.TaskStatus",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#TaskStatus",
+        description: "TaskStatus represents the status of a task.",
+    }
+) {}
@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmTopology extends Schema.Class<SwarmTopology>("SwarmTopology")(
+    {
+        Segments: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.String))),
+    },
+    {
+        identifier: "SwarmTopology",
+        title: "swarm.Topology",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#Topology",
+        description:
+            "Topology defines the CSI topology of this node. This type is a duplicate of\ngithub.com/docker/docker/api/types.Topology. Because the type definition\nis so simple and to avoid complicated structure or circular imports, we just\nduplicate it here. See that type for full documentation",
+    }
+) {}
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmUpdateConfigQuery = Schema.Struct({
+    version: Schema.optional(Schema.Finite),
+}).annotate({
+    identifier: "SwarmUpdateConfigQuery",
+    title: "swarm.updateConfig",
+    description: "Query parameters of POST /configs/{id}/update",
+});
+
+export type SwarmUpdateConfigQuery = Schema.Schema.Type<typeof SwarmUpdateConfigQuery>;
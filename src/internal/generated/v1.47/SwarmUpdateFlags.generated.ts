@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmUpdateFlags extends Schema.Class<SwarmUpdateFlags>("SwarmUpdateFlags")(
+    {
+        RotateWorkerToken: Schema.Boolean,
+        RotateManagerToken: Schema.Boolean,
+        RotateManagerUnlockKey: Schema.Boolean,
+    },
+    {
+        identifier: "SwarmUpdateFlags",
+        title: "swarm.UpdateFlags",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#UpdateFlags",
+        description: "UpdateFlags contains flags for SwarmUpdate.",
+    }
+) {}
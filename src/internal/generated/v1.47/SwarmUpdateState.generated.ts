@@ -0,0 +1,26 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmUpdateState = Schema.Literals([
+    "updating",
+    "paused",
+    "completed",
+    "rollback_started",
+    "rollback_paused",
+    "rollback_completed",
+]).annotate({
+    identifier: "SwarmUpdateState",
+    title: "swarm.UpdateState",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#UpdateState",
+    description: "UpdateState is the state of a service update.",
+});
+
+export type SwarmUpdateState = Schema.Schema.Type<typeof SwarmUpdateState>;
+
+export const SwarmUpdateStateConstants = Object.freeze({
+    UpdateStateUpdating: "updating",
+    UpdateStatePaused: "paused",
+    UpdateStateCompleted: "completed",
+    UpdateStateRollbackStarted: "rollback_started",
+    UpdateStateRollbackPaused: "rollback_paused",
+    UpdateStateRollbackCompleted: "rollback_completed",
+} as const);
@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmUpdateState from "./SwarmUpdateState.generated.ts";
+
+export class SwarmUpdateStatus extends Schema.Class<SwarmUpdateStatus>("SwarmUpdateStatus")(
+    {
+        State: Schema.optional(SwarmUpdateState.SwarmUpdateState),
+        StartedAt: Schema.optional(Schema.NullOr(Schema.DateFromString)),
+        CompletedAt: Schema.optional(Schema.NullOr(Schema.DateFromString)),
+        Message: Schema.optional(Schema.String),
+    },
+    {
+        identifier: "SwarmUpdateStatus",
+        title: "swarm.UpdateStatus",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#UpdateStatus",
+        description: "UpdateStatus reports the status of a service update.",
+    }
+) {}
@@ -0,0 +1,30 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmVolumeAttachment extends Schema.Class<SwarmVolumeAttachment>("SwarmVolumeAttachment")(
+    {
+        ID: Schema.optional(
+            Schema.String.annotate({
+                description: "ID is the Swarmkit ID of the Volume. This is not the CSI VolumeId.",
+            })
+        ),
+        Source: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "Source, together with Target, indicates the Mount, as specified in the\nContainerSpec, that this volume fulfills.",
+            })
+        ),
+        Target: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "Target, together with Source, indicates the Mount, as specified\nin the ContainerSpec, that this volume fulfills.",
+            })
+        ),
+    },
+    {
+        identifier: "SwarmVolumeAttachment",
+        title: "swarm.VolumeAttachment",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/swarm#VolumeAttachment",
+        description: "VolumeAttachment contains the associating a Volume to a Task.",
+    }
+) {}
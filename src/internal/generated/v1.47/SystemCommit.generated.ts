@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export class SystemCommit extends Schema.Class<SystemCommit>("SystemCommit")(
+    {
+        ID: Schema.String.annotate({ description: "ID is the actual commit ID of external tool." }),
+        Expected: Schema.String.annotate({
+            description: "Expected is the commit ID of external tool expected by dockerd as set at build time.",
+        }),
+    },
+    {
+        identifier: "SystemCommit",
+        title: "system.Commit",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/system#Commit",
+        description:
+            "Commit holds the Git-commit (SHA1) that a binary was built from, as reported\nin the version-string of external tools, such as containerd, or runC.",
+    }
+) {}
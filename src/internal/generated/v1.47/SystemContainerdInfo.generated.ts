@@ -0,0 +1,21 @@
+import * as Schema from "effect/Schema";
+
+import * as SystemContainerdNamespaces from "./SystemContainerdNamespaces.generated.ts";
+
+export class SystemContainerdInfo extends Schema.Class<SystemContainerdInfo>("SystemContainerdInfo")(
+    {
+        Address: Schema.optional(
+            Schema.String.annotate({ description: "Address is the path to the containerd socket." })
+        ),
+        Namespaces: Schema.NullOr(SystemContainerdNamespaces.SystemContainerdNamespaces).annotate({
+            description: "Namespaces is the containerd namespaces used by the daemon.",
+        }),
+    },
+    {
+        identifier: "SystemContainerdInfo",
+        title: "system.ContainerdInfo",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/system#ContainerdInfo",
+        description: "ContainerdInfo holds information about the containerd instance used by the daemon.",
+    }
+) {}
@@ -0,0 +1,22 @@
+import * as Schema from "effect/Schema";
+
+export class SystemContainerdNamespaces extends Schema.Class<SystemContainerdNamespaces>("SystemContainerdNamespaces")(
+    {
+        Containers: Schema.String.annotate({
+            description:
+                'Containers holds the default containerd namespace used for\ncontainers managed by the daemon.\n\nThe default namespace for containers is "moby", but will be\nsuffixed with the `<uid>.<gid>` of the remapped `root` if\nuser-namespaces are enabled and the containerd image-store\nis used.',
+        }),
+        Plugins: Schema.String.annotate({
+            description:
+                'Plugins holds the default containerd namespace used for\nplugins managed by the daemon.\n\nThe default namespace for plugins is "moby", but will be\nsuffixed with the `<uid>.<gid>` of the remapped `root` if\nuser-namespaces are enabled and the containerd image-store\nis used.',
+        }),
+    },
+    {
+        identifier: "SystemContainerdNamespaces",
+        title: "system.ContainerdNamespaces",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/system#ContainerdNamespaces",
+        description:
+            "ContainerdNamespaces reflects the containerd namespaces used by the daemon.\n\nThese namespaces can be configured in the daemon configuration, and are\nconsidered to be used exclusively by the daemon,\n\nAs these namespaces are considered to be exclusively accessed\nby the daemon, it is not recommended to change these values,\nor to change them to a value that is used by other systems,\nsuch as cri-containerd.",
+    }
+) {}
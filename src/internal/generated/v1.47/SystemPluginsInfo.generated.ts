@@ -0,0 +1,23 @@
+import * as Schema from "effect/Schema";
+
+export class SystemPluginsInfo extends Schema.Class<SystemPluginsInfo>("SystemPluginsInfo")(
+    {
+        Volume: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description: "List of Volume plugins registered",
+        }),
+        Network: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description: "List of Network plugins registered",
+        }),
+        Authorization: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description: "List of Authorization plugins registered",
+        }),
+        Log: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "List of Log plugins registered" }),
+    },
+    {
+        identifier: "SystemPluginsInfo",
+        title: "system.PluginsInfo",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/system#PluginsInfo",
+        description:
+            "PluginsInfo is a temp struct holding Plugins name\nregistered with docker daemon. It is used by [Info] struct",
+    }
+) {}
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export class SystemRuntime extends Schema.Class<SystemRuntime>("SystemRuntime")(
+    {
+        path: Schema.optional(Schema.String),
+        runtimeArgs: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
+        runtimeType: Schema.optional(Schema.String),
+        options: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.ObjectKeyword))),
+    },
+    {
+        identifier: "SystemRuntime",
+        title: "system.Runtime",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/system#Runtime",
+        description: "Runtime describes an OCI runtime",
+    }
+) {}
@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+export class SystemRuntimeWithStatus extends Schema.Class<SystemRuntimeWithStatus>("SystemRuntimeWithStatus")(
+    {
+        path: Schema.optional(Schema.String),
+        runtimeArgs: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
+        runtimeType: Schema.optional(Schema.String),
+        options: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.ObjectKeyword))),
+        status: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.String))),
+    },
+    {
+        identifier: "SystemRuntimeWithStatus",
+        title: "system.RuntimeWithStatus",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/system#RuntimeWithStatus",
+        description: "RuntimeWithStatus extends [Runtime] to hold [RuntimeStatus].",
+    }
+) {}
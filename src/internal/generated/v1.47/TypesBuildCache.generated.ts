@@ -0,0 +1,44 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class TypesBuildCache extends Schema.Class<TypesBuildCache>("TypesBuildCache")(
+    {
+        ID: Schema.String.annotate({ description: "ID is the unique ID of the build cache record." }),
+        Parent: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "Parent is the ID of the parent build cache record.\n\nDeprecated: deprecated in API v1.42 and up, as it was deprecated in BuildKit; use Parents instead.",
+            })
+        ),
+        " Parents": Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "Parents is the list of parent build cache record IDs.",
+            })
+        ),
+        Type: Schema.String.annotate({ description: "Type is the cache record type." }),
+        Description: Schema.String.annotate({
+            description: "Description is a description of the build-step that produced the build cache.",
+        }),
+        InUse: Schema.Boolean.annotate({ description: "InUse indicates if the build cache is in use." }),
+        Shared: Schema.Boolean.annotate({ description: "Shared indicates if the build cache is shared." }),
+        Size: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({ description: "Size is the amount of disk space used by the build cache (in bytes)." }),
+        CreatedAt: Schema.NullOr(Schema.DateFromString).annotate({
+            description: "CreatedAt is the date and time at which the build cache was created.",
+        }),
+        LastUsedAt: Schema.NullOr(Schema.DateFromString).annotate({
+            description: "LastUsedAt is the date and time at which the build cache was last used.",
+        }),
+        UsageCount: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+    },
+    {
+        identifier: "TypesBuildCache",
+        title: "types.BuildCache",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types#BuildCache",
+        description: "BuildCache contains information about a build cache record.",
+    }
+) {}
@@ -0,0 +1,22 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class TypesBuildCachePruneOptions extends Schema.Class<TypesBuildCachePruneOptions>(
+    "TypesBuildCachePruneOptions"
+)(
+    {
+        All: Schema.Boolean,
+        KeepStorage: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        Filters: Schema.NullOr(Schema.Record(Schema.String, Schema.Record(Schema.String, Schema.Boolean))),
+    },
+    {
+        identifier: "TypesBuildCachePruneOptions",
+        title: "types.BuildCachePruneOptions",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types#BuildCachePruneOptions",
+        description: "BuildCachePruneOptions hold parameters to prune the build cache",
+    }
+) {}
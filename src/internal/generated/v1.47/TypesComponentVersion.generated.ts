@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+
+export class TypesComponentVersion extends Schema.Class<TypesComponentVersion>("TypesComponentVersion")(
+    {
+        Name: Schema.String,
+        Version: Schema.String,
+        Details: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.String))),
+    },
+    {
+        identifier: "TypesComponentVersion",
+        title: "types.ComponentVersion",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types#ComponentVersion",
+        description: "ComponentVersion describes the version information for a specific component.",
+    }
+) {}
@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export class TypesContainerHostConfig extends Schema.Class<TypesContainerHostConfig>("TypesContainerHostConfig")(
+    {
+        NetworkMode: Schema.optional(Schema.String),
+        Annotations: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.String))),
+    },
+    {
+        identifier: "TypesContainerHostConfig",
+        title: "types.Container.HostConfig",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types#Container",
+    }
+) {}
@@ -0,0 +1,26 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class TypesContainerNode extends Schema.Class<TypesContainerNode>("TypesContainerNode")(
+    {
+        ID: Schema.String,
+        IP: Schema.String,
+        Addr: Schema.String,
+        Name: Schema.String,
+        Cpus: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        Memory: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+    },
+    {
+        identifier: "TypesContainerNode",
+        title: "types.ContainerNode",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types#ContainerNode",
+        description:
+            "ContainerNode stores information about the node that a container\nis running on.  It's only used by the Docker Swarm standalone API.\n\nDeprecated: ContainerNode was used for the classic Docker Swarm standalone API. It will be removed in the next release.",
+    }
+) {}
@@ -0,0 +1,35 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as TypesHealth from "./TypesHealth.generated.ts";
+
+export class TypesContainerState extends Schema.Class<TypesContainerState>("TypesContainerState")(
+    {
+        Status: Schema.String.annotate({
+            description:
+                'String representation of the container state. Can be one of "created", "running", "paused", "restarting", "removing", "exited", or "dead"',
+        }),
+        Running: Schema.Boolean,
+        Paused: Schema.Boolean,
+        Restarting: Schema.Boolean,
+        OOMKilled: Schema.Boolean,
+        Dead: Schema.Boolean,
+        Pid: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        ExitCode: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        Error: Schema.String,
+        StartedAt: Schema.String,
+        FinishedAt: Schema.String,
+        Health: Schema.optional(Schema.NullOr(TypesHealth.TypesHealth)),
+    },
+    {
+        identifier: "TypesContainerState",
+        title: "types.ContainerState",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types#ContainerState",
+        description:
+            "ContainerState stores container's running state\nit's part of ContainerJSONBase and will return by \"inspect\" command",
+    }
+) {}
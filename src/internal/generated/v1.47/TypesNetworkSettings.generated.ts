@@ -0,0 +1,65 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as PortSchemas from "../../schemas/port.ts";
+import * as NetworkAddress from "./NetworkAddress.generated.ts";
+import * as NetworkEndpointSettings from "./NetworkEndpointSettings.generated.ts";
+
+export class TypesNetworkSettings extends Schema.Class<TypesNetworkSettings>("TypesNetworkSettings")(
+    {
+        Bridge: Schema.String.annotate({
+            description:
+                "Bridge contains the name of the default bridge interface iff it was set through the daemon --bridge flag.",
+        }),
+        SandboxID: Schema.String.annotate({ description: "SandboxID uniquely represents a container's network stack" }),
+        SandboxKey: Schema.String.annotate({ description: "SandboxKey identifies the sandbox" }),
+        Ports: Schema.NullOr(PortSchemas.PortMap).annotate({
+            description: "Ports is a collection of PortBinding indexed by Port",
+        }),
+        HairpinMode: Schema.Boolean.annotate({
+            description:
+                "HairpinMode specifies if hairpin NAT should be enabled on the virtual interface\n\nDeprecated: This field is never set and will be removed in a future release.",
+        }),
+        LinkLocalIPv6Address: Schema.String.annotate({
+            description:
+                "LinkLocalIPv6Address is an IPv6 unicast address using the link-local prefix\n\nDeprecated: This field is never set and will be removed in a future release.",
+        }),
+        LinkLocalIPv6PrefixLen: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "LinkLocalIPv6PrefixLen is the prefix length of an IPv6 unicast address\n\nDeprecated: This field is never set and will be removed in a future release.",
+        }),
+        SecondaryIPAddresses: Schema.NullOr(Schema.Array(Schema.NullOr(NetworkAddress.NetworkAddress))).annotate({
+            description: "Deprecated: This field is never set and will be removed in a future release.",
+        }),
+        SecondaryIPv6Addresses: Schema.NullOr(Schema.Array(Schema.NullOr(NetworkAddress.NetworkAddress))).annotate({
+            description: "Deprecated: This field is never set and will be removed in a future release.",
+        }),
+        EndpointID: Schema.String.annotate({
+            description: "EndpointID uniquely represents a service endpoint in a Sandbox",
+        }),
+        Gateway: Schema.String.annotate({ description: "Gateway holds the gateway address for the network" }),
+        GlobalIPv6Address: Schema.String.annotate({
+            description: "GlobalIPv6Address holds network's global IPv6 address",
+        }),
+        GlobalIPv6PrefixLen: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({ description: "GlobalIPv6PrefixLen represents mask length of network's global IPv6 address" }),
+        IPAddress: Schema.String.annotate({ description: "IPAddress holds the IPv4 address for the network" }),
+        IPPrefixLen: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({ description: "IPPrefixLen represents mask length of network's IPv4 address" }),
+        IPv6Gateway: Schema.String.annotate({ description: "IPv6Gateway holds gateway address specific for IPv6" }),
+        MacAddress: Schema.String.annotate({ description: "MacAddress holds the MAC address for the network" }),
+        Networks: Schema.NullOr(
+            Schema.Record(Schema.String, Schema.NullOr(NetworkEndpointSettings.NetworkEndpointSettings))
+        ),
+    },
+    {
+        identifier: "TypesNetworkSettings",
+        title: "types.NetworkSettings",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types#NetworkSettings",
+        description: "NetworkSettings exposes the network settings in the api",
+    }
+) {}
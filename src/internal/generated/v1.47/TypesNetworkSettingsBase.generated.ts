@@ -0,0 +1,45 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as PortSchemas from "../../schemas/port.ts";
+import * as NetworkAddress from "./NetworkAddress.generated.ts";
+
+export class TypesNetworkSettingsBase extends Schema.Class<TypesNetworkSettingsBase>("TypesNetworkSettingsBase")(
+    {
+        Bridge: Schema.String.annotate({
+            description:
+                "Bridge contains the name of the default bridge interface iff it was set through the daemon --bridge flag.",
+        }),
+        SandboxID: Schema.String.annotate({ description: "SandboxID uniquely represents a container's network stack" }),
+        SandboxKey: Schema.String.annotate({ description: "SandboxKey identifies the sandbox" }),
+        Ports: Schema.NullOr(PortSchemas.PortMap).annotate({
+            description: "Ports is a collection of PortBinding indexed by Port",
+        }),
+        HairpinMode: Schema.Boolean.annotate({
+            description:
+                "HairpinMode specifies if hairpin NAT should be enabled on the virtual interface\n\nDeprecated: This field is never set and will be removed in a future release.",
+        }),
+        LinkLocalIPv6Address: Schema.String.annotate({
+            description:
+                "LinkLocalIPv6Address is an IPv6 unicast address using the link-local prefix\n\nDeprecated: This field is never set and will be removed in a future release.",
+        }),
+        LinkLocalIPv6PrefixLen: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "LinkLocalIPv6PrefixLen is the prefix length of an IPv6 unicast address\n\nDeprecated: This field is never set and will be removed in a future release.",
+        }),
+        SecondaryIPAddresses: Schema.NullOr(Schema.Array(Schema.NullOr(NetworkAddress.NetworkAddress))).annotate({
+            description: "Deprecated: This field is never set and will be removed in a future release.",
+        }),
+        SecondaryIPv6Addresses: Schema.NullOr(Schema.Array(Schema.NullOr(NetworkAddress.NetworkAddress))).annotate({
+            description: "Deprecated: This field is never set and will be removed in a future release.",
+        }),
+    },
+    {
+        identifier: "TypesNetworkSettingsBase",
+        title: "types.NetworkSettingsBase",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types#NetworkSettingsBase",
+        description: "NetworkSettingsBase holds networking state for a container when inspecting it.",
+    }
+) {}
@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export class TypesPluginConfigNetwork extends Schema.Class<TypesPluginConfigNetwork>("TypesPluginConfigNetwork")(
+    {
+        Type: Schema.String.annotate({ description: "type\nRequired: true" }),
+    },
+    {
+        identifier: "TypesPluginConfigNetwork",
+        title: "types.PluginConfigNetwork",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types#PluginConfigNetwork",
+        description: "PluginConfigNetwork plugin config network\nswagger:model PluginConfigNetwork",
+    }
+) {}
@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export class TypesServiceListOptions extends Schema.Class<TypesServiceListOptions>("TypesServiceListOptions")(
+    {
+        Filters: Schema.NullOr(Schema.Record(Schema.String, Schema.Record(Schema.String, Schema.Boolean))),
+        Status: Schema.Boolean.annotate({
+            description:
+                "Status indicates whether the server should include the service task\ncount of running and desired tasks.",
+        }),
+    },
+    {
+        identifier: "TypesServiceListOptions",
+        title: "types.ServiceListOptions",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types#ServiceListOptions",
+        description: "ServiceListOptions holds parameters to list services with.",
+    }
+) {}
@@ -0,0 +1,29 @@
+import * as Schema from "effect/Schema";
+
+import * as TypesComponentVersion from "./TypesComponentVersion.generated.ts";
+import * as TypesVersionPlatform from "./TypesVersionPlatform.generated.ts";
+
+export class TypesVersion extends Schema.Class<TypesVersion>("TypesVersion")(
+    {
+        Platform: TypesVersionPlatform.TypesVersionPlatform,
+        Components: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.NullOr(TypesComponentVersion.TypesComponentVersion)))
+        ),
+        Version: Schema.String,
+        ApiVersion: Schema.String,
+        MinAPIVersion: Schema.optional(Schema.String),
+        GitCommit: Schema.String,
+        GoVersion: Schema.String,
+        Os: Schema.String,
+        Arch: Schema.String,
+        KernelVersion: Schema.optional(Schema.String),
+        Experimental: Schema.optional(Schema.Boolean),
+        BuildTime: Schema.optional(Schema.String),
+    },
+    {
+        identifier: "TypesVersion",
+        title: "types.Version",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types#Version",
+        description: 'Version contains response of Engine API:\nGET "/version"',
+    }
+) {}
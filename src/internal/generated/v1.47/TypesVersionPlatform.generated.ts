@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export class TypesVersionPlatform extends Schema.Class<TypesVersionPlatform>("TypesVersionPlatform")(
+    {
+        Name: Schema.String,
+    },
+    {
+        identifier: "TypesVersionPlatform",
+        title: "types.Version.Platform",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types#Version",
+    }
+) {}
@@ -0,0 +1,36 @@
+import * as Schema from "effect/Schema";
+
+export class V1Platform extends Schema.Class<V1Platform>("V1Platform")(
+    {
+        architecture: Schema.String.annotate({
+            description: "Architecture field specifies the CPU architecture, for example\n`amd64` or `ppc64le`.",
+        }),
+        os: Schema.String.annotate({
+            description: "OS specifies the operating system, for example `linux` or `windows`.",
+        }),
+        "os.version": Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "OSVersion is an optional field specifying the operating system\nversion, for example on Windows `10.0.14393.1066`.",
+            })
+        ),
+        "os.features": Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description:
+                    "OSFeatures is an optional field specifying an array of strings,\neach listing a required OS feature (for example on Windows `win32k`).",
+            })
+        ),
+        variant: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "Variant is an optional field specifying a variant of the CPU, for\nexample `v7` to specify ARMv7 when architecture is `arm`.",
+            })
+        ),
+    },
+    {
+        identifier: "V1Platform",
+        title: "v1.Platform",
+        documentation: "https://pkg.go.dev/github.com/opencontainers/image-spec@v1.1.1/specs-go/v1#Platform",
+        description: "Platform describes the platform which the image in the manifest runs on.",
+    }
+) {}
@@ -0,0 +1,40 @@
+import * as Schema from "effect/Schema";
+
+import * as VolumeScope from "./VolumeScope.generated.ts";
+import * as VolumeSharingMode from "./VolumeSharingMode.generated.ts";
+import * as VolumeTypeBlock from "./VolumeTypeBlock.generated.ts";
+import * as VolumeTypeMount from "./VolumeTypeMount.generated.ts";
+
+export class VolumeAccessMode extends Schema.Class<VolumeAccessMode>("VolumeAccessMode")(
+    {
+        Scope: Schema.optional(
+            VolumeScope.VolumeScope.annotate({
+                description: "Scope defines the set of nodes this volume can be used on at one time.",
+            })
+        ),
+        Sharing: Schema.optional(
+            VolumeSharingMode.VolumeSharingMode.annotate({
+                description:
+                    "Sharing defines the number and way that different tasks can use this\nvolume at one time.",
+            })
+        ),
+        MountVolume: Schema.optional(
+            Schema.NullOr(VolumeTypeMount.VolumeTypeMount).annotate({
+                description:
+                    "MountVolume defines options for using this volume as a Mount-type\nvolume.\n\nEither BlockVolume or MountVolume, but not both, must be present.",
+            })
+        ),
+        BlockVolume: Schema.optional(
+            Schema.NullOr(VolumeTypeBlock.VolumeTypeBlock).annotate({
+                description:
+                    "BlockVolume defines options for using this volume as a Block-type\nvolume.\n\nEither BlockVolume or MountVolume, but not both, must be present.",
+            })
+        ),
+    },
+    {
+        identifier: "VolumeAccessMode",
+        title: "volume.AccessMode",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/volume#AccessMode",
+        description: "AccessMode defines the access mode of a volume.",
+    }
+) {}
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export const VolumeAvailability = Schema.Literals(["active", "pause", "drain"]).annotate({
+    identifier: "VolumeAvailability",
+    title: "volume.Availability",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/volume#Availability",
+    description: "Availability specifies the availability of the volume.",
+});
+
+export type VolumeAvailability = Schema.Schema.Type<typeof VolumeAvailability>;
+
+export const VolumeAvailabilityConstants = Object.freeze({
+    AvailabilityActive: "active",
+    AvailabilityPause: "pause",
+    AvailabilityDrain: "drain",
+} as const);
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const VolumeDeleteVolumesQuery = Schema.Struct({
+    force: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "VolumeDeleteVolumesQuery",
+    title: "volume.deleteVolumes",
+    description: "Query parameters of DELETE /volumes/{name:.*}",
+});
+
+export type VolumeDeleteVolumesQuery = Schema.Schema.Type<typeof VolumeDeleteVolumesQuery>;
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const VolumeGetVolumesListQuery = Schema.Struct({
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+}).annotate({
+    identifier: "VolumeGetVolumesListQuery",
+    title: "volume.getVolumesList",
+    description: "Query parameters of GET /volumes",
+});
+
+export type VolumeGetVolumesListQuery = Schema.Schema.Type<typeof VolumeGetVolumesListQuery>;
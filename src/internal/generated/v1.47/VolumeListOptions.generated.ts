@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export class VolumeListOptions extends Schema.Class<VolumeListOptions>("VolumeListOptions")(
+    {
+        Filters: Schema.NullOr(Schema.Record(Schema.String, Schema.Record(Schema.String, Schema.Boolean))),
+    },
+    {
+        identifier: "VolumeListOptions",
+        title: "volume.ListOptions",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/volume#ListOptions",
+        description: "ListOptions holds parameters to list volumes.",
+    }
+) {}
@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class VolumePruneReport extends Schema.Class<VolumePruneReport>("VolumePruneReport")(
+    {
+        VolumesDeleted: Schema.NullOr(Schema.Array(Schema.String)),
+        SpaceReclaimed: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ),
+    },
+    {
+        identifier: "VolumePruneReport",
+        title: "volume.PruneReport",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/volume#PruneReport",
+        description: 'PruneReport contains the response for Engine API:\nPOST "/volumes/prune"',
+    }
+) {}
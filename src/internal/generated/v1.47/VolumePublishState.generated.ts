@@ -0,0 +1,22 @@
+import * as Schema from "effect/Schema";
+
+export const VolumePublishState = Schema.Literals([
+    "pending-publish",
+    "published",
+    "pending-node-unpublish",
+    "pending-controller-unpublish",
+]).annotate({
+    identifier: "VolumePublishState",
+    title: "volume.PublishState",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/volume#PublishState",
+    description: "PublishState represents the state of a Volume as it pertains to its\nuse on a particular Node.",
+});
+
+export type VolumePublishState = Schema.Schema.Type<typeof VolumePublishState>;
+
+export const VolumePublishStateConstants = Object.freeze({
+    StatePending: "pending-publish",
+    StatePublished: "published",
+    StatePendingNodeUnpublish: "pending-node-unpublish",
+    StatePendingUnpublish: "pending-controller-unpublish",
+} as const);
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export const VolumeScope = Schema.Literals(["single", "multi"]).annotate({
+    identifier: "VolumeScope",
+    title: "volume.Scope",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/volume#Scope",
+    description:
+        "Scope defines the Scope of a Cluster Volume. This is how many nodes a\nVolume can be accessed simultaneously on.",
+});
+
+export type VolumeScope = Schema.Schema.Type<typeof VolumeScope>;
+
+export const VolumeScopeConstants = Object.freeze({
+    ScopeSingleNode: "single",
+    ScopeMultiNode: "multi",
+} as const);
@@ -0,0 +1,20 @@
+import * as Schema from "effect/Schema";
+
+export class VolumeSecret extends Schema.Class<VolumeSecret>("VolumeSecret")(
+    {
+        Key: Schema.String.annotate({
+            description: "Key is the name of the key of the key-value pair passed to the plugin.",
+        }),
+        Secret: Schema.String.annotate({
+            description:
+                "Secret is the swarm Secret object from which to read data. This can be a\nSecret name or ID. The Secret data is retrieved by Swarm and used as the\nvalue of the key-value pair passed to the plugin.",
+        }),
+    },
+    {
+        identifier: "VolumeSecret",
+        title: "volume.Secret",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/volume#Secret",
+        description:
+            "Secret represents a Swarm Secret value that must be passed to the CSI\nstorage plugin when operating on this Volume. It represents one key-value\npair of possibly many.",
+    }
+) {}
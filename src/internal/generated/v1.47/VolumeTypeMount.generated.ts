@@ -0,0 +1,22 @@
+import * as Schema from "effect/Schema";
+
+export class VolumeTypeMount extends Schema.Class<VolumeTypeMount>("VolumeTypeMount")(
+    {
+        FsType: Schema.optional(
+            Schema.String.annotate({
+                description: "FsType specifies the filesystem type for the mount volume. Optional.",
+            })
+        ),
+        MountFlags: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "MountFlags defines flags to pass when mounting the volume. Optional.",
+            })
+        ),
+    },
+    {
+        identifier: "VolumeTypeMount",
+        title: "volume.TypeMount",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/volume#TypeMount",
+        description: "TypeMount contains options for using a volume as a Mount-type\nvolume.",
+    }
+) {}
@@ -0,0 +1,27 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class VolumeUsageData extends Schema.Class<VolumeUsageData>("VolumeUsageData")(
+    {
+        RefCount: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "The number of containers referencing this volume. This field\nis set to `-1` if the reference-count is not available.\n\nRequired: true",
+        }),
+        Size: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                'Amount of disk space used by the volume (in bytes). This information\nis only available for volumes created with the `"local"` volume\ndriver. For volumes created with other volume drivers, this field\nis set to `-1` ("not available")\n\nRequired: true',
+        }),
+    },
+    {
+        identifier: "VolumeUsageData",
+        title: "volume.UsageData",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v27.5.1+incompatible/api/types/volume#UsageData",
+        description:
+            "UsageData Usage details about the volume. This information is used by the\n`GET /system/df` endpoint, and omitted in other endpoints.\n\nswagger:model UsageData",
+    }
+) {}
@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+import * as ArchiveChangeType from "./ArchiveChangeType.generated.ts";
+
+export class ArchiveChange extends Schema.Class<ArchiveChange>("ArchiveChange")(
+    {
+        Path: Schema.String,
+        Kind: ArchiveChangeType.ArchiveChangeType,
+    },
+    {
+        identifier: "ArchiveChange",
+        title: "archive.Change",
+        documentation: "https://pkg.go.dev/github.com/moby/go-archive@v0.1.0#Change",
+        description:
+            "Change represents a change, it wraps the change type and path.\nIt describes changes of the files in the path respect to the\nparent layers. The change could be modify, add, delete.\nThis is used for layer diff.",
+    }
+) {}
@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export const ArchiveChangeType = MobyNumber.LiteralsFromWireString([0, 1, 2]).annotate({
+    identifier: "ArchiveChangeType",
+    title: "archive.ChangeType",
+    documentation: "https://pkg.go.dev/github.com/moby/go-archive@v0.1.0#ChangeType",
+    description: "ChangeType represents the change type.",
+});
+
+export type ArchiveChangeType = Schema.Schema.Type<typeof ArchiveChangeType>;
+
+export const ArchiveChangeTypeConstants = Object.freeze({
+    ChangeModify: 0,
+    ChangeAdd: 1,
+    ChangeDelete: 2,
+} as const);
@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class BlkiodevThrottleDevice extends Schema.Class<BlkiodevThrottleDevice>("BlkiodevThrottleDevice")(
+    {
+        Path: Schema.String,
+        Rate: MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })),
+    },
+    {
+        identifier: "BlkiodevThrottleDevice",
+        title: "blkiodev.ThrottleDevice",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/blkiodev#ThrottleDevice",
+        description: "ThrottleDevice is a structure that holds device:rate_per_second pair",
+    }
+) {}
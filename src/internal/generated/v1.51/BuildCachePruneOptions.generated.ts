@@ -0,0 +1,29 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class BuildCachePruneOptions extends Schema.Class<BuildCachePruneOptions>("BuildCachePruneOptions")(
+    {
+        All: Schema.Boolean,
+        ReservedSpace: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        MaxUsedSpace: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        MinFreeSpace: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        Filters: Schema.NullOr(Schema.Record(Schema.String, Schema.Record(Schema.String, Schema.Boolean))),
+        KeepStorage: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({ description: "Deprecated: deprecated in API 1.48." }),
+    },
+    {
+        identifier: "BuildCachePruneOptions",
+        title: "build.CachePruneOptions",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/build#CachePruneOptions",
+        description: "CachePruneOptions hold parameters to prune the build cache.",
+    }
+) {}
@@ -0,0 +1,19 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class BuildCachePruneReport extends Schema.Class<BuildCachePruneReport>("BuildCachePruneReport")(
+    {
+        CachesDeleted: Schema.NullOr(Schema.Array(Schema.String)),
+        SpaceReclaimed: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ),
+    },
+    {
+        identifier: "BuildCachePruneReport",
+        title: "build.CachePruneReport",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/build#CachePruneReport",
+        description: 'CachePruneReport contains the response for Engine API:\nPOST "/build/prune"',
+    }
+) {}
@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class CheckpointListOptions extends Schema.Class<CheckpointListOptions>("CheckpointListOptions")(
+    {
+        CheckpointDir: Schema.String,
+    },
+    {
+        identifier: "CheckpointListOptions",
+        title: "checkpoint.ListOptions",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/checkpoint#ListOptions",
+        description: "ListOptions holds parameters to list checkpoints for a container.",
+    }
+) {}
@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class ContainerBlkioStatEntry extends Schema.Class<ContainerBlkioStatEntry>("ContainerBlkioStatEntry")(
     {
@@ -14,5 +14,6 @@ export class ContainerBlkioStatEntry extends Schema.Class<ContainerBlkioStatEntr
         title: "container.BlkioStatEntry",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#BlkioStatEntry",
+        description: "BlkioStatEntry is one small entity to store a piece of Blkio stats\nNot used on Windows.",
     }
 ) {}
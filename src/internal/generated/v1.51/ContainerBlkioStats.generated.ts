@@ -6,7 +6,7 @@ export class ContainerBlkioStats extends Schema.Class<ContainerBlkioStats>("Cont
     {
         io_service_bytes_recursive: Schema.NullOr(
             Schema.Array(Schema.NullOr(ContainerBlkioStatEntry.ContainerBlkioStatEntry))
-        ),
+        ).annotate({ description: "number of bytes transferred to and from the block device" }),
         io_serviced_recursive: Schema.NullOr(
             Schema.Array(Schema.NullOr(ContainerBlkioStatEntry.ContainerBlkioStatEntry))
         ),
@@ -28,5 +28,7 @@ export class ContainerBlkioStats extends Schema.Class<ContainerBlkioStats>("Cont
         title: "container.BlkioStats",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#BlkioStats",
+        description:
+            "BlkioStats stores All IO service stats for data read and write.\nThis is a Linux specific structure as the differences between expressing\nblock I/O on Windows and Linux are sufficiently significant to make\nlittle sense attempting to morph into a combined structure.",
     }
 ) {}
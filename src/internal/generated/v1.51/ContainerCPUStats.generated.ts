@@ -1,26 +1,33 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 import * as ContainerCPUUsage from "./ContainerCPUUsage.generated.ts";
 import * as ContainerThrottlingData from "./ContainerThrottlingData.generated.ts";
 
 export class ContainerCPUStats extends Schema.Class<ContainerCPUStats>("ContainerCPUStats")(
     {
-        cpu_usage: Schema.NullOr(ContainerCPUUsage.ContainerCPUUsage),
+        cpu_usage: Schema.NullOr(ContainerCPUUsage.ContainerCPUUsage).annotate({
+            description: "CPU Usage. Linux and Windows.",
+        }),
         system_cpu_usage: Schema.optional(
-            MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+            ).annotate({ description: "System Usage. Linux only." })
         ),
         online_cpus: Schema.optional(
             MobyNumber.NumberFromWireString.check(
                 Schema.isInt(),
                 Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
-            )
+            ).annotate({ description: "Online CPUs. Linux only." })
         ),
-        throttling_data: Schema.optional(Schema.NullOr(ContainerThrottlingData.ContainerThrottlingData)),
+        throttling_data: Schema.NullOr(ContainerThrottlingData.ContainerThrottlingData).annotate({
+            description: "Throttling Data. Linux only.",
+        }),
     },
     {
         identifier: "ContainerCPUStats",
         title: "container.CPUStats",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#CPUStats",
+        description: "CPUStats aggregates and wraps all CPU related info of container",
     }
 ) {}
@@ -1,12 +1,14 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class ContainerCPUUsage extends Schema.Class<ContainerCPUUsage>("ContainerCPUUsage")(
     {
         total_usage: MobyNumber.BigIntFromWireString.check(
             Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
-        ),
+        ).annotate({
+            description: "Total CPU time consumed.\nUnits: nanoseconds (Linux)\nUnits: 100's of nanoseconds (Windows)",
+        }),
         percpu_usage: Schema.optional(
             Schema.NullOr(
                 Schema.Array(
@@ -14,18 +16,27 @@ export class ContainerCPUUsage extends Schema.Class<ContainerCPUUsage>("Containe
                         Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
                     )
                 )
-            )
+            ).annotate({
+                description: "Total CPU time consumed per core (Linux). Not used on Windows.\nUnits: nanoseconds.",
+            })
         ),
         usage_in_kernelmode: MobyNumber.BigIntFromWireString.check(
             Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
-        ),
+        ).annotate({
+            description:
+                "Time spent by tasks of the cgroup in kernel mode (Linux).\nTime spent by all container processes in kernel mode (Windows).\nUnits: nanoseconds (Linux).\nUnits: 100's of nanoseconds (Windows). Not populated for Hyper-V Containers.",
+        }),
         usage_in_usermode: MobyNumber.BigIntFromWireString.check(
             Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
-        ),
+        ).annotate({
+            description:
+                "Time spent by tasks of the cgroup in user mode (Linux).\nTime spent by all container processes in user mode (Windows).\nUnits: nanoseconds (Linux).\nUnits: 100's of nanoseconds (Windows). Not populated for Hyper-V Containers",
+        }),
     },
     {
         identifier: "ContainerCPUUsage",
         title: "container.CPUUsage",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#CPUUsage",
+        description: "CPUUsage stores All CPU stats aggregated since container inception.",
     }
 ) {}
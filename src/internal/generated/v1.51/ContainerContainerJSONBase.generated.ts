@@ -1,7 +1,7 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyIdentifiers from "../schemas/id.ts";
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 import * as ContainerHostConfig from "./ContainerHostConfig.generated.ts";
 import * as ContainerState from "./ContainerState.generated.ts";
 import * as StorageDriverData from "./StorageDriverData.generated.ts";
@@ -9,7 +9,7 @@ import * as StorageDriverData from "./StorageDriverData.generated.ts";
 export class ContainerContainerJSONBase extends Schema.Class<ContainerContainerJSONBase>("ContainerContainerJSONBase")(
     {
         Id: MobyIdentifiers.ContainerIdentifier,
-        Created: Schema.DateFromString,
+        Created: Schema.String,
         Path: Schema.String,
         Args: Schema.NullOr(Schema.Array(Schema.String)),
         State: Schema.NullOr(ContainerState.ContainerState),
@@ -29,7 +29,7 @@ export class ContainerContainerJSONBase extends Schema.Class<ContainerContainerJ
         AppArmorProfile: Schema.String,
         ExecIDs: Schema.NullOr(Schema.Array(Schema.String)),
         HostConfig: Schema.NullOr(ContainerHostConfig.ContainerHostConfig),
-        GraphDriver: Schema.optional(Schema.NullOr(StorageDriverData.StorageDriverData)),
+        GraphDriver: Schema.NullOr(StorageDriverData.StorageDriverData),
         SizeRw: Schema.optional(
             Schema.NullOr(
                 MobyNumber.BigIntFromWireString.check(
@@ -50,5 +50,7 @@ export class ContainerContainerJSONBase extends Schema.Class<ContainerContainerJ
         title: "container.ContainerJSONBase",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#ContainerJSONBase",
+        description:
+            'ContainerJSONBase contains response of Engine API GET "/containers/{name:.*}/json"\nfor API version 1.18 and older.\n\nTODO(thaJeztah): combine ContainerJSONBase and InspectResponse into a single struct.\nThe split between ContainerJSONBase (ContainerJSONBase) and InspectResponse (InspectResponse)\nwas done in commit 6deaa58ba5f051039643cedceee97c8695e2af74 (https://github.com/moby/moby/pull/13675).\nContainerJSONBase contained all fields for API < 1.19, and InspectResponse\nheld fields that were added in API 1.19 and up. Given that the minimum\nsupported API version is now 1.24, we no longer use the separate type.',
     }
 ) {}
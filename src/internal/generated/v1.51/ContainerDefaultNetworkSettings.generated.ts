@@ -0,0 +1,54 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class ContainerDefaultNetworkSettings extends Schema.Class<ContainerDefaultNetworkSettings>(
+    "ContainerDefaultNetworkSettings"
+)(
+    {
+        EndpointID: Schema.String.annotate({
+            description:
+                "EndpointID uniquely represents a service endpoint in a Sandbox\n\nDeprecated: This field will be removed in v29. You should look for the default network in NetworkSettings.Networks instead.",
+        }),
+        Gateway: Schema.String.annotate({
+            description:
+                "Gateway holds the gateway address for the network\n\nDeprecated: This field will be removed in v29. You should look for the default network in NetworkSettings.Networks instead.",
+        }),
+        GlobalIPv6Address: Schema.String.annotate({
+            description:
+                "GlobalIPv6Address holds network's global IPv6 address\n\nDeprecated: This field will be removed in v29. You should look for the default network in NetworkSettings.Networks instead.",
+        }),
+        GlobalIPv6PrefixLen: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "GlobalIPv6PrefixLen represents mask length of network's global IPv6 address\n\nDeprecated: This field will be removed in v29. You should look for the default network in NetworkSettings.Networks instead.",
+        }),
+        IPAddress: Schema.String.annotate({
+            description:
+                "IPAddress holds the IPv4 address for the network\n\nDeprecated: This field will be removed in v29. You should look for the default network in NetworkSettings.Networks instead.",
+        }),
+        IPPrefixLen: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "IPPrefixLen represents mask length of network's IPv4 address\n\nDeprecated: This field will be removed in v29. You should look for the default network in NetworkSettings.Networks instead.",
+        }),
+        IPv6Gateway: Schema.String.annotate({
+            description:
+                "IPv6Gateway holds gateway address specific for IPv6\n\nDeprecated: This field will be removed in v29. You should look for the default network in NetworkSettings.Networks instead.",
+        }),
+        MacAddress: Schema.String.annotate({
+            description:
+                "MacAddress holds the MAC address for the network\n\nDeprecated: This field will be removed in v29. You should look for the default network in NetworkSettings.Networks instead.",
+        }),
+    },
+    {
+        identifier: "ContainerDefaultNetworkSettings",
+        title: "container.DefaultNetworkSettings",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#DefaultNetworkSettings",
+        description:
+            "DefaultNetworkSettings holds the networking state for the default bridge, if the container is connected to that\nnetwork.\n\nDeprecated: this struct is deprecated since Docker v1.11 and will be removed in v29. You should look for the default\nnetwork in NetworkSettings.Networks instead.",
+    }
+) {}
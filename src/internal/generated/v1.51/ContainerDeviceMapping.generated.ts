@@ -11,5 +11,6 @@ export class ContainerDeviceMapping extends Schema.Class<ContainerDeviceMapping>
         title: "container.DeviceMapping",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#DeviceMapping",
+        description: "DeviceMapping represents the device mapping between the host and the container.",
     }
 ) {}
@@ -0,0 +1,29 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class ContainerDeviceRequest extends Schema.Class<ContainerDeviceRequest>("ContainerDeviceRequest")(
+    {
+        Driver: Schema.String.annotate({ description: "Name of device driver" }),
+        Count: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({ description: "Number of devices to request (-1 = All)" }),
+        DeviceIDs: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description: "List of device IDs as recognizable by the device driver",
+        }),
+        Capabilities: Schema.NullOr(Schema.Array(Schema.NullOr(Schema.Array(Schema.String)))).annotate({
+            description: 'An OR list of AND lists of device capabilities (e.g. "gpu")',
+        }),
+        Options: Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+            description: "Options to pass onto the device driver",
+        }),
+    },
+    {
+        identifier: "ContainerDeviceRequest",
+        title: "container.DeviceRequest",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#DeviceRequest",
+        description:
+            "DeviceRequest represents a request for devices from a device driver.\nUsed by GPU device drivers.",
+    }
+) {}
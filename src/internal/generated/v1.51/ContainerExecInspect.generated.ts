@@ -1,7 +1,7 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyIdentifiers from "../schemas/id.ts";
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class ContainerExecInspect extends Schema.Class<ContainerExecInspect>("ContainerExecInspect")(
     {
@@ -20,5 +20,6 @@ export class ContainerExecInspect extends Schema.Class<ContainerExecInspect>("Co
         title: "container.ExecInspect",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#ExecInspect",
+        description: "ExecInspect holds information returned by exec inspect.",
     }
 ) {}
@@ -1,11 +1,11 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class ContainerExecStartOptions extends Schema.Class<ContainerExecStartOptions>("ContainerExecStartOptions")(
     {
-        Detach: Schema.Boolean,
-        Tty: Schema.Boolean,
+        Detach: Schema.Boolean.annotate({ description: "ExecStart will first check if it's detached" }),
+        Tty: Schema.Boolean.annotate({ description: "Check if there's a tty" }),
         ConsoleSize: Schema.optional(
             Schema.NullOr(
                 Schema.Array(
@@ -13,7 +13,7 @@ export class ContainerExecStartOptions extends Schema.Class<ContainerExecStartOp
                         Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
                     )
                 ).check(Schema.isLengthBetween(2, 2))
-            )
+            ).annotate({ description: "Terminal size [height, width], unused if Tty == false" })
         ),
     },
     {
@@ -21,5 +21,7 @@ export class ContainerExecStartOptions extends Schema.Class<ContainerExecStartOp
         title: "container.ExecStartOptions",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#ExecStartOptions",
+        description:
+            "ExecStartOptions is a temp struct used by execStart\nConfig fields is part of ExecConfig in runconfig package",
     }
 ) {}
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerGetContainersByNameQuery = Schema.Struct({
+    size: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "ContainerGetContainersByNameQuery",
+    title: "container.getContainersByName",
+    description: "Query parameters of GET /containers/{name:.*}/json",
+});
+
+export type ContainerGetContainersByNameQuery = Schema.Schema.Type<typeof ContainerGetContainersByNameQuery>;
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerGetContainersJSONQuery = Schema.Struct({
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+    all: Schema.optional(Schema.Boolean),
+    size: Schema.optional(Schema.Boolean),
+    since: Schema.optional(Schema.String),
+    before: Schema.optional(Schema.String),
+    limit: Schema.optional(Schema.Finite),
+}).annotate({
+    identifier: "ContainerGetContainersJSONQuery",
+    title: "container.getContainersJSON",
+    description: "Query parameters of GET /containers/json",
+});
+
+export type ContainerGetContainersJSONQuery = Schema.Schema.Type<typeof ContainerGetContainersJSONQuery>;
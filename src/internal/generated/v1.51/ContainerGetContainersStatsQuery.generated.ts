@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerGetContainersStatsQuery = Schema.Struct({
+    stream: Schema.optional(Schema.Boolean),
+    "one-shot": Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "ContainerGetContainersStatsQuery",
+    title: "container.getContainersStats",
+    description: "Query parameters of GET /containers/{name:.*}/stats",
+});
+
+export type ContainerGetContainersStatsQuery = Schema.Schema.Type<typeof ContainerGetContainersStatsQuery>;
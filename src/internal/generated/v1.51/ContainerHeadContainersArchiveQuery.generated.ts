@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerHeadContainersArchiveQuery = Schema.Struct({
+    path: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "ContainerHeadContainersArchiveQuery",
+    title: "container.headContainersArchive",
+    description: "Query parameters of HEAD /containers/{name:.*}/archive",
+});
+
+export type ContainerHeadContainersArchiveQuery = Schema.Schema.Type<typeof ContainerHeadContainersArchiveQuery>;
@@ -1,19 +1,22 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 import * as ContainerHealthcheckResult from "./ContainerHealthcheckResult.generated.ts";
 
 export class ContainerHealth extends Schema.Class<ContainerHealth>("ContainerHealth")(
     {
-        Status: Schema.Literals(["none", "starting", "healthy", "unhealthy"]),
+        Status: Schema.String.annotate({ description: "Status is one of [Starting], [Healthy] or [Unhealthy]." }),
         FailingStreak: MobyNumber.BigIntFromWireString.check(
             Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({ description: "FailingStreak is the number of consecutive failures" }),
+        Log: Schema.NullOr(Schema.Array(Schema.NullOr(ContainerHealthcheckResult.ContainerHealthcheckResult))).annotate(
+            { description: "Log contains the last few results (oldest first)" }
         ),
-        Log: Schema.NullOr(Schema.Array(Schema.NullOr(ContainerHealthcheckResult.ContainerHealthcheckResult))),
     },
     {
         identifier: "ContainerHealth",
         title: "container.Health",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#Health",
+        description: "Health stores information about the container's healthcheck results",
     }
 ) {}
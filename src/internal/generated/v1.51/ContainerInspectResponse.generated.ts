@@ -0,0 +1,70 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
+import * as ContainerConfig from "./ContainerConfig.generated.ts";
+import * as ContainerHostConfig from "./ContainerHostConfig.generated.ts";
+import * as ContainerMountPoint from "./ContainerMountPoint.generated.ts";
+import * as ContainerNetworkSettings from "./ContainerNetworkSettings.generated.ts";
+import * as ContainerState from "./ContainerState.generated.ts";
+import * as StorageDriverData from "./StorageDriverData.generated.ts";
+import * as V1Descriptor from "./V1Descriptor.generated.ts";
+
+export class ContainerInspectResponse extends Schema.Class<ContainerInspectResponse>("ContainerInspectResponse")(
+    {
+        Id: Schema.optional(MobyIdentifiers.ContainerIdentifier),
+        Created: Schema.optional(Schema.String),
+        Path: Schema.optional(Schema.String),
+        Args: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
+        State: Schema.optional(Schema.NullOr(ContainerState.ContainerState)),
+        Image: Schema.optional(Schema.String),
+        ResolvConfPath: Schema.optional(Schema.String),
+        HostnamePath: Schema.optional(Schema.String),
+        HostsPath: Schema.optional(Schema.String),
+        LogPath: Schema.optional(Schema.String),
+        Name: Schema.optional(Schema.String),
+        RestartCount: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            )
+        ),
+        Driver: Schema.optional(Schema.String),
+        Platform: Schema.optional(Schema.String),
+        MountLabel: Schema.optional(Schema.String),
+        ProcessLabel: Schema.optional(Schema.String),
+        AppArmorProfile: Schema.optional(Schema.String),
+        ExecIDs: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
+        HostConfig: Schema.optional(Schema.NullOr(ContainerHostConfig.ContainerHostConfig)),
+        GraphDriver: Schema.optional(Schema.NullOr(StorageDriverData.StorageDriverData)),
+        SizeRw: Schema.optional(
+            Schema.NullOr(
+                MobyNumber.BigIntFromWireString.check(
+                    Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+                )
+            )
+        ),
+        SizeRootFs: Schema.optional(
+            Schema.NullOr(
+                MobyNumber.BigIntFromWireString.check(
+                    Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+                )
+            )
+        ),
+        Mounts: Schema.NullOr(Schema.Array(Schema.NullOr(ContainerMountPoint.ContainerMountPoint))),
+        Config: Schema.NullOr(ContainerConfig.ContainerConfig),
+        NetworkSettings: Schema.NullOr(ContainerNetworkSettings.ContainerNetworkSettings),
+        ImageManifestDescriptor: Schema.optional(
+            Schema.NullOr(V1Descriptor.V1Descriptor).annotate({
+                description:
+                    "ImageManifestDescriptor is the descriptor of a platform-specific manifest of the image used to create the container.",
+            })
+        ),
+    },
+    {
+        identifier: "ContainerInspectResponse",
+        title: "container.InspectResponse",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#InspectResponse",
+        description: 'InspectResponse is the response for the GET "/containers/{name:.*}/json"\nendpoint.',
+    }
+) {}
@@ -0,0 +1,24 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class ContainerListOptions extends Schema.Class<ContainerListOptions>("ContainerListOptions")(
+    {
+        Size: Schema.Boolean,
+        All: Schema.Boolean,
+        Latest: Schema.Boolean,
+        Since: Schema.String,
+        Before: Schema.String,
+        Limit: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        Filters: Schema.NullOr(Schema.Record(Schema.String, Schema.Record(Schema.String, Schema.Boolean))),
+    },
+    {
+        identifier: "ContainerListOptions",
+        title: "container.ListOptions",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#ListOptions",
+        description: "ListOptions holds parameters to list containers with.",
+    }
+) {}
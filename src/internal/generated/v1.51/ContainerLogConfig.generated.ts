@@ -9,5 +9,6 @@ export class ContainerLogConfig extends Schema.Class<ContainerLogConfig>("Contai
         identifier: "ContainerLogConfig",
         title: "container.LogConfig",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#LogConfig",
+        description: "LogConfig represents the logging configuration of the container.",
     }
 ) {}
@@ -0,0 +1,86 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as PortSchemas from "../../schemas/port.ts";
+import * as NetworkAddress from "./NetworkAddress.generated.ts";
+import * as NetworkEndpointSettings from "./NetworkEndpointSettings.generated.ts";
+
+export class ContainerNetworkSettings extends Schema.Class<ContainerNetworkSettings>("ContainerNetworkSettings")(
+    {
+        Bridge: Schema.String.annotate({
+            description:
+                "Deprecated: This field is only set when the daemon is started with the --bridge flag specified.",
+        }),
+        SandboxID: Schema.String.annotate({ description: "SandboxID uniquely represents a container's network stack" }),
+        SandboxKey: Schema.String.annotate({ description: "SandboxKey identifies the sandbox" }),
+        Ports: Schema.NullOr(PortSchemas.PortMap).annotate({
+            description: "Ports is a collection of PortBinding indexed by Port",
+        }),
+        HairpinMode: Schema.Boolean.annotate({
+            description:
+                "HairpinMode specifies if hairpin NAT should be enabled on the virtual interface\n\nDeprecated: This field is never set and will be removed in a future release.",
+        }),
+        LinkLocalIPv6Address: Schema.String.annotate({
+            description:
+                "LinkLocalIPv6Address is an IPv6 unicast address using the link-local prefix\n\nDeprecated: This field is never set and will be removed in a future release.",
+        }),
+        LinkLocalIPv6PrefixLen: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "LinkLocalIPv6PrefixLen is the prefix length of an IPv6 unicast address\n\nDeprecated: This field is never set and will be removed in a future release.",
+        }),
+        SecondaryIPAddresses: Schema.NullOr(Schema.Array(Schema.NullOr(NetworkAddress.NetworkAddress))).annotate({
+            description: "Deprecated: This field is never set and will be removed in a future release.",
+        }),
+        SecondaryIPv6Addresses: Schema.NullOr(Schema.Array(Schema.NullOr(NetworkAddress.NetworkAddress))).annotate({
+            description: "Deprecated: This field is never set and will be removed in a future release.",
+        }),
+        EndpointID: Schema.String.annotate({
+            description:
+                "EndpointID uniquely represents a service endpoint in a Sandbox\n\nDeprecated: This field will be removed in v29. You should look for the default network in NetworkSettings.Networks instead.",
+        }),
+        Gateway: Schema.String.annotate({
+            description:
+                "Gateway holds the gateway address for the network\n\nDeprecated: This field will be removed in v29. You should look for the default network in NetworkSettings.Networks instead.",
+        }),
+        GlobalIPv6Address: Schema.String.annotate({
+            description:
+                "GlobalIPv6Address holds network's global IPv6 address\n\nDeprecated: This field will be removed in v29. You should look for the default network in NetworkSettings.Networks instead.",
+        }),
+        GlobalIPv6PrefixLen: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "GlobalIPv6PrefixLen represents mask length of network's global IPv6 address\n\nDeprecated: This field will be removed in v29. You should look for the default network in NetworkSettings.Networks instead.",
+        }),
+        IPAddress: Schema.String.annotate({
+            description:
+                "IPAddress holds the IPv4 address for the network\n\nDeprecated: This field will be removed in v29. You should look for the default network in NetworkSettings.Networks instead.",
+        }),
+        IPPrefixLen: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "IPPrefixLen represents mask length of network's IPv4 address\n\nDeprecated: This field will be removed in v29. You should look for the default network in NetworkSettings.Networks instead.",
+        }),
+        IPv6Gateway: Schema.String.annotate({
+            description:
+                "IPv6Gateway holds gateway address specific for IPv6\n\nDeprecated: This field will be removed in v29. You should look for the default network in NetworkSettings.Networks instead.",
+        }),
+        MacAddress: Schema.String.annotate({
+            description:
+                "MacAddress holds the MAC address for the network\n\nDeprecated: This field will be removed in v29. You should look for the default network in NetworkSettings.Networks instead.",
+        }),
+        Networks: Schema.NullOr(
+            Schema.Record(Schema.String, Schema.NullOr(NetworkEndpointSettings.NetworkEndpointSettings))
+        ),
+    },
+    {
+        identifier: "ContainerNetworkSettings",
+        title: "container.NetworkSettings",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#NetworkSettings",
+        description: "NetworkSettings exposes the network settings in the api",
+    }
+) {}
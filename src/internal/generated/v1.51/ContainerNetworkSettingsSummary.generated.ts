@@ -15,5 +15,6 @@ export class ContainerNetworkSettingsSummary extends Schema.Class<ContainerNetwo
         title: "container.NetworkSettingsSummary",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#NetworkSettingsSummary",
+        description: "NetworkSettingsSummary provides a summary of container's networks\nin /containers/json",
     }
 ) {}
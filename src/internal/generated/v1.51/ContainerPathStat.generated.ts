@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class ContainerPathStat extends Schema.Class<ContainerPathStat>("ContainerPathStat")(
     {
@@ -19,5 +19,7 @@ export class ContainerPathStat extends Schema.Class<ContainerPathStat>("Containe
         identifier: "ContainerPathStat",
         title: "container.PathStat",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#PathStat",
+        description:
+            'PathStat is used to encode the header from\nGET "/containers/{name:.*}/archive"\n"Name" is the file or directory name.',
     }
 ) {}
@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerPostContainerExecResizeQuery = Schema.Struct({
+    h: Schema.optional(Schema.Finite),
+    w: Schema.optional(Schema.Finite),
+}).annotate({
+    identifier: "ContainerPostContainerExecResizeQuery",
+    title: "container.postContainerExecResize",
+    description: "Query parameters of POST /exec/{name:.*}/resize",
+});
+
+export type ContainerPostContainerExecResizeQuery = Schema.Schema.Type<typeof ContainerPostContainerExecResizeQuery>;
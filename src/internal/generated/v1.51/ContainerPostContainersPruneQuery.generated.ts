@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerPostContainersPruneQuery = Schema.Struct({
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+}).annotate({
+    identifier: "ContainerPostContainersPruneQuery",
+    title: "container.postContainersPrune",
+    description: "Query parameters of POST /containers/prune",
+});
+
+export type ContainerPostContainersPruneQuery = Schema.Schema.Type<typeof ContainerPostContainersPruneQuery>;
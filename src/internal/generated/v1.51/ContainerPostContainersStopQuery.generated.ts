@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerPostContainersStopQuery = Schema.Struct({
+    signal: Schema.optional(Schema.String),
+    t: Schema.optional(Schema.Finite),
+}).annotate({
+    identifier: "ContainerPostContainersStopQuery",
+    title: "container.postContainersStop",
+    description: "Query parameters of POST /containers/{name:.*}/stop",
+});
+
+export type ContainerPostContainersStopQuery = Schema.Schema.Type<typeof ContainerPostContainersStopQuery>;
@@ -1,10 +1,11 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
+import * as ContainerRestartPolicyMode from "./ContainerRestartPolicyMode.generated.ts";
 
 export class ContainerRestartPolicy extends Schema.Class<ContainerRestartPolicy>("ContainerRestartPolicy")(
     {
-        Name: Schema.Literals(["no", "always", "on-failure", "unless-stopped"]),
+        Name: ContainerRestartPolicyMode.ContainerRestartPolicyMode,
         MaximumRetryCount: MobyNumber.BigIntFromWireString.check(
             Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
         ),
@@ -14,5 +15,6 @@ export class ContainerRestartPolicy extends Schema.Class<ContainerRestartPolicy>
         title: "container.RestartPolicy",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#RestartPolicy",
+        description: "RestartPolicy represents the restart policies of the container.",
     }
 ) {}
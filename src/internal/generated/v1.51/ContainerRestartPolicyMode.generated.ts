@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerRestartPolicyMode = Schema.Literals(["no", "always", "on-failure", "unless-stopped"]).annotate({
+    identifier: "ContainerRestartPolicyMode",
+    title: "container.RestartPolicyMode",
+    documentation:
+        "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#RestartPolicyMode",
+});
+
+export type ContainerRestartPolicyMode = Schema.Schema.Type<typeof ContainerRestartPolicyMode>;
+
+export const ContainerRestartPolicyModeConstants = Object.freeze({
+    RestartPolicyDisabled: "no",
+    RestartPolicyAlways: "always",
+    RestartPolicyOnFailure: "on-failure",
+    RestartPolicyUnlessStopped: "unless-stopped",
+} as const);
@@ -1,11 +1,14 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 import * as ContainerHealth from "./ContainerHealth.generated.ts";
 
 export class ContainerState extends Schema.Class<ContainerState>("ContainerState")(
     {
-        Status: Schema.Literals(["created", "restarting", "running", "removing", "paused", "exited", "dead"]),
+        Status: Schema.String.annotate({
+            description:
+                'String representation of the container state. Can be one of "created", "running", "paused", "restarting", "removing", "exited", or "dead"',
+        }),
         Running: Schema.Boolean,
         Paused: Schema.Boolean,
         Restarting: Schema.Boolean,
@@ -26,5 +29,7 @@ export class ContainerState extends Schema.Class<ContainerState>("ContainerState
         identifier: "ContainerState",
         title: "container.State",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#State",
+        description:
+            "State stores container's running state\nit's part of ContainerJSONBase and returned by \"inspect\" command",
     }
 ) {}
@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export class ContainerStateStatus extends Schema.Class<ContainerStateStatus>("ContainerStateStatus")(
+    {},
+    {
+        identifier: "ContainerStateStatus",
+        title: "container.StateStatus",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#StateStatus",
+        description:
+            "StateStatus is used to return container wait results.\nImplements exec.ExitCode interface.\nThis type is needed as State include a sync.Mutex field which make\ncopying it unsafe.",
+    }
+) {}
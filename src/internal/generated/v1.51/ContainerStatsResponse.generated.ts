@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 import * as ContainerBlkioStats from "./ContainerBlkioStats.generated.ts";
 import * as ContainerCPUStats from "./ContainerCPUStats.generated.ts";
 import * as ContainerMemoryStats from "./ContainerMemoryStats.generated.ts";
@@ -12,18 +12,20 @@ export class ContainerStatsResponse extends Schema.Class<ContainerStatsResponse>
     {
         name: Schema.optional(Schema.String),
         id: Schema.optional(Schema.String),
-        read: Schema.NullOr(Schema.DateFromString),
+        read: Schema.NullOr(Schema.DateFromString).annotate({ description: "Common stats" }),
         preread: Schema.NullOr(Schema.DateFromString),
-        pids_stats: Schema.optional(Schema.NullOr(ContainerPidsStats.ContainerPidsStats)),
-        blkio_stats: Schema.optional(Schema.NullOr(ContainerBlkioStats.ContainerBlkioStats)),
+        pids_stats: Schema.NullOr(ContainerPidsStats.ContainerPidsStats).annotate({
+            description: "Linux specific stats, not populated on Windows.",
+        }),
+        blkio_stats: Schema.NullOr(ContainerBlkioStats.ContainerBlkioStats),
         num_procs: MobyNumber.NumberFromWireString.check(
             Schema.isInt(),
             Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
-        ),
-        storage_stats: Schema.optional(Schema.NullOr(ContainerStorageStats.ContainerStorageStats)),
-        cpu_stats: Schema.optional(Schema.NullOr(ContainerCPUStats.ContainerCPUStats)),
-        precpu_stats: Schema.optional(Schema.NullOr(ContainerCPUStats.ContainerCPUStats)),
-        memory_stats: Schema.optional(Schema.NullOr(ContainerMemoryStats.ContainerMemoryStats)),
+        ).annotate({ description: "Windows specific stats, not populated on Linux." }),
+        storage_stats: Schema.NullOr(ContainerStorageStats.ContainerStorageStats),
+        cpu_stats: Schema.NullOr(ContainerCPUStats.ContainerCPUStats).annotate({ description: "Shared stats" }),
+        precpu_stats: Schema.NullOr(ContainerCPUStats.ContainerCPUStats).annotate({ description: '"Pre"="Previous"' }),
+        memory_stats: Schema.NullOr(ContainerMemoryStats.ContainerMemoryStats),
         networks: Schema.optional(
             Schema.NullOr(Schema.Record(Schema.String, Schema.NullOr(ContainerNetworkStats.ContainerNetworkStats)))
         ),
@@ -33,5 +35,6 @@ export class ContainerStatsResponse extends Schema.Class<ContainerStatsResponse>
         title: "container.StatsResponse",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#StatsResponse",
+        description: "StatsResponse aggregates all types of stats of one container.",
     }
 ) {}
@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class ContainerStorageStats extends Schema.Class<ContainerStorageStats>("ContainerStorageStats")(
     {
@@ -22,5 +22,6 @@ export class ContainerStorageStats extends Schema.Class<ContainerStorageStats>("
         title: "container.StorageStats",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#StorageStats",
+        description: "StorageStats is the disk I/O stats for read/write on Windows.",
     }
 ) {}
@@ -1,10 +1,11 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyIdentifiers from "../schemas/id.ts";
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 import * as ContainerMountPoint from "./ContainerMountPoint.generated.ts";
 import * as ContainerNetworkSettingsSummary from "./ContainerNetworkSettingsSummary.generated.ts";
 import * as ContainerPort from "./ContainerPort.generated.ts";
+import * as ContainerSummaryHostConfig from "./ContainerSummaryHostConfig.generated.ts";
 import * as V1Descriptor from "./V1Descriptor.generated.ts";
 
 export class ContainerSummary extends Schema.Class<ContainerSummary>("ContainerSummary")(
@@ -32,10 +33,7 @@ export class ContainerSummary extends Schema.Class<ContainerSummary>("ContainerS
         Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
         State: Schema.String,
         Status: Schema.String,
-        HostConfig: Schema.Struct({
-            NetworkMode: Schema.optional(Schema.String),
-            Annotations: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.String))),
-        }),
+        HostConfig: ContainerSummaryHostConfig.ContainerSummaryHostConfig,
         NetworkSettings: Schema.NullOr(ContainerNetworkSettingsSummary.ContainerNetworkSettingsSummary),
         Mounts: Schema.NullOr(Schema.Array(Schema.NullOr(ContainerMountPoint.ContainerMountPoint))),
     },
@@ -43,5 +41,6 @@ export class ContainerSummary extends Schema.Class<ContainerSummary>("ContainerS
         identifier: "ContainerSummary",
         title: "container.Summary",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#Summary",
+        description: 'Summary contains response of Engine API:\nGET "/containers/json"',
     }
 ) {}
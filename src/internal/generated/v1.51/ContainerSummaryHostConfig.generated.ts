@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export class ContainerSummaryHostConfig extends Schema.Class<ContainerSummaryHostConfig>("ContainerSummaryHostConfig")(
+    {
+        NetworkMode: Schema.optional(Schema.String),
+        Annotations: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.String))),
+    },
+    {
+        identifier: "ContainerSummaryHostConfig",
+        title: "container.Summary.HostConfig",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#Summary",
+    }
+) {}
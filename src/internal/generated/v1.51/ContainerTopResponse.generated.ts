@@ -2,13 +2,17 @@ import * as Schema from "effect/Schema";
 
 export class ContainerTopResponse extends Schema.Class<ContainerTopResponse>("ContainerTopResponse")(
     {
-        Processes: Schema.NullOr(Schema.Array(Schema.NullOr(Schema.Array(Schema.String)))),
-        Titles: Schema.NullOr(Schema.Array(Schema.String)),
+        Processes: Schema.NullOr(Schema.Array(Schema.NullOr(Schema.Array(Schema.String)))).annotate({
+            description:
+                "Each process running in the container, where each process\nis an array of values corresponding to the titles.",
+        }),
+        Titles: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "The ps column titles" }),
     },
     {
         identifier: "ContainerTopResponse",
         title: "container.TopResponse",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#TopResponse",
+        description: 'TopResponse ContainerTopResponse\n\nContainer "top" response.\nswagger:model TopResponse',
     }
 ) {}
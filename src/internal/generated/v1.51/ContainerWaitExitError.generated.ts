@@ -2,12 +2,13 @@ import * as Schema from "effect/Schema";
 
 export class ContainerWaitExitError extends Schema.Class<ContainerWaitExitError>("ContainerWaitExitError")(
     {
-        Message: Schema.optional(Schema.String),
+        Message: Schema.optional(Schema.String.annotate({ description: "Details of an error" })),
     },
     {
         identifier: "ContainerWaitExitError",
         title: "container.WaitExitError",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#WaitExitError",
+        description: "WaitExitError container waiting error, if any\nswagger:model WaitExitError",
     }
 ) {}
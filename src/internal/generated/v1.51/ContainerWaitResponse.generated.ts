@@ -1,19 +1,23 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 import * as ContainerWaitExitError from "./ContainerWaitExitError.generated.ts";
 
 export class ContainerWaitResponse extends Schema.Class<ContainerWaitResponse>("ContainerWaitResponse")(
     {
-        Error: Schema.optional(Schema.NullOr(ContainerWaitExitError.ContainerWaitExitError)),
+        Error: Schema.optional(
+            Schema.NullOr(ContainerWaitExitError.ContainerWaitExitError).annotate({ description: "error" })
+        ),
         StatusCode: MobyNumber.BigIntFromWireString.check(
             Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
-        ),
+        ).annotate({ description: "Exit code of the container\nRequired: true" }),
     },
     {
         identifier: "ContainerWaitResponse",
         title: "container.WaitResponse",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/container#WaitResponse",
+        description:
+            "WaitResponse ContainerWaitResponse\n\nOK response to ContainerWait operation\nswagger:model WaitResponse",
     }
 ) {}
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export const ContainerWsContainersAttachQuery = Schema.Struct({
+    stdin: Schema.optional(Schema.Boolean),
+    stdout: Schema.optional(Schema.Boolean),
+    stderr: Schema.optional(Schema.Boolean),
+    logs: Schema.optional(Schema.Boolean),
+    stream: Schema.optional(Schema.Boolean),
+    detachKeys: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "ContainerWsContainersAttachQuery",
+    title: "container.wsContainersAttach",
+    description: "Query parameters of GET /containers/{name:.*}/attach/ws",
+});
+
+export type ContainerWsContainersAttachQuery = Schema.Schema.Type<typeof ContainerWsContainersAttachQuery>;
@@ -0,0 +1,108 @@
+import * as Schema from "effect/Schema";
+
+export const EventsAction = Schema.Literals([
+    "create",
+    "start",
+    "restart",
+    "stop",
+    "checkpoint",
+    "pause",
+    "unpause",
+    "attach",
+    "detach",
+    "resize",
+    "update",
+    "rename",
+    "kill",
+    "die",
+    "oom",
+    "destroy",
+    "remove",
+    "commit",
+    "top",
+    "copy",
+    "archive-path",
+    "extract-to-dir",
+    "export",
+    "import",
+    "save",
+    "load",
+    "tag",
+    "untag",
+    "push",
+    "pull",
+    "prune",
+    "delete",
+    "enable",
+    "disable",
+    "connect",
+    "disconnect",
+    "reload",
+    "mount",
+    "unmount",
+    "exec_create",
+    "exec_start",
+    "exec_die",
+    "exec_detach",
+    "health_status",
+    "health_status: running",
+    "health_status: healthy",
+    "health_status: unhealthy",
+]).annotate({
+    identifier: "EventsAction",
+    title: "events.Action",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/events#Action",
+    description: "Action is used for event-actions.",
+});
+
+export type EventsAction = Schema.Schema.Type<typeof EventsAction>;
+
+export const EventsActionConstants = Object.freeze({
+    ActionCreate: "create",
+    ActionStart: "start",
+    ActionRestart: "restart",
+    ActionStop: "stop",
+    ActionCheckpoint: "checkpoint",
+    ActionPause: "pause",
+    ActionUnPause: "unpause",
+    ActionAttach: "attach",
+    ActionDetach: "detach",
+    ActionResize: "resize",
+    ActionUpdate: "update",
+    ActionRename: "rename",
+    ActionKill: "kill",
+    ActionDie: "die",
+    ActionOOM: "oom",
+    ActionDestroy: "destroy",
+    ActionRemove: "remove",
+    ActionCommit: "commit",
+    ActionTop: "top",
+    ActionCopy: "copy",
+    ActionArchivePath: "archive-path",
+    ActionExtractToDir: "extract-to-dir",
+    ActionExport: "export",
+    ActionImport: "import",
+    ActionSave: "save",
+    ActionLoad: "load",
+    ActionTag: "tag",
+    ActionUnTag: "untag",
+    ActionPush: "push",
+    ActionPull: "pull",
+    ActionPrune: "prune",
+    ActionDelete: "delete",
+    ActionEnable: "enable",
+    ActionDisable: "disable",
+    ActionConnect: "connect",
+    ActionDisconnect: "disconnect",
+    ActionReload: "reload",
+    ActionMount: "mount",
+    ActionUnmount: "unmount",
+    ActionExecCreate: "exec_create",
+    ActionExecStart: "exec_start",
+    ActionExecDie: "exec_die",
+    ActionExecDetach: "exec_detach",
+    ActionHealthStatus: "health_status",
+    ActionHealthStatusRunning: "health_status: running",
+    ActionHealthStatusHealthy: "health_status: healthy",
+    ActionHealthStatusUnhealthy: "health_status: unhealthy",
+} as const);
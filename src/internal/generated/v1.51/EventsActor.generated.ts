@@ -9,5 +9,7 @@ export class EventsActor extends Schema.Class<EventsActor>("EventsActor")(
         identifier: "EventsActor",
         title: "events.Actor",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/events#Actor",
+        description:
+            "Actor describes something that generates events,\nlike a container, or a network, or a volume.\nIt has a defined name and a set of attributes.\nThe container attributes are its labels, other actors\ncan generate these attributes from other properties.",
     }
 ) {}
@@ -0,0 +1,43 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as EventsAction from "./EventsAction.generated.ts";
+import * as EventsActor from "./EventsActor.generated.ts";
+import * as EventsType from "./EventsType.generated.ts";
+
+export class EventsMessage extends Schema.Class<EventsMessage>("EventsMessage")(
+    {
+        status: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "Deprecated: use Action instead.\nInformation from JSONMessage.\nWith data only in container events.",
+            })
+        ),
+        id: Schema.optional(Schema.String.annotate({ description: "Deprecated: use Actor.ID instead." })),
+        from: Schema.optional(
+            Schema.String.annotate({ description: 'Deprecated: use Actor.Attributes["image"] instead.' })
+        ),
+        Type: EventsType.EventsType,
+        Action: EventsAction.EventsAction,
+        Actor: Schema.NullOr(EventsActor.EventsActor),
+        scope: Schema.optional(
+            Schema.String.annotate({ description: "Engine events are local scope. Cluster events are swarm scope." })
+        ),
+        time: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            )
+        ),
+        timeNano: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            )
+        ),
+    },
+    {
+        identifier: "EventsMessage",
+        title: "events.Message",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/events#Message",
+        description: "Message represents the information an event contains",
+    }
+) {}
@@ -0,0 +1,36 @@
+import * as Schema from "effect/Schema";
+
+export const EventsType = Schema.Literals([
+    "builder",
+    "config",
+    "container",
+    "daemon",
+    "image",
+    "network",
+    "node",
+    "plugin",
+    "secret",
+    "service",
+    "volume",
+]).annotate({
+    identifier: "EventsType",
+    title: "events.Type",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/events#Type",
+    description: "Type is used for event-types.",
+});
+
+export type EventsType = Schema.Schema.Type<typeof EventsType>;
+
+export const EventsTypeConstants = Object.freeze({
+    BuilderEventType: "builder",
+    ConfigEventType: "config",
+    ContainerEventType: "container",
+    DaemonEventType: "daemon",
+    ImageEventType: "image",
+    NetworkEventType: "network",
+    NodeEventType: "node",
+    PluginEventType: "plugin",
+    SecretEventType: "secret",
+    ServiceEventType: "service",
+    VolumeEventType: "volume",
+} as const);
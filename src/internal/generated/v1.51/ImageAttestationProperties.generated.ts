@@ -1,10 +1,12 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyIdentifiers from "../schemas/id.ts";
+import * as MobyIdentifiers from "../../schemas/id.ts";
 
 export class ImageAttestationProperties extends Schema.Class<ImageAttestationProperties>("ImageAttestationProperties")(
     {
-        For: MobyIdentifiers.Digest,
+        For: MobyIdentifiers.Digest.annotate({
+            description: "For is the digest of the image manifest that this attestation is for.",
+        }),
     },
     {
         identifier: "ImageAttestationProperties",
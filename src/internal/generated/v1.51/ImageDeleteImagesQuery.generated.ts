@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export const ImageDeleteImagesQuery = Schema.Struct({
+    force: Schema.optional(Schema.Boolean),
+    noprune: Schema.optional(Schema.Boolean),
+    platforms: Schema.optional(Schema.Array(Schema.String)),
+}).annotate({
+    identifier: "ImageDeleteImagesQuery",
+    title: "image.deleteImages",
+    description: "Query parameters of DELETE /images/{name:.*}",
+});
+
+export type ImageDeleteImagesQuery = Schema.Schema.Type<typeof ImageDeleteImagesQuery>;
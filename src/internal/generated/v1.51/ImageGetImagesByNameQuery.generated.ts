@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export const ImageGetImagesByNameQuery = Schema.Struct({
+    manifests: Schema.optional(Schema.Boolean),
+    platform: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "ImageGetImagesByNameQuery",
+    title: "image.getImagesByName",
+    description: "Query parameters of GET /images/{name:.*}/json",
+});
+
+export type ImageGetImagesByNameQuery = Schema.Schema.Type<typeof ImageGetImagesByNameQuery>;
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const ImageGetImagesHistoryQuery = Schema.Struct({
+    platform: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "ImageGetImagesHistoryQuery",
+    title: "image.getImagesHistory",
+    description: "Query parameters of GET /images/{name:.*}/history",
+});
+
+export type ImageGetImagesHistoryQuery = Schema.Schema.Type<typeof ImageGetImagesHistoryQuery>;
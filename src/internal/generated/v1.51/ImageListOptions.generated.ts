@@ -0,0 +1,28 @@
+import * as Schema from "effect/Schema";
+
+export class ImageListOptions extends Schema.Class<ImageListOptions>("ImageListOptions")(
+    {
+        All: Schema.Boolean.annotate({
+            description: "All controls whether all images in the graph are filtered, or just\nthe heads.",
+        }),
+        Filters: Schema.NullOr(Schema.Record(Schema.String, Schema.Record(Schema.String, Schema.Boolean))).annotate({
+            description: "Filters is a JSON-encoded set of filter arguments.",
+        }),
+        SharedSize: Schema.Boolean.annotate({
+            description: "SharedSize indicates whether the shared size of images should be computed.",
+        }),
+        ContainerCount: Schema.Boolean.annotate({
+            description:
+                "ContainerCount indicates whether container count should be computed.\n\nDeprecated: This field has been unused and is no longer required and will be removed in a future version.",
+        }),
+        Manifests: Schema.Boolean.annotate({
+            description: "Manifests indicates whether the image manifests should be returned.",
+        }),
+    },
+    {
+        identifier: "ImageListOptions",
+        title: "image.ListOptions",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/image#ListOptions",
+        description: "ListOptions holds parameters to list images with.",
+    }
+) {}
@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+
+export const ImageManifestKind = Schema.Literals(["image", "attestation", "unknown"]).annotate({
+    identifier: "ImageManifestKind",
+    title: "image.ManifestKind",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/image#ManifestKind",
+});
+
+export type ImageManifestKind = Schema.Schema.Type<typeof ImageManifestKind>;
+
+export const ImageManifestKindConstants = Object.freeze({
+    ManifestKindImage: "image",
+    ManifestKindAttestation: "attestation",
+    ManifestKindUnknown: "unknown",
+} as const);
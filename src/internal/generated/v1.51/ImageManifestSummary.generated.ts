@@ -0,0 +1,46 @@
+import * as Schema from "effect/Schema";
+
+import * as ImageAttestationProperties from "./ImageAttestationProperties.generated.ts";
+import * as ImageImageProperties from "./ImageImageProperties.generated.ts";
+import * as ImageManifestKind from "./ImageManifestKind.generated.ts";
+import * as ImageManifestSummarySize from "./ImageManifestSummarySize.generated.ts";
+import * as V1Descriptor from "./V1Descriptor.generated.ts";
+
+export class ImageManifestSummary extends Schema.Class<ImageManifestSummary>("ImageManifestSummary")(
+    {
+        ID: Schema.String.annotate({
+            description:
+                "ID is the content-addressable ID of an image and is the same as the\ndigest of the image manifest.\n\nRequired: true",
+        }),
+        Descriptor: Schema.NullOr(V1Descriptor.V1Descriptor).annotate({
+            description: "Descriptor is the OCI descriptor of the image.\n\nRequired: true",
+        }),
+        Available: Schema.Boolean.annotate({
+            description:
+                "Indicates whether all the child content (image config, layers) is\nfully available locally\n\nRequired: true",
+        }),
+        Size: ImageManifestSummarySize.ImageManifestSummarySize.annotate({
+            description:
+                "Size is the size information of the content related to this manifest.\nNote: These sizes only take the locally available content into account.\n\nRequired: true",
+        }),
+        Kind: ImageManifestKind.ImageManifestKind.annotate({
+            description: "Kind is the kind of the image manifest.\n\nRequired: true",
+        }),
+        ImageData: Schema.optional(
+            Schema.NullOr(ImageImageProperties.ImageImageProperties).annotate({
+                description: "Present only if Kind == ManifestKindImage.",
+            })
+        ),
+        AttestationData: Schema.optional(
+            Schema.NullOr(ImageAttestationProperties.ImageAttestationProperties).annotate({
+                description: "Present only if Kind == ManifestKindAttestation.",
+            })
+        ),
+    },
+    {
+        identifier: "ImageManifestSummary",
+        title: "image.ManifestSummary",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/image#ManifestSummary",
+    }
+) {}
@@ -2,11 +2,14 @@ import * as Schema from "effect/Schema";
 
 export class ImageMetadata extends Schema.Class<ImageMetadata>("ImageMetadata")(
     {
-        LastTagTime: Schema.optional(Schema.NullOr(Schema.DateFromString)),
+        LastTagTime: Schema.NullOr(Schema.DateFromString).annotate({
+            description: "LastTagTime is the date and time at which the image was last tagged.",
+        }),
     },
     {
         identifier: "ImageMetadata",
         title: "image.Metadata",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/image#Metadata",
+        description: "Metadata contains engine-local data about the image.",
     }
 ) {}
@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export const ImagePostImagesLoadQuery = Schema.Struct({
+    platform: Schema.optional(Schema.Array(Schema.String)),
+    quiet: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "ImagePostImagesLoadQuery",
+    title: "image.postImagesLoad",
+    description: "Query parameters of POST /images/load",
+});
+
+export type ImagePostImagesLoadQuery = Schema.Schema.Type<typeof ImagePostImagesLoadQuery>;
@@ -0,0 +1,19 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as ImageDeleteResponse from "./ImageDeleteResponse.generated.ts";
+
+export class ImagePruneReport extends Schema.Class<ImagePruneReport>("ImagePruneReport")(
+    {
+        ImagesDeleted: Schema.NullOr(Schema.Array(Schema.NullOr(ImageDeleteResponse.ImageDeleteResponse))),
+        SpaceReclaimed: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ),
+    },
+    {
+        identifier: "ImagePruneReport",
+        title: "image.PruneReport",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/image#PruneReport",
+        description: 'PruneReport contains the response for Engine API:\nPOST "/images/prune"',
+    }
+) {}
@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+import * as V1Platform from "./V1Platform.generated.ts";
+
+export class ImageRemoveOptions extends Schema.Class<ImageRemoveOptions>("ImageRemoveOptions")(
+    {
+        Platforms: Schema.NullOr(Schema.Array(Schema.NullOr(V1Platform.V1Platform))),
+        Force: Schema.Boolean,
+        PruneChildren: Schema.Boolean,
+    },
+    {
+        identifier: "ImageRemoveOptions",
+        title: "image.RemoveOptions",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/image#RemoveOptions",
+        description: "RemoveOptions holds parameters to remove images.",
+    }
+) {}
@@ -9,5 +9,6 @@ export class ImageRootFS extends Schema.Class<ImageRootFS>("ImageRootFS")(
         identifier: "ImageRootFS",
         title: "image.RootFS",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/image#RootFS",
+        description: "RootFS returns Image's RootFS description including the layer IDs.",
     }
 ) {}
@@ -0,0 +1,54 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as JsonmessageJSONError from "./JsonmessageJSONError.generated.ts";
+import * as JsonmessageJSONProgress from "./JsonmessageJSONProgress.generated.ts";
+
+export class JSONMessage extends Schema.Class<JSONMessage>("JSONMessage")(
+    {
+        stream: Schema.optional(Schema.String),
+        status: Schema.optional(Schema.String),
+        progressDetail: Schema.optional(Schema.NullOr(JsonmessageJSONProgress.JsonmessageJSONProgress)),
+        progress: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "ProgressMessage is a pre-formatted presentation of [Progress].\n\nDeprecated: this field is deprecated since docker v0.7.1 / API v1.8. Use the information in [Progress] instead. This field will be omitted in a future release.",
+            })
+        ),
+        id: Schema.optional(Schema.String),
+        from: Schema.optional(
+            Schema.String.annotate({
+                description: "Deprecated: this field is no longer set in stream responses and should not be used.",
+            })
+        ),
+        time: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({
+                description: "Deprecated: this field is no longer set in stream responses and should not be used.",
+            })
+        ),
+        timeNano: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({
+                description: "Deprecated: this field is no longer set in stream responses and should not be used.",
+            })
+        ),
+        errorDetail: Schema.optional(Schema.NullOr(JsonmessageJSONError.JsonmessageJSONError)),
+        error: Schema.optional(Schema.String.annotate({ description: "deprecated" })),
+        aux: Schema.optional(
+            Schema.NullOr(Schema.Unknown).annotate({
+                description:
+                    "Aux contains out-of-band data, such as digests for push signing and image id after building.",
+            })
+        ),
+    },
+    {
+        identifier: "JSONMessage",
+        title: "jsonmessage.JSONMessage",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/pkg/jsonmessage#JSONMessage",
+        description:
+            "JSONMessage defines a message struct. It describes\nthe created time, where it from, status, ID of the\nmessage. It's used for docker events.",
+    }
+) {}
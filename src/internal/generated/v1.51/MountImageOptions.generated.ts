@@ -7,6 +7,6 @@ export class MountImageOptions extends Schema.Class<MountImageOptions>("MountIma
     {
         identifier: "MountImageOptions",
         title: "mount.ImageOptions",
-        documentation: "",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/mount#ImageOptions",
     }
 ) {}
@@ -2,17 +2,24 @@ import * as Schema from "effect/Schema";
 
 import * as MountBindOptions from "./MountBindOptions.generated.ts";
 import * as MountClusterOptions from "./MountClusterOptions.generated.ts";
+import * as MountConsistency from "./MountConsistency.generated.ts";
 import * as MountImageOptions from "./MountImageOptions.generated.ts";
 import * as MountTmpfsOptions from "./MountTmpfsOptions.generated.ts";
+import * as MountType from "./MountType.generated.ts";
 import * as MountVolumeOptions from "./MountVolumeOptions.generated.ts";
 
 export class MountMount extends Schema.Class<MountMount>("MountMount")(
     {
-        Type: Schema.optional(Schema.Literals(["bind", "volume", "tmpfs", "npipe", "cluster", "image"])),
-        Source: Schema.optional(Schema.String),
+        Type: Schema.optional(MountType.MountType),
+        Source: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "Source specifies the name of the mount. Depending on mount type, this\nmay be a volume name or a host path, or even ignored.\nSource is not supported for tmpfs (must be an empty value)",
+            })
+        ),
         Target: Schema.optional(Schema.String),
-        ReadOnly: Schema.optional(Schema.Boolean),
-        Consistency: Schema.optional(Schema.Literals(["consistent", "cached", "delegated", "default"])),
+        ReadOnly: Schema.optional(Schema.Boolean.annotate({ description: "attempts recursive read-only if possible" })),
+        Consistency: Schema.optional(MountConsistency.MountConsistency),
         BindOptions: Schema.optional(Schema.NullOr(MountBindOptions.MountBindOptions)),
         VolumeOptions: Schema.optional(Schema.NullOr(MountVolumeOptions.MountVolumeOptions)),
         ImageOptions: Schema.optional(Schema.NullOr(MountImageOptions.MountImageOptions)),
@@ -22,6 +29,7 @@ export class MountMount extends Schema.Class<MountMount>("MountMount")(
     {
         identifier: "MountMount",
         title: "mount.Mount",
-        documentation: "",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/mount#Mount",
+        description: "Mount represents a mount (volume).",
     }
 ) {}
@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class NetworkAddress extends Schema.Class<NetworkAddress>("NetworkAddress")(
     {
@@ -13,5 +13,6 @@ export class NetworkAddress extends Schema.Class<NetworkAddress>("NetworkAddress
         identifier: "NetworkAddress",
         title: "network.Address",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/network#Address",
+        description: "Address represents an IP address",
     }
 ) {}
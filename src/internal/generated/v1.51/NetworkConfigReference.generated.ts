@@ -9,5 +9,6 @@ export class NetworkConfigReference extends Schema.Class<NetworkConfigReference>
         title: "network.ConfigReference",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/network#ConfigReference",
+        description: "ConfigReference specifies the source which provides a network's configuration",
     }
 ) {}
@@ -12,5 +12,6 @@ export class NetworkConnectOptions extends Schema.Class<NetworkConnectOptions>("
         title: "network.ConnectOptions",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/network#ConnectOptions",
+        description: "ConnectOptions represents the data to be used to connect a container to the\nnetwork.",
     }
 ) {}
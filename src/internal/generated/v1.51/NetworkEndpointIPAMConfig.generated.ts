@@ -11,5 +11,6 @@ export class NetworkEndpointIPAMConfig extends Schema.Class<NetworkEndpointIPAMC
         title: "network.EndpointIPAMConfig",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/network#EndpointIPAMConfig",
+        description: "EndpointIPAMConfig represents IPAM configurations for the endpoint",
     }
 ) {}
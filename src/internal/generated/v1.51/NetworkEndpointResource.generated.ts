@@ -13,5 +13,6 @@ export class NetworkEndpointResource extends Schema.Class<NetworkEndpointResourc
         title: "network.EndpointResource",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/network#EndpointResource",
+        description: "EndpointResource contains network resources allocated and used for a\ncontainer in a network.",
     }
 ) {}
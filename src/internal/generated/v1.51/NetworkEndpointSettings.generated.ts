@@ -0,0 +1,50 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as NetworkEndpointIPAMConfig from "./NetworkEndpointIPAMConfig.generated.ts";
+
+export class NetworkEndpointSettings extends Schema.Class<NetworkEndpointSettings>("NetworkEndpointSettings")(
+    {
+        IPAMConfig: Schema.NullOr(NetworkEndpointIPAMConfig.NetworkEndpointIPAMConfig).annotate({
+            description: "Configurations",
+        }),
+        Links: Schema.NullOr(Schema.Array(Schema.String)),
+        Aliases: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description: "Aliases holds the list of extra, user-specified DNS names for this endpoint.",
+        }),
+        MacAddress: Schema.String.annotate({
+            description:
+                "MacAddress may be used to specify a MAC address when the container is created.\nOnce the container is running, it becomes operational data (it may contain a\ngenerated address).",
+        }),
+        DriverOpts: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+        GwPriority: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({
+            description:
+                "GwPriority determines which endpoint will provide the default gateway\nfor the container. The endpoint with the highest priority will be used.\nIf multiple endpoints have the same priority, they are lexicographically\nsorted based on their network name, and the one that sorts first is picked.",
+        }),
+        NetworkID: Schema.String.annotate({ description: "Operational data" }),
+        EndpointID: Schema.String,
+        Gateway: Schema.String,
+        IPAddress: Schema.String,
+        IPPrefixLen: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        IPv6Gateway: Schema.String,
+        GlobalIPv6Address: Schema.String,
+        GlobalIPv6PrefixLen: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ),
+        DNSNames: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description:
+                "DNSNames holds all the (non fully qualified) DNS names associated to this endpoint. First entry is used to\ngenerate PTR records.",
+        }),
+    },
+    {
+        identifier: "NetworkEndpointSettings",
+        title: "network.EndpointSettings",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/network#EndpointSettings",
+        description: "EndpointSettings stores the network endpoint details",
+    }
+) {}
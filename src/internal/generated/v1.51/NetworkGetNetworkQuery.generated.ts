@@ -0,0 +1,12 @@
+import * as Schema from "effect/Schema";
+
+export const NetworkGetNetworkQuery = Schema.Struct({
+    verbose: Schema.optional(Schema.Boolean),
+    scope: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "NetworkGetNetworkQuery",
+    title: "network.getNetwork",
+    description: "Query parameters of GET /networks/{id:.+}",
+});
+
+export type NetworkGetNetworkQuery = Schema.Schema.Type<typeof NetworkGetNetworkQuery>;
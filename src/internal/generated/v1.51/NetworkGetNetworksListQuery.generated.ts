@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const NetworkGetNetworksListQuery = Schema.Struct({
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+}).annotate({
+    identifier: "NetworkGetNetworksListQuery",
+    title: "network.getNetworksList",
+    description: "Query parameters of GET /networks, GET /networks/",
+});
+
+export type NetworkGetNetworksListQuery = Schema.Schema.Type<typeof NetworkGetNetworksListQuery>;
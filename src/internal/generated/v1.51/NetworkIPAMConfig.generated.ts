@@ -11,5 +11,6 @@ export class NetworkIPAMConfig extends Schema.Class<NetworkIPAMConfig>("NetworkI
         identifier: "NetworkIPAMConfig",
         title: "network.IPAMConfig",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/network#IPAMConfig",
+        description: "IPAMConfig represents IPAM configurations",
     }
 ) {}
@@ -7,12 +7,16 @@ export class NetworkNetworkingConfig extends Schema.Class<NetworkNetworkingConfi
     {
         EndpointsConfig: Schema.NullOr(
             Schema.Record(Schema.String, Schema.NullOr(NetworkEndpointSettings.NetworkEndpointSettings))
-        ).pipe(Schema.withConstructorDefault(Effect.succeed(null))),
+        )
+            .annotate({ description: "Endpoint configs for each connecting network" })
+            .pipe(Schema.withConstructorDefault(Effect.sync(() => null))),
     },
     {
         identifier: "NetworkNetworkingConfig",
         title: "network.NetworkingConfig",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/network#NetworkingConfig",
+        description:
+            "NetworkingConfig represents the container's networking configuration for each of its interfaces\nCarries the networking configs specified in the `docker run` and `docker network connect` commands",
     }
 ) {}
@@ -9,5 +9,6 @@ export class NetworkPeerInfo extends Schema.Class<NetworkPeerInfo>("NetworkPeerI
         identifier: "NetworkPeerInfo",
         title: "network.PeerInfo",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/network#PeerInfo",
+        description: "PeerInfo represents one peer of an overlay network",
     }
 ) {}
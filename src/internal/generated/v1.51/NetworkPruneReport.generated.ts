@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export class NetworkPruneReport extends Schema.Class<NetworkPruneReport>("NetworkPruneReport")(
+    {
+        NetworksDeleted: Schema.NullOr(Schema.Array(Schema.String)),
+    },
+    {
+        identifier: "NetworkPruneReport",
+        title: "network.PruneReport",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/network#PruneReport",
+        description: 'PruneReport contains the response for Engine API:\nPOST "/networks/prune"',
+    }
+) {}
@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 import * as NetworkTask from "./NetworkTask.generated.ts";
 
 export class NetworkServiceInfo extends Schema.Class<NetworkServiceInfo>("NetworkServiceInfo")(
@@ -16,5 +16,6 @@ export class NetworkServiceInfo extends Schema.Class<NetworkServiceInfo>("Networ
         identifier: "NetworkServiceInfo",
         title: "network.ServiceInfo",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/network#ServiceInfo",
+        description: "ServiceInfo represents service parameters with the list of service's tasks",
     }
 ) {}
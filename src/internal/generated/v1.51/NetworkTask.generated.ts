@@ -11,5 +11,6 @@ export class NetworkTask extends Schema.Class<NetworkTask>("NetworkTask")(
         identifier: "NetworkTask",
         title: "network.Task",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/network#Task",
+        description: "Task carries the information about one backend task",
     }
 ) {}
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const PluginDisablePluginQuery = Schema.Struct({
+    force: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "PluginDisablePluginQuery",
+    title: "plugin.disablePlugin",
+    description: "Query parameters of POST /plugins/{name:.*}/disable",
+});
+
+export type PluginDisablePluginQuery = Schema.Schema.Type<typeof PluginDisablePluginQuery>;
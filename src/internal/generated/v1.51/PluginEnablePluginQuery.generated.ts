@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const PluginEnablePluginQuery = Schema.Struct({
+    timeout: Schema.optional(Schema.Finite),
+}).annotate({
+    identifier: "PluginEnablePluginQuery",
+    title: "plugin.enablePlugin",
+    description: "Query parameters of POST /plugins/{name:.*}/enable",
+});
+
+export type PluginEnablePluginQuery = Schema.Schema.Type<typeof PluginEnablePluginQuery>;
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const PluginGetPrivilegesQuery = Schema.Struct({
+    remote: Schema.optional(Schema.String),
+}).annotate({
+    identifier: "PluginGetPrivilegesQuery",
+    title: "plugin.getPrivileges",
+    description: "Query parameters of GET /plugins/privileges",
+});
+
+export type PluginGetPrivilegesQuery = Schema.Schema.Type<typeof PluginGetPrivilegesQuery>;
@@ -2,13 +2,16 @@ import * as Schema from "effect/Schema";
 
 export class RegistryAuthenticateOKBody extends Schema.Class<RegistryAuthenticateOKBody>("RegistryAuthenticateOKBody")(
     {
-        IdentityToken: Schema.String,
-        Status: Schema.String,
+        IdentityToken: Schema.String.annotate({
+            description: "An opaque token used to authenticate a user after a successful login\nRequired: true",
+        }),
+        Status: Schema.String.annotate({ description: "The status of the authentication\nRequired: true" }),
     },
     {
         identifier: "RegistryAuthenticateOKBody",
         title: "registry.AuthenticateOKBody",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/registry#AuthenticateOKBody",
+        description: "AuthenticateOKBody authenticate o k body\nswagger:model AuthenticateOKBody",
     }
 ) {}
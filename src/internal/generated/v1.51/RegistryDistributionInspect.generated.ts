@@ -7,13 +7,21 @@ export class RegistryDistributionInspect extends Schema.Class<RegistryDistributi
     "RegistryDistributionInspect"
 )(
     {
-        Descriptor: Schema.NullOr(V1Descriptor.V1Descriptor),
-        Platforms: Schema.NullOr(Schema.Array(Schema.NullOr(V1Platform.V1Platform))),
+        Descriptor: Schema.NullOr(V1Descriptor.V1Descriptor).annotate({
+            description:
+                "Descriptor contains information about the manifest, including\nthe content addressable digest",
+        }),
+        Platforms: Schema.NullOr(Schema.Array(Schema.NullOr(V1Platform.V1Platform))).annotate({
+            description:
+                "Platforms contains the list of platforms supported by the image,\nobtained by parsing the manifest",
+        }),
     },
     {
         identifier: "RegistryDistributionInspect",
         title: "registry.DistributionInspect",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/registry#DistributionInspect",
+        description:
+            "DistributionInspect describes the result obtained from contacting the\nregistry to retrieve image metadata",
     }
 ) {}
@@ -0,0 +1,32 @@
+import * as Schema from "effect/Schema";
+
+import * as EffectSchemas from "effect-schemas";
+
+import * as RegistryIndexInfo from "./RegistryIndexInfo.generated.ts";
+
+export class RegistryServiceConfig extends Schema.Class<RegistryServiceConfig>("RegistryServiceConfig")(
+    {
+        AllowNondistributableArtifactsCIDRs: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.NullOr(EffectSchemas.Internet.CidrBlockFromString))).annotate({
+                description:
+                    "Deprecated: non-distributable artifacts are deprecated and enabled by default. This field will be removed in the next release.",
+            })
+        ),
+        AllowNondistributableArtifactsHostnames: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description:
+                    "Deprecated: non-distributable artifacts are deprecated and enabled by default. This field will be removed in the next release.",
+            })
+        ),
+        InsecureRegistryCIDRs: Schema.NullOr(Schema.Array(Schema.NullOr(EffectSchemas.Internet.CidrBlockFromString))),
+        IndexConfigs: Schema.NullOr(Schema.Record(Schema.String, Schema.NullOr(RegistryIndexInfo.RegistryIndexInfo))),
+        Mirrors: Schema.NullOr(Schema.Array(Schema.String)),
+    },
+    {
+        identifier: "RegistryServiceConfig",
+        title: "registry.ServiceConfig",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/registry#ServiceConfig",
+        description: "ServiceConfig stores daemon registry services configuration.",
+    }
+) {}
@@ -0,0 +1,23 @@
+import * as Schema from "effect/Schema";
+
+import * as RuntimePluginPrivilege from "./RuntimePluginPrivilege.generated.ts";
+
+export class RuntimePluginSpec extends Schema.Class<RuntimePluginSpec>("RuntimePluginSpec")(
+    {
+        name: Schema.optional(Schema.String),
+        remote: Schema.optional(Schema.String),
+        privileges: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.NullOr(RuntimePluginPrivilege.RuntimePluginPrivilege)))
+        ),
+        disabled: Schema.optional(Schema.Boolean),
+        env: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
+    },
+    {
+        identifier: "RuntimePluginSpec",
+        title: "runtime.PluginSpec",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm/runtime#PluginSpec",
+        description:
+            "PluginSpec defines the base payload which clients can specify for creating\na service with the plugin runtime.",
+    }
+) {}
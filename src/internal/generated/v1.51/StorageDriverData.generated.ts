@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+export class StorageDriverData extends Schema.Class<StorageDriverData>("StorageDriverData")(
+    {
+        Data: Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+            description:
+                "Low-level storage metadata, provided as key/value pairs.\n\nThis information is driver-specific, and depends on the storage-driver\nin use, and should be used for informational purposes only.\n\nRequired: true",
+        }),
+        Name: Schema.String.annotate({ description: "Name of the storage driver.\nRequired: true" }),
+    },
+    {
+        identifier: "StorageDriverData",
+        title: "storage.DriverData",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/storage#DriverData",
+        description:
+            "DriverData Information about the storage driver used to store the container's and\nimage's filesystem.\n\nswagger:model DriverData",
+    }
+) {}
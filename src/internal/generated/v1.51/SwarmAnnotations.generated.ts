@@ -9,5 +9,6 @@ export class SwarmAnnotations extends Schema.Class<SwarmAnnotations>("SwarmAnnot
         identifier: "SwarmAnnotations",
         title: "swarm.Annotations",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#Annotations",
+        description: "Annotations represents how to describe an object.",
     }
 ) {}
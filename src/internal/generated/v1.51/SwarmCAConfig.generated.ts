@@ -0,0 +1,41 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as SwarmExternalCA from "./SwarmExternalCA.generated.ts";
+
+export class SwarmCAConfig extends Schema.Class<SwarmCAConfig>("SwarmCAConfig")(
+    {
+        NodeCertExpiry: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({ description: "NodeCertExpiry is the duration certificates should be issued for" })
+        ),
+        ExternalCAs: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.NullOr(SwarmExternalCA.SwarmExternalCA))).annotate({
+                description:
+                    "ExternalCAs is a list of CAs to which a manager node will make\ncertificate signing requests for node certificates.",
+            })
+        ),
+        SigningCACert: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "SigningCACert and SigningCAKey specify the desired signing root CA and\nroot CA key for the swarm.  When inspecting the cluster, the key will\nbe redacted.",
+            })
+        ),
+        SigningCAKey: Schema.optional(Schema.String),
+        ForceRotate: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+            ).annotate({
+                description:
+                    "If this value changes, and there is no specified signing cert and key,\nthen the swarm is forced to generate a new root certificate and key.",
+            })
+        ),
+    },
+    {
+        identifier: "SwarmCAConfig",
+        title: "swarm.CAConfig",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#CAConfig",
+        description: "CAConfig represents CA configuration.",
+    }
+) {}
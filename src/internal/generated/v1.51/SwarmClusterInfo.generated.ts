@@ -1,14 +1,16 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
-import * as SwarmMeta from "./SwarmMeta.generated.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 import * as SwarmSpec from "./SwarmSpec.generated.ts";
 import * as SwarmTLSInfo from "./SwarmTLSInfo.generated.ts";
+import * as SwarmVersion from "./SwarmVersion.generated.ts";
 
 export class SwarmClusterInfo extends Schema.Class<SwarmClusterInfo>("SwarmClusterInfo")(
     {
         ID: Schema.String,
-        ...SwarmMeta.SwarmMeta.fields,
+        Version: Schema.NullOr(SwarmVersion.SwarmVersion),
+        CreatedAt: Schema.NullOr(Schema.DateFromString),
+        UpdatedAt: Schema.NullOr(Schema.DateFromString),
         Spec: Schema.NullOr(SwarmSpec.SwarmSpec),
         TLSInfo: Schema.NullOr(SwarmTLSInfo.SwarmTLSInfo),
         RootRotationInProgress: Schema.Boolean,
@@ -26,5 +28,7 @@ export class SwarmClusterInfo extends Schema.Class<SwarmClusterInfo>("SwarmClust
         identifier: "SwarmClusterInfo",
         title: "swarm.ClusterInfo",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ClusterInfo",
+        description:
+            'ClusterInfo represents info about the cluster for outputting in "info"\nit contains the same information as "Swarm", but without the JoinTokens',
     }
 ) {}
@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmConfigListOptions extends Schema.Class<SwarmConfigListOptions>("SwarmConfigListOptions")(
+    {
+        Filters: Schema.NullOr(Schema.Record(Schema.String, Schema.Record(Schema.String, Schema.Boolean))),
+    },
+    {
+        identifier: "SwarmConfigListOptions",
+        title: "swarm.ConfigListOptions",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ConfigListOptions",
+        description: "ConfigListOptions holds parameters to list configs",
+    }
+) {}
@@ -15,5 +15,6 @@ export class SwarmConfigReference extends Schema.Class<SwarmConfigReference>("Sw
         title: "swarm.ConfigReference",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ConfigReference",
+        description: "ConfigReference is a reference to a config in swarm",
     }
 ) {}
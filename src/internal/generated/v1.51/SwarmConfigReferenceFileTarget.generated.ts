@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class SwarmConfigReferenceFileTarget extends Schema.Class<SwarmConfigReferenceFileTarget>(
     "SwarmConfigReferenceFileTarget"
@@ -19,5 +19,6 @@ export class SwarmConfigReferenceFileTarget extends Schema.Class<SwarmConfigRefe
         title: "swarm.ConfigReferenceFileTarget",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ConfigReferenceFileTarget",
+        description: "ConfigReferenceFileTarget is a file target in a config reference",
     }
 ) {}
@@ -9,5 +9,7 @@ export class SwarmConfigReferenceRuntimeTarget extends Schema.Class<SwarmConfigR
         title: "swarm.ConfigReferenceRuntimeTarget",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ConfigReferenceRuntimeTarget",
+        description:
+            "ConfigReferenceRuntimeTarget is a target for a config specifying that it\nisn't mounted into the container but instead has some other purpose.",
     }
 ) {}
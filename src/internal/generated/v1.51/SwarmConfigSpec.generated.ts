@@ -0,0 +1,28 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmDriver from "./SwarmDriver.generated.ts";
+
+export class SwarmConfigSpec extends Schema.Class<SwarmConfigSpec>("SwarmConfigSpec")(
+    {
+        Name: Schema.optional(Schema.String),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+        Data: Schema.optional(
+            Schema.NullOr(Schema.Uint8ArrayFromBase64).annotate({
+                description:
+                    "Data is the data to store as a config.\n\nThe maximum allowed size is 1000KB, as defined in [MaxConfigSize].\n\n[MaxConfigSize]: https://pkg.go.dev/github.com/moby/swarmkit/v2@v2.0.0-20250103191802-8c1959736554/manager/controlapi#MaxConfigSize",
+            })
+        ),
+        Templating: Schema.optional(
+            Schema.NullOr(SwarmDriver.SwarmDriver).annotate({
+                description:
+                    "Templating controls whether and how to evaluate the config payload as\na template. If it is not set, no templating is used.",
+            })
+        ),
+    },
+    {
+        identifier: "SwarmConfigSpec",
+        title: "swarm.ConfigSpec",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ConfigSpec",
+        description: "ConfigSpec represents a config specification from a config in swarm",
+    }
+) {}
@@ -1,6 +1,7 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
+import * as ContainerIsolation from "./ContainerIsolation.generated.ts";
 import * as MountMount from "./MountMount.generated.ts";
 import * as SwarmConfigReference from "./SwarmConfigReference.generated.ts";
 import * as SwarmDNSConfig from "./SwarmDNSConfig.generated.ts";
@@ -35,11 +36,16 @@ export class SwarmContainerSpec extends Schema.Class<SwarmContainerSpec>("SwarmC
             )
         ),
         Healthcheck: Schema.optional(Schema.NullOr(V1HealthcheckConfig.V1HealthcheckConfig)),
-        Hosts: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
+        Hosts: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description:
+                    "The format of extra hosts on swarmkit is specified in:\nhttp://man7.org/linux/man-pages/man5/hosts.5.html\n   IP_address canonical_hostname [aliases...]",
+            })
+        ),
         DNSConfig: Schema.optional(Schema.NullOr(SwarmDNSConfig.SwarmDNSConfig)),
         Secrets: Schema.optional(Schema.NullOr(Schema.Array(Schema.NullOr(SwarmSecretReference.SwarmSecretReference)))),
         Configs: Schema.optional(Schema.NullOr(Schema.Array(Schema.NullOr(SwarmConfigReference.SwarmConfigReference)))),
-        Isolation: Schema.optional(Schema.Literals(["", "default", "process", "hyperv"])),
+        Isolation: Schema.optional(ContainerIsolation.ContainerIsolation),
         Sysctls: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.String))),
         CapabilityAdd: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
         CapabilityDrop: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
@@ -54,5 +60,6 @@ export class SwarmContainerSpec extends Schema.Class<SwarmContainerSpec>("SwarmC
         identifier: "SwarmContainerSpec",
         title: "swarm.ContainerSpec",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ContainerSpec",
+        description: "ContainerSpec represents the spec of a container.",
     }
 ) {}
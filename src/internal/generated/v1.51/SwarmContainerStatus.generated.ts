@@ -1,7 +1,7 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyIdentifiers from "../schemas/id.ts";
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class SwarmContainerStatus extends Schema.Class<SwarmContainerStatus>("SwarmContainerStatus")(
     {
@@ -18,5 +18,6 @@ export class SwarmContainerStatus extends Schema.Class<SwarmContainerStatus>("Sw
         title: "swarm.ContainerStatus",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ContainerStatus",
+        description: "ContainerStatus represents the status of a container.",
     }
 ) {}
@@ -11,5 +11,6 @@ export class SwarmCredentialSpec extends Schema.Class<SwarmCredentialSpec>("Swar
         title: "swarm.CredentialSpec",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#CredentialSpec",
+        description: "CredentialSpec for managed service account (Windows only)",
     }
 ) {}
@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class SwarmDiscreteGenericResource extends Schema.Class<SwarmDiscreteGenericResource>(
     "SwarmDiscreteGenericResource"
@@ -18,5 +18,7 @@ export class SwarmDiscreteGenericResource extends Schema.Class<SwarmDiscreteGene
         title: "swarm.DiscreteGenericResource",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#DiscreteGenericResource",
+        description:
+            'DiscreteGenericResource represents a "user defined" resource which is defined\nas an integer\n"Kind" is used to describe the Kind of a resource (e.g: "GPU", "FPGA", "SSD", ...)\nValue is used to count the resource (SSD=5, HDD=3, ...)',
     }
 ) {}
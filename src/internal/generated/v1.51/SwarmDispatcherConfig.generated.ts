@@ -1,13 +1,15 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class SwarmDispatcherConfig extends Schema.Class<SwarmDispatcherConfig>("SwarmDispatcherConfig")(
     {
         HeartbeatPeriod: Schema.optional(
             MobyNumber.BigIntFromWireString.check(
                 Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
-            )
+            ).annotate({
+                description: "HeartbeatPeriod defines how often agent should send heartbeats to\ndispatcher.",
+            })
         ),
     },
     {
@@ -15,5 +17,6 @@ export class SwarmDispatcherConfig extends Schema.Class<SwarmDispatcherConfig>("
         title: "swarm.DispatcherConfig",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#DispatcherConfig",
+        description: "DispatcherConfig represents dispatcher configuration.",
     }
 ) {}
@@ -9,5 +9,6 @@ export class SwarmDriver extends Schema.Class<SwarmDriver>("SwarmDriver")(
         identifier: "SwarmDriver",
         title: "swarm.Driver",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#Driver",
+        description: "Driver represents a driver (network, logging, secrets backend).",
     }
 ) {}
@@ -6,7 +6,7 @@ import * as SwarmPortConfig from "./SwarmPortConfig.generated.ts";
 
 export class SwarmEndpoint extends Schema.Class<SwarmEndpoint>("SwarmEndpoint")(
     {
-        Spec: Schema.optional(Schema.NullOr(SwarmEndpointSpec.SwarmEndpointSpec)),
+        Spec: Schema.NullOr(SwarmEndpointSpec.SwarmEndpointSpec),
         Ports: Schema.optional(Schema.NullOr(Schema.Array(Schema.NullOr(SwarmPortConfig.SwarmPortConfig)))),
         VirtualIPs: Schema.optional(
             Schema.NullOr(Schema.Array(Schema.NullOr(SwarmEndpointVirtualIP.SwarmEndpointVirtualIP)))
@@ -16,5 +16,6 @@ export class SwarmEndpoint extends Schema.Class<SwarmEndpoint>("SwarmEndpoint")(
         identifier: "SwarmEndpoint",
         title: "swarm.Endpoint",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#Endpoint",
+        description: "Endpoint represents an endpoint.",
     }
 ) {}
@@ -10,5 +10,6 @@ export class SwarmEndpointVirtualIP extends Schema.Class<SwarmEndpointVirtualIP>
         title: "swarm.EndpointVirtualIP",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#EndpointVirtualIP",
+        description: "EndpointVirtualIP represents the virtual ip of a port.",
     }
 ) {}
@@ -15,5 +15,6 @@ export class SwarmEngineDescription extends Schema.Class<SwarmEngineDescription>
         title: "swarm.EngineDescription",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#EngineDescription",
+        description: "EngineDescription represents the description of an engine.",
     }
 ) {}
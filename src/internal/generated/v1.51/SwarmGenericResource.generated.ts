@@ -13,5 +13,7 @@ export class SwarmGenericResource extends Schema.Class<SwarmGenericResource>("Sw
         title: "swarm.GenericResource",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#GenericResource",
+        description:
+            'GenericResource represents a "user defined" resource which can\nbe either an integer (e.g: SSD=3) or a string (e.g: SSD=sda1)',
     }
 ) {}
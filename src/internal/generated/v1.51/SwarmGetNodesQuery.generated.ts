@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmGetNodesQuery = Schema.Struct({
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+}).annotate({
+    identifier: "SwarmGetNodesQuery",
+    title: "swarm.getNodes",
+    description: "Query parameters of GET /nodes",
+});
+
+export type SwarmGetNodesQuery = Schema.Schema.Type<typeof SwarmGetNodesQuery>;
@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmGetServiceLogsQuery = Schema.Struct({
+    stdout: Schema.optional(Schema.Boolean),
+    stderr: Schema.optional(Schema.Boolean),
+    follow: Schema.optional(Schema.Boolean),
+    timestamps: Schema.optional(Schema.Boolean),
+    since: Schema.optional(Schema.String),
+    tail: Schema.optional(Schema.String),
+    details: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "SwarmGetServiceLogsQuery",
+    title: "swarm.getServiceLogs",
+    description: "Query parameters of GET /services/{id}/logs",
+});
+
+export type SwarmGetServiceLogsQuery = Schema.Schema.Type<typeof SwarmGetServiceLogsQuery>;
@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmGetTaskLogsQuery = Schema.Struct({
+    stdout: Schema.optional(Schema.Boolean),
+    stderr: Schema.optional(Schema.Boolean),
+    follow: Schema.optional(Schema.Boolean),
+    timestamps: Schema.optional(Schema.Boolean),
+    since: Schema.optional(Schema.String),
+    tail: Schema.optional(Schema.String),
+    details: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "SwarmGetTaskLogsQuery",
+    title: "swarm.getTaskLogs",
+    description: "Query parameters of GET /tasks/{id}/logs",
+});
+
+export type SwarmGetTaskLogsQuery = Schema.Schema.Type<typeof SwarmGetTaskLogsQuery>;
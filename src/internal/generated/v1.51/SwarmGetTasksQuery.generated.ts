@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmGetTasksQuery = Schema.Struct({
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+}).annotate({
+    identifier: "SwarmGetTasksQuery",
+    title: "swarm.getTasks",
+    description: "Query parameters of GET /tasks",
+});
+
+export type SwarmGetTasksQuery = Schema.Schema.Type<typeof SwarmGetTasksQuery>;
@@ -6,5 +6,7 @@ export class SwarmGlobalJob extends Schema.Class<SwarmGlobalJob>("SwarmGlobalJob
         identifier: "SwarmGlobalJob",
         title: "swarm.GlobalJob",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#GlobalJob",
+        description:
+            "GlobalJob is the type of a Service which executes a Task on every Node\nmatching the Service's placement constraints. These tasks run to completion\nand then exit.\n\nThis type is deliberately empty.",
     }
 ) {}
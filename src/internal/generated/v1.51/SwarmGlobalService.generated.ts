@@ -6,5 +6,6 @@ export class SwarmGlobalService extends Schema.Class<SwarmGlobalService>("SwarmG
         identifier: "SwarmGlobalService",
         title: "swarm.GlobalService",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#GlobalService",
+        description: "GlobalService is a kind of ServiceMode.",
     }
 ) {}
@@ -10,5 +10,6 @@ export class SwarmIPAMConfig extends Schema.Class<SwarmIPAMConfig>("SwarmIPAMCon
         identifier: "SwarmIPAMConfig",
         title: "swarm.IPAMConfig",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#IPAMConfig",
+        description: "IPAMConfig represents ipam configuration.",
     }
 ) {}
@@ -1,15 +1,16 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyIdentifiers from "../schemas/id.ts";
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 import * as SwarmClusterInfo from "./SwarmClusterInfo.generated.ts";
+import * as SwarmLocalNodeState from "./SwarmLocalNodeState.generated.ts";
 import * as SwarmPeer from "./SwarmPeer.generated.ts";
 
 export class SwarmInfo extends Schema.Class<SwarmInfo>("SwarmInfo")(
     {
         NodeID: MobyIdentifiers.NodeIdentifier,
         NodeAddr: Schema.String,
-        LocalNodeState: Schema.Literals(["inactive", "pending", "active", "error", "locked"]),
+        LocalNodeState: SwarmLocalNodeState.SwarmLocalNodeState,
         ControlAvailable: Schema.Boolean,
         Error: Schema.String,
         RemoteManagers: Schema.NullOr(Schema.Array(Schema.NullOr(SwarmPeer.SwarmPeer))),
@@ -30,5 +31,6 @@ export class SwarmInfo extends Schema.Class<SwarmInfo>("SwarmInfo")(
         identifier: "SwarmInfo",
         title: "swarm.Info",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#Info",
+        description: "Info represents generic information about swarm.",
     }
 ) {}
@@ -1,17 +1,20 @@
 import * as Schema from "effect/Schema";
 
+import * as SwarmNodeAvailability from "./SwarmNodeAvailability.generated.ts";
+
 export class SwarmJoinRequest extends Schema.Class<SwarmJoinRequest>("SwarmJoinRequest")(
     {
         ListenAddr: Schema.String,
         AdvertiseAddr: Schema.String,
         DataPathAddr: Schema.String,
         RemoteAddrs: Schema.NullOr(Schema.Array(Schema.String)),
-        JoinToken: Schema.String,
-        Availability: Schema.Literals(["active", "pause", "drain"]),
+        JoinToken: Schema.String.annotate({ description: "accept by secret" }),
+        Availability: SwarmNodeAvailability.SwarmNodeAvailability,
     },
     {
         identifier: "SwarmJoinRequest",
         title: "swarm.JoinRequest",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#JoinRequest",
+        description: "JoinRequest is the request used to join a swarm.",
     }
 ) {}
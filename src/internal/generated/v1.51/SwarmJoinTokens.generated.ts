@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmJoinTokens extends Schema.Class<SwarmJoinTokens>("SwarmJoinTokens")(
+    {
+        Worker: Schema.String.annotate({ description: "Worker is the join token workers may use to join the swarm." }),
+        Manager: Schema.String.annotate({
+            description: "Manager is the join token managers may use to join the swarm.",
+        }),
+    },
+    {
+        identifier: "SwarmJoinTokens",
+        title: "swarm.JoinTokens",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#JoinTokens",
+        description: "JoinTokens contains the tokens workers and managers need to join the swarm.",
+    }
+) {}
@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class SwarmLimit extends Schema.Class<SwarmLimit>("SwarmLimit")(
     {
@@ -24,5 +24,6 @@ export class SwarmLimit extends Schema.Class<SwarmLimit>("SwarmLimit")(
         identifier: "SwarmLimit",
         title: "swarm.Limit",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#Limit",
+        description: "Limit describes limits on resources which can be requested by a task.",
     }
 ) {}
@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmLocalNodeState = Schema.Literals(["inactive", "pending", "active", "error", "locked"]).annotate({
+    identifier: "SwarmLocalNodeState",
+    title: "swarm.LocalNodeState",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#LocalNodeState",
+    description: "LocalNodeState represents the state of the local node.",
+});
+
+export type SwarmLocalNodeState = Schema.Schema.Type<typeof SwarmLocalNodeState>;
+
+export const SwarmLocalNodeStateConstants = Object.freeze({
+    LocalNodeStateInactive: "inactive",
+    LocalNodeStatePending: "pending",
+    LocalNodeStateActive: "active",
+    LocalNodeStateError: "error",
+    LocalNodeStateLocked: "locked",
+} as const);
@@ -10,5 +10,7 @@ export class SwarmNamedGenericResource extends Schema.Class<SwarmNamedGenericRes
         title: "swarm.NamedGenericResource",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#NamedGenericResource",
+        description:
+            'NamedGenericResource represents a "user defined" resource which is defined\nas a string.\n"Kind" is used to describe the Kind of a resource (e.g: "GPU", "FPGA", "SSD", ...)\nValue is used to identify the resource (GPU="UUID-1", FPGA="/dev/sdb5", ...)',
     }
 ) {}
@@ -4,7 +4,7 @@ import * as SwarmNetwork from "./SwarmNetwork.generated.ts";
 
 export class SwarmNetworkAttachment extends Schema.Class<SwarmNetworkAttachment>("SwarmNetworkAttachment")(
     {
-        Network: Schema.optional(Schema.NullOr(SwarmNetwork.SwarmNetwork)),
+        Network: Schema.NullOr(SwarmNetwork.SwarmNetwork),
         Addresses: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
     },
     {
@@ -12,5 +12,6 @@ export class SwarmNetworkAttachment extends Schema.Class<SwarmNetworkAttachment>
         title: "swarm.NetworkAttachment",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#NetworkAttachment",
+        description: "NetworkAttachment represents a network attachment.",
     }
 ) {}
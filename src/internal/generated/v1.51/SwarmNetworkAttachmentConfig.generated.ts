@@ -13,5 +13,6 @@ export class SwarmNetworkAttachmentConfig extends Schema.Class<SwarmNetworkAttac
         title: "swarm.NetworkAttachmentConfig",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#NetworkAttachmentConfig",
+        description: "NetworkAttachmentConfig represents the configuration of a network attachment.",
     }
 ) {}
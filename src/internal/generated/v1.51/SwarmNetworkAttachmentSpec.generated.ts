@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyIdentifiers from "../schemas/id.ts";
+import * as MobyIdentifiers from "../../schemas/id.ts";
 
 export class SwarmNetworkAttachmentSpec extends Schema.Class<SwarmNetworkAttachmentSpec>("SwarmNetworkAttachmentSpec")(
     {
@@ -11,5 +11,6 @@ export class SwarmNetworkAttachmentSpec extends Schema.Class<SwarmNetworkAttachm
         title: "swarm.NetworkAttachmentSpec",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#NetworkAttachmentSpec",
+        description: "NetworkAttachmentSpec represents the runtime spec type for network\nattachment tasks",
     }
 ) {}
@@ -1,13 +1,13 @@
 import * as Schema from "effect/Schema";
 
 import * as NetworkConfigReference from "./NetworkConfigReference.generated.ts";
-import * as SwarmAnnotations from "./SwarmAnnotations.generated.ts";
 import * as SwarmDriver from "./SwarmDriver.generated.ts";
 import * as SwarmIPAMOptions from "./SwarmIPAMOptions.generated.ts";
 
 export class SwarmNetworkSpec extends Schema.Class<SwarmNetworkSpec>("SwarmNetworkSpec")(
     {
-        ...SwarmAnnotations.SwarmAnnotations.fields,
+        Name: Schema.optional(Schema.String),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
         DriverConfiguration: Schema.optional(Schema.NullOr(SwarmDriver.SwarmDriver)),
         IPv6Enabled: Schema.optional(Schema.Boolean),
         Internal: Schema.optional(Schema.Boolean),
@@ -21,5 +21,6 @@ export class SwarmNetworkSpec extends Schema.Class<SwarmNetworkSpec>("SwarmNetwo
         identifier: "SwarmNetworkSpec",
         title: "swarm.NetworkSpec",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#NetworkSpec",
+        description: "NetworkSpec represents the spec of a network.",
     }
 ) {}
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmNodeAvailability = Schema.Literals(["active", "pause", "drain"]).annotate({
+    identifier: "SwarmNodeAvailability",
+    title: "swarm.NodeAvailability",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#NodeAvailability",
+    description: "NodeAvailability represents the availability of a node.",
+});
+
+export type SwarmNodeAvailability = Schema.Schema.Type<typeof SwarmNodeAvailability>;
+
+export const SwarmNodeAvailabilityConstants = Object.freeze({
+    NodeAvailabilityActive: "active",
+    NodeAvailabilityPause: "pause",
+    NodeAvailabilityDrain: "drain",
+} as const);
@@ -9,10 +9,10 @@ import * as SwarmTLSInfo from "./SwarmTLSInfo.generated.ts";
 export class SwarmNodeDescription extends Schema.Class<SwarmNodeDescription>("SwarmNodeDescription")(
     {
         Hostname: Schema.optional(Schema.String),
-        Platform: Schema.optional(Schema.NullOr(SwarmPlatform.SwarmPlatform)),
-        Resources: Schema.optional(Schema.NullOr(SwarmResources.SwarmResources)),
-        Engine: Schema.optional(Schema.NullOr(SwarmEngineDescription.SwarmEngineDescription)),
-        TLSInfo: Schema.optional(Schema.NullOr(SwarmTLSInfo.SwarmTLSInfo)),
+        Platform: Schema.NullOr(SwarmPlatform.SwarmPlatform),
+        Resources: Schema.NullOr(SwarmResources.SwarmResources),
+        Engine: Schema.NullOr(SwarmEngineDescription.SwarmEngineDescription),
+        TLSInfo: Schema.NullOr(SwarmTLSInfo.SwarmTLSInfo),
         CSIInfo: Schema.optional(Schema.NullOr(Schema.Array(Schema.NullOr(SwarmNodeCSIInfo.SwarmNodeCSIInfo)))),
     },
     {
@@ -20,5 +20,6 @@ export class SwarmNodeDescription extends Schema.Class<SwarmNodeDescription>("Sw
         title: "swarm.NodeDescription",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#NodeDescription",
+        description: "NodeDescription represents the description of a node.",
     }
 ) {}
@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmNodeListOptions extends Schema.Class<SwarmNodeListOptions>("SwarmNodeListOptions")(
+    {
+        Filters: Schema.NullOr(Schema.Record(Schema.String, Schema.Record(Schema.String, Schema.Boolean))),
+    },
+    {
+        identifier: "SwarmNodeListOptions",
+        title: "swarm.NodeListOptions",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#NodeListOptions",
+        description: "NodeListOptions holds parameters to list nodes with.",
+    }
+) {}
@@ -1,8 +1,10 @@
 import * as Schema from "effect/Schema";
 
+import * as SwarmNodeState from "./SwarmNodeState.generated.ts";
+
 export class SwarmNodeStatus extends Schema.Class<SwarmNodeStatus>("SwarmNodeStatus")(
     {
-        State: Schema.optional(Schema.Literals(["unknown", "down", "ready", "disconnected"])),
+        State: Schema.optional(SwarmNodeState.SwarmNodeState),
         Message: Schema.optional(Schema.String),
         Addr: Schema.optional(Schema.String),
     },
@@ -10,5 +12,6 @@ export class SwarmNodeStatus extends Schema.Class<SwarmNodeStatus>("SwarmNodeSta
         identifier: "SwarmNodeStatus",
         title: "swarm.NodeStatus",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#NodeStatus",
+        description: "NodeStatus represents the status of a node.",
     }
 ) {}
@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class SwarmOrchestrationConfig extends Schema.Class<SwarmOrchestrationConfig>("SwarmOrchestrationConfig")(
     {
@@ -9,7 +9,10 @@ export class SwarmOrchestrationConfig extends Schema.Class<SwarmOrchestrationCon
                 MobyNumber.BigIntFromWireString.check(
                     Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
                 )
-            )
+            ).annotate({
+                description:
+                    "TaskHistoryRetentionLimit is the number of historic tasks to keep per instance or\nnode. If negative, never remove completed or failed tasks.",
+            })
         ),
     },
     {
@@ -17,5 +20,6 @@ export class SwarmOrchestrationConfig extends Schema.Class<SwarmOrchestrationCon
         title: "swarm.OrchestrationConfig",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#OrchestrationConfig",
+        description: "OrchestrationConfig represents orchestration configuration.",
     }
 ) {}
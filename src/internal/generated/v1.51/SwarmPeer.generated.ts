@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyIdentifiers from "../schemas/id.ts";
+import * as MobyIdentifiers from "../../schemas/id.ts";
 
 export class SwarmPeer extends Schema.Class<SwarmPeer>("SwarmPeer")(
     {
@@ -11,5 +11,6 @@ export class SwarmPeer extends Schema.Class<SwarmPeer>("SwarmPeer")(
         identifier: "SwarmPeer",
         title: "swarm.Peer",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#Peer",
+        description: "Peer represents a peer.",
     }
 ) {}
@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 import * as SwarmPlacementPreference from "./SwarmPlacementPreference.generated.ts";
 import * as SwarmPlatform from "./SwarmPlatform.generated.ts";
 
@@ -13,11 +13,17 @@ export class SwarmPlacement extends Schema.Class<SwarmPlacement>("SwarmPlacement
         MaxReplicas: Schema.optional(
             MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))
         ),
-        Platforms: Schema.optional(Schema.NullOr(Schema.Array(Schema.NullOr(SwarmPlatform.SwarmPlatform)))),
+        Platforms: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.NullOr(SwarmPlatform.SwarmPlatform))).annotate({
+                description:
+                    "Platforms stores all the platforms that the image can run on.\nThis field is used in the platform filter for scheduling. If empty,\nthen the platform filter is off, meaning there are no scheduling restrictions.",
+            })
+        ),
     },
     {
         identifier: "SwarmPlacement",
         title: "swarm.Placement",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#Placement",
+        description: "Placement represents orchestration parameters.",
     }
 ) {}
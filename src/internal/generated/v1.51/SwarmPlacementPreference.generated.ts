@@ -11,5 +11,6 @@ export class SwarmPlacementPreference extends Schema.Class<SwarmPlacementPrefere
         title: "swarm.PlacementPreference",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#PlacementPreference",
+        description: "PlacementPreference provides a way to make the scheduler aware of factors\nsuch as topology.",
     }
 ) {}
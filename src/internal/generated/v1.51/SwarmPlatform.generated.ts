@@ -9,5 +9,6 @@ export class SwarmPlatform extends Schema.Class<SwarmPlatform>("SwarmPlatform")(
         identifier: "SwarmPlatform",
         title: "swarm.Platform",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#Platform",
+        description: "Platform represents the platform (Arch/OS).",
     }
 ) {}
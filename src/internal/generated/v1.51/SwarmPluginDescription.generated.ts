@@ -10,5 +10,6 @@ export class SwarmPluginDescription extends Schema.Class<SwarmPluginDescription>
         title: "swarm.PluginDescription",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#PluginDescription",
+        description: "PluginDescription represents the description of an engine plugin.",
     }
 ) {}
@@ -1,28 +1,35 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
+import * as SwarmPortConfigProtocol from "./SwarmPortConfigProtocol.generated.ts";
+import * as SwarmPortConfigPublishMode from "./SwarmPortConfigPublishMode.generated.ts";
 
 export class SwarmPortConfig extends Schema.Class<SwarmPortConfig>("SwarmPortConfig")(
     {
         Name: Schema.optional(Schema.String),
-        Protocol: Schema.optional(Schema.Literals(["tcp", "udp", "sctp"])),
+        Protocol: Schema.optional(SwarmPortConfigProtocol.SwarmPortConfigProtocol),
         TargetPort: Schema.optional(
             MobyNumber.NumberFromWireString.check(
                 Schema.isInt(),
                 Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
-            )
+            ).annotate({ description: "TargetPort is the port inside the container" })
         ),
         PublishedPort: Schema.optional(
             MobyNumber.NumberFromWireString.check(
                 Schema.isInt(),
                 Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
-            )
+            ).annotate({ description: "PublishedPort is the port on the swarm hosts" })
+        ),
+        PublishMode: Schema.optional(
+            SwarmPortConfigPublishMode.SwarmPortConfigPublishMode.annotate({
+                description: "PublishMode is the mode in which port is published",
+            })
         ),
-        PublishMode: Schema.optional(Schema.Literals(["ingress", "host"])),
     },
     {
         identifier: "SwarmPortConfig",
         title: "swarm.PortConfig",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#PortConfig",
+        description: "PortConfig represents the config of a port.",
     }
 ) {}
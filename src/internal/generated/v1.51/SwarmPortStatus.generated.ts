@@ -10,5 +10,7 @@ export class SwarmPortStatus extends Schema.Class<SwarmPortStatus>("SwarmPortSta
         identifier: "SwarmPortStatus",
         title: "swarm.PortStatus",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#PortStatus",
+        description:
+            "PortStatus represents the port status of a task's host ports whose\nservice has published host ports",
     }
 ) {}
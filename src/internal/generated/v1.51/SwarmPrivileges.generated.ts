@@ -17,5 +17,6 @@ export class SwarmPrivileges extends Schema.Class<SwarmPrivileges>("SwarmPrivile
         identifier: "SwarmPrivileges",
         title: "swarm.Privileges",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#Privileges",
+        description: "Privileges defines the security options for the container.",
     }
 ) {}
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmRemoveNodeQuery = Schema.Struct({
+    force: Schema.optional(Schema.Boolean),
+}).annotate({
+    identifier: "SwarmRemoveNodeQuery",
+    title: "swarm.removeNode",
+    description: "Query parameters of DELETE /nodes/{id}",
+});
+
+export type SwarmRemoveNodeQuery = Schema.Schema.Type<typeof SwarmRemoveNodeQuery>;
@@ -0,0 +1,31 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class SwarmReplicatedJob extends Schema.Class<SwarmReplicatedJob>("SwarmReplicatedJob")(
+    {
+        MaxConcurrent: Schema.optional(
+            Schema.NullOr(
+                MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))
+            ).annotate({
+                description:
+                    "MaxConcurrent indicates the maximum number of Tasks that should be\nexecuting simultaneously for this job at any given time. There may be\nfewer Tasks that MaxConcurrent executing simultaneously; for example, if\nthere are fewer than MaxConcurrent tasks needed to reach\nTotalCompletions.\n\nIf this field is empty, it will default to a max concurrency of 1.",
+            })
+        ),
+        TotalCompletions: Schema.optional(
+            Schema.NullOr(
+                MobyNumber.BigIntFromWireString.check(Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n }))
+            ).annotate({
+                description:
+                    "TotalCompletions is the total number of Tasks desired to run to\ncompletion.\n\nIf this field is empty, the value of MaxConcurrent will be used.",
+            })
+        ),
+    },
+    {
+        identifier: "SwarmReplicatedJob",
+        title: "swarm.ReplicatedJob",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ReplicatedJob",
+        description:
+            "ReplicatedJob is the a type of Service which executes a defined Tasks\nin parallel until the specified number of Tasks have succeeded.",
+    }
+) {}
@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class SwarmReplicatedService extends Schema.Class<SwarmReplicatedService>("SwarmReplicatedService")(
     {
@@ -15,5 +15,6 @@ export class SwarmReplicatedService extends Schema.Class<SwarmReplicatedService>
         title: "swarm.ReplicatedService",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ReplicatedService",
+        description: "ReplicatedService is a kind of ServiceMode.",
     }
 ) {}
@@ -0,0 +1,15 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmResolutionMode = Schema.Literals(["vip", "dnsrr"]).annotate({
+    identifier: "SwarmResolutionMode",
+    title: "swarm.ResolutionMode",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ResolutionMode",
+    description: "ResolutionMode represents a resolution mode.",
+});
+
+export type SwarmResolutionMode = Schema.Schema.Type<typeof SwarmResolutionMode>;
+
+export const SwarmResolutionModeConstants = Object.freeze({
+    ResolutionModeVIP: "vip",
+    ResolutionModeDNSRR: "dnsrr",
+} as const);
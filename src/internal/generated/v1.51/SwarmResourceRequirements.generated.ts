@@ -13,5 +13,6 @@ export class SwarmResourceRequirements extends Schema.Class<SwarmResourceRequire
         title: "swarm.ResourceRequirements",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ResourceRequirements",
+        description: "ResourceRequirements represents resources requirements.",
     }
 ) {}
@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 import * as SwarmGenericResource from "./SwarmGenericResource.generated.ts";
 
 export class SwarmResources extends Schema.Class<SwarmResources>("SwarmResources")(
@@ -23,5 +23,7 @@ export class SwarmResources extends Schema.Class<SwarmResources>("SwarmResources
         identifier: "SwarmResources",
         title: "swarm.Resources",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#Resources",
+        description:
+            "Resources represents resources (CPU/Memory) which can be advertised by a\nnode and requested to be reserved for a task.",
     }
 ) {}
@@ -1,10 +1,11 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
+import * as SwarmRestartPolicyCondition from "./SwarmRestartPolicyCondition.generated.ts";
 
 export class SwarmRestartPolicy extends Schema.Class<SwarmRestartPolicy>("SwarmRestartPolicy")(
     {
-        Condition: Schema.optional(Schema.Literals(["none", "on-failure", "any"])),
+        Condition: Schema.optional(SwarmRestartPolicyCondition.SwarmRestartPolicyCondition),
         Delay: Schema.optional(
             Schema.NullOr(
                 MobyNumber.BigIntFromWireString.check(
@@ -29,5 +30,6 @@ export class SwarmRestartPolicy extends Schema.Class<SwarmRestartPolicy>("SwarmR
         identifier: "SwarmRestartPolicy",
         title: "swarm.RestartPolicy",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#RestartPolicy",
+        description: "RestartPolicy represents the restart policy.",
     }
 ) {}
@@ -13,5 +13,6 @@ export class SwarmSELinuxContext extends Schema.Class<SwarmSELinuxContext>("Swar
         title: "swarm.SELinuxContext",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#SELinuxContext",
+        description: "SELinuxContext contains the SELinux labels of the container.",
     }
 ) {}
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmSeccompMode = Schema.Literals(["default", "unconfined", "custom"]).annotate({
+    identifier: "SwarmSeccompMode",
+    title: "swarm.SeccompMode",
+    documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#SeccompMode",
+    description: "SeccompMode is the type used for the enumeration of possible seccomp modes\nin SeccompOpts",
+});
+
+export type SwarmSeccompMode = Schema.Schema.Type<typeof SwarmSeccompMode>;
+
+export const SwarmSeccompModeConstants = Object.freeze({
+    SeccompModeDefault: "default",
+    SeccompModeUnconfined: "unconfined",
+    SeccompModeCustom: "custom",
+} as const);
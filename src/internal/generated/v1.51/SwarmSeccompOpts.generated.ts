@@ -0,0 +1,25 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmSeccompMode from "./SwarmSeccompMode.generated.ts";
+
+export class SwarmSeccompOpts extends Schema.Class<SwarmSeccompOpts>("SwarmSeccompOpts")(
+    {
+        Mode: Schema.optional(
+            SwarmSeccompMode.SwarmSeccompMode.annotate({
+                description: "Mode is the SeccompMode used for the container.",
+            })
+        ),
+        Profile: Schema.optional(
+            Schema.NullOr(Schema.Uint8ArrayFromBase64).annotate({
+                description:
+                    "Profile is the custom seccomp profile as a json object to be used with\nthe container. Mode should be set to SeccompModeCustom when using a\ncustom profile in this manner.",
+            })
+        ),
+    },
+    {
+        identifier: "SwarmSeccompOpts",
+        title: "swarm.SeccompOpts",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#SeccompOpts",
+        description: "SeccompOpts defines the options for configuring seccomp on a swarm-managed\ncontainer.",
+    }
+) {}
@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmSecretListOptions extends Schema.Class<SwarmSecretListOptions>("SwarmSecretListOptions")(
+    {
+        Filters: Schema.NullOr(Schema.Record(Schema.String, Schema.Record(Schema.String, Schema.Boolean))),
+    },
+    {
+        identifier: "SwarmSecretListOptions",
+        title: "swarm.SecretListOptions",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#SecretListOptions",
+        description: "SecretListOptions holds parameters to list secrets",
+    }
+) {}
@@ -13,5 +13,6 @@ export class SwarmSecretReference extends Schema.Class<SwarmSecretReference>("Sw
         title: "swarm.SecretReference",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#SecretReference",
+        description: "SecretReference is a reference to a secret in swarm",
     }
 ) {}
@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class SwarmSecretReferenceFileTarget extends Schema.Class<SwarmSecretReferenceFileTarget>(
     "SwarmSecretReferenceFileTarget"
@@ -19,5 +19,6 @@ export class SwarmSecretReferenceFileTarget extends Schema.Class<SwarmSecretRefe
         title: "swarm.SecretReferenceFileTarget",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#SecretReferenceFileTarget",
+        description: "SecretReferenceFileTarget is a file target in a secret reference",
     }
 ) {}
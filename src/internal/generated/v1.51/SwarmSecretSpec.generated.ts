@@ -0,0 +1,34 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmDriver from "./SwarmDriver.generated.ts";
+
+export class SwarmSecretSpec extends Schema.Class<SwarmSecretSpec>("SwarmSecretSpec")(
+    {
+        Name: Schema.optional(Schema.String),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+        Data: Schema.optional(
+            Schema.NullOr(Schema.Uint8ArrayFromBase64).annotate({
+                description:
+                    "Data is the data to store as a secret. It must be empty if a\n[Driver] is used, in which case the data is loaded from an external\nsecret store. The maximum allowed size is 500KB, as defined in\n[MaxSecretSize].\n\nThis field is only used to create the secret, and is not returned\nby other endpoints.\n\n[MaxSecretSize]: https://pkg.go.dev/github.com/moby/swarmkit/v2@v2.0.0-20250103191802-8c1959736554/api/validation#MaxSecretSize",
+            })
+        ),
+        Driver: Schema.optional(
+            Schema.NullOr(SwarmDriver.SwarmDriver).annotate({
+                description:
+                    "Driver is the name of the secrets driver used to fetch the secret's\nvalue from an external secret store. If not set, the default built-in\nstore is used.",
+            })
+        ),
+        Templating: Schema.optional(
+            Schema.NullOr(SwarmDriver.SwarmDriver).annotate({
+                description:
+                    "Templating controls whether and how to evaluate the secret payload as\na template. If it is not set, no templating is used.",
+            })
+        ),
+    },
+    {
+        identifier: "SwarmSecretSpec",
+        title: "swarm.SecretSpec",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#SecretSpec",
+        description: "SecretSpec represents a secret specification from a secret in swarm",
+    }
+) {}
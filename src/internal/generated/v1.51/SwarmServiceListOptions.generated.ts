@@ -0,0 +1,18 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmServiceListOptions extends Schema.Class<SwarmServiceListOptions>("SwarmServiceListOptions")(
+    {
+        Filters: Schema.NullOr(Schema.Record(Schema.String, Schema.Record(Schema.String, Schema.Boolean))),
+        Status: Schema.Boolean.annotate({
+            description:
+                "Status indicates whether the server should include the service task\ncount of running and desired tasks.",
+        }),
+    },
+    {
+        identifier: "SwarmServiceListOptions",
+        title: "swarm.ServiceListOptions",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ServiceListOptions",
+        description: "ServiceListOptions holds parameters to list services with.",
+    }
+) {}
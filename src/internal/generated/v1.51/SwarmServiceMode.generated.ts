@@ -16,5 +16,6 @@ export class SwarmServiceMode extends Schema.Class<SwarmServiceMode>("SwarmServi
         identifier: "SwarmServiceMode",
         title: "swarm.ServiceMode",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ServiceMode",
+        description: "ServiceMode represents the mode of a service.",
     }
 ) {}
@@ -1,6 +1,5 @@
 import * as Schema from "effect/Schema";
 
-import * as SwarmAnnotations from "./SwarmAnnotations.generated.ts";
 import * as SwarmEndpointSpec from "./SwarmEndpointSpec.generated.ts";
 import * as SwarmNetworkAttachmentConfig from "./SwarmNetworkAttachmentConfig.generated.ts";
 import * as SwarmServiceMode from "./SwarmServiceMode.generated.ts";
@@ -9,13 +8,23 @@ import * as SwarmUpdateConfig from "./SwarmUpdateConfig.generated.ts";
 
 export class SwarmServiceSpec extends Schema.Class<SwarmServiceSpec>("SwarmServiceSpec")(
     {
-        ...SwarmAnnotations.SwarmAnnotations.fields,
-        TaskTemplate: Schema.optional(Schema.NullOr(SwarmTaskSpec.SwarmTaskSpec)),
-        Mode: Schema.optional(Schema.NullOr(SwarmServiceMode.SwarmServiceMode)),
+        Name: Schema.optional(Schema.String),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+        TaskTemplate: Schema.NullOr(SwarmTaskSpec.SwarmTaskSpec).annotate({
+            description:
+                "TaskTemplate defines how the service should construct new tasks when\norchestrating this service.",
+        }),
+        Mode: Schema.NullOr(SwarmServiceMode.SwarmServiceMode),
         UpdateConfig: Schema.optional(Schema.NullOr(SwarmUpdateConfig.SwarmUpdateConfig)),
         RollbackConfig: Schema.optional(Schema.NullOr(SwarmUpdateConfig.SwarmUpdateConfig)),
         Networks: Schema.optional(
-            Schema.NullOr(Schema.Array(Schema.NullOr(SwarmNetworkAttachmentConfig.SwarmNetworkAttachmentConfig)))
+            Schema.NullOr(
+                Schema.Array(Schema.NullOr(SwarmNetworkAttachmentConfig.SwarmNetworkAttachmentConfig))
+            ).annotate({
+                deprecatedIn: "1.44",
+                description:
+                    "Networks specifies which networks the service should attach to.\n\nDeprecated: This field is deprecated since v1.44. The Networks field in TaskSpec should be used instead.",
+            })
         ),
         EndpointSpec: Schema.optional(Schema.NullOr(SwarmEndpointSpec.SwarmEndpointSpec)),
     },
@@ -23,5 +32,6 @@ export class SwarmServiceSpec extends Schema.Class<SwarmServiceSpec>("SwarmServi
         identifier: "SwarmServiceSpec",
         title: "swarm.ServiceSpec",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ServiceSpec",
+        description: "ServiceSpec represents the spec of a service.",
     }
 ) {}
@@ -0,0 +1,32 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class SwarmServiceStatus extends Schema.Class<SwarmServiceStatus>("SwarmServiceStatus")(
+    {
+        RunningTasks: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ).annotate({
+            description: "RunningTasks is the number of tasks for the service actually in the\nRunning state",
+        }),
+        DesiredTasks: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ).annotate({
+            description:
+                "DesiredTasks is the number of tasks desired to be running by the\nservice. For replicated services, this is the replica count. For global\nservices, this is computed by taking the number of tasks with desired\nstate of not-Shutdown.",
+        }),
+        CompletedTasks: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ).annotate({
+            description:
+                "CompletedTasks is the number of tasks in the state Completed, if this\nservice is in ReplicatedJob or GlobalJob mode. This field must be\ncross-referenced with the service type, because the default value of 0\nmay mean that a service is not in a job mode, or it may mean that the\njob has yet to complete any tasks.",
+        }),
+    },
+    {
+        identifier: "SwarmServiceStatus",
+        title: "swarm.ServiceStatus",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ServiceStatus",
+        description:
+            "ServiceStatus represents the number of running tasks in a service and the\nnumber of tasks desired to be running.",
+    }
+) {}
@@ -0,0 +1,29 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmServiceUpdateOptions extends Schema.Class<SwarmServiceUpdateOptions>("SwarmServiceUpdateOptions")(
+    {
+        EncodedRegistryAuth: Schema.String.annotate({
+            description:
+                "EncodedRegistryAuth is the encoded registry authorization credentials to\nuse when updating the service.\n\nThis field follows the format of the X-Registry-Auth header.",
+        }),
+        RegistryAuthFrom: Schema.String.annotate({
+            description:
+                'RegistryAuthFrom specifies where to find the registry authorization\ncredentials if they are not given in EncodedRegistryAuth. Valid\nvalues are "spec" and "previous-spec".',
+        }),
+        Rollback: Schema.String.annotate({
+            description:
+                'Rollback indicates whether a server-side rollback should be\nperformed. When this is set, the provided spec will be ignored.\nThe valid values are "previous" and "none". An empty value is the\nsame as "none".',
+        }),
+        QueryRegistry: Schema.Boolean.annotate({
+            description:
+                "QueryRegistry indicates whether the service update requires\ncontacting a registry. A registry may be contacted to retrieve\nthe image digest and manifest, which in turn can be used to update\nplatform or other information about the service.",
+        }),
+    },
+    {
+        identifier: "SwarmServiceUpdateOptions",
+        title: "swarm.ServiceUpdateOptions",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#ServiceUpdateOptions",
+        description: "ServiceUpdateOptions contains the options to be used for updating services.",
+    }
+) {}
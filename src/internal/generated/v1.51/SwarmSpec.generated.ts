@@ -1,6 +1,5 @@
 import * as Schema from "effect/Schema";
 
-import * as SwarmAnnotations from "./SwarmAnnotations.generated.ts";
 import * as SwarmCAConfig from "./SwarmCAConfig.generated.ts";
 import * as SwarmDispatcherConfig from "./SwarmDispatcherConfig.generated.ts";
 import * as SwarmEncryptionConfig from "./SwarmEncryptionConfig.generated.ts";
@@ -10,17 +9,19 @@ import * as SwarmTaskDefaults from "./SwarmTaskDefaults.generated.ts";
 
 export class SwarmSpec extends Schema.Class<SwarmSpec>("SwarmSpec")(
     {
-        ...SwarmAnnotations.SwarmAnnotations.fields,
-        Orchestration: Schema.optional(Schema.NullOr(SwarmOrchestrationConfig.SwarmOrchestrationConfig)),
-        Raft: Schema.optional(Schema.NullOr(SwarmRaftConfig.SwarmRaftConfig)),
-        Dispatcher: Schema.optional(Schema.NullOr(SwarmDispatcherConfig.SwarmDispatcherConfig)),
-        CAConfig: Schema.optional(Schema.NullOr(SwarmCAConfig.SwarmCAConfig)),
-        TaskDefaults: Schema.optional(Schema.NullOr(SwarmTaskDefaults.SwarmTaskDefaults)),
-        EncryptionConfig: Schema.optional(Schema.NullOr(SwarmEncryptionConfig.SwarmEncryptionConfig)),
+        Name: Schema.optional(Schema.String),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+        Orchestration: Schema.NullOr(SwarmOrchestrationConfig.SwarmOrchestrationConfig),
+        Raft: Schema.NullOr(SwarmRaftConfig.SwarmRaftConfig),
+        Dispatcher: Schema.NullOr(SwarmDispatcherConfig.SwarmDispatcherConfig),
+        CAConfig: Schema.NullOr(SwarmCAConfig.SwarmCAConfig),
+        TaskDefaults: Schema.NullOr(SwarmTaskDefaults.SwarmTaskDefaults),
+        EncryptionConfig: Schema.NullOr(SwarmEncryptionConfig.SwarmEncryptionConfig),
     },
     {
         identifier: "SwarmSpec",
         title: "swarm.Spec",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#Spec",
+        description: "Spec represents the spec of a swarm.",
     }
 ) {}
@@ -2,11 +2,13 @@ import * as Schema from "effect/Schema";
 
 export class SwarmSpreadOver extends Schema.Class<SwarmSpreadOver>("SwarmSpreadOver")(
     {
-        SpreadDescriptor: Schema.String,
+        SpreadDescriptor: Schema.String.annotate({ description: "label descriptor, such as engine.labels.az" }),
     },
     {
         identifier: "SwarmSpreadOver",
         title: "swarm.SpreadOver",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#SpreadOver",
+        description:
+            "SpreadOver is a scheduling preference that instructs the scheduler to spread\ntasks evenly over groups of nodes identified by labels.",
     }
 ) {}
@@ -0,0 +1,35 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as SwarmJoinTokens from "./SwarmJoinTokens.generated.ts";
+import * as SwarmSpec from "./SwarmSpec.generated.ts";
+import * as SwarmTLSInfo from "./SwarmTLSInfo.generated.ts";
+import * as SwarmVersion from "./SwarmVersion.generated.ts";
+
+export class SwarmSwarm extends Schema.Class<SwarmSwarm>("SwarmSwarm")(
+    {
+        ID: Schema.String,
+        Version: Schema.NullOr(SwarmVersion.SwarmVersion),
+        CreatedAt: Schema.NullOr(Schema.DateFromString),
+        UpdatedAt: Schema.NullOr(Schema.DateFromString),
+        Spec: Schema.NullOr(SwarmSpec.SwarmSpec),
+        TLSInfo: Schema.NullOr(SwarmTLSInfo.SwarmTLSInfo),
+        RootRotationInProgress: Schema.Boolean,
+        DefaultAddrPool: Schema.NullOr(Schema.Array(Schema.String)),
+        SubnetSize: MobyNumber.NumberFromWireString.check(
+            Schema.isInt(),
+            Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
+        ),
+        DataPathPort: MobyNumber.NumberFromWireString.check(
+            Schema.isInt(),
+            Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
+        ),
+        JoinTokens: Schema.NullOr(SwarmJoinTokens.SwarmJoinTokens),
+    },
+    {
+        identifier: "SwarmSwarm",
+        title: "swarm.Swarm",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#Swarm",
+        description: "Swarm represents a swarm.",
+    }
+) {}
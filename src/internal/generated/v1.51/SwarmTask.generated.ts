@@ -1,12 +1,11 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyIdentifiers from "../schemas/id.ts";
-import * as MobyNumber from "../schemas/number.ts";
-import * as SwarmAnnotations from "./SwarmAnnotations.generated.ts";
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 import * as SwarmGenericResource from "./SwarmGenericResource.generated.ts";
-import * as SwarmMeta from "./SwarmMeta.generated.ts";
 import * as SwarmNetworkAttachment from "./SwarmNetworkAttachment.generated.ts";
 import * as SwarmTaskSpec from "./SwarmTaskSpec.generated.ts";
+import * as SwarmTaskState from "./SwarmTaskState.generated.ts";
 import * as SwarmTaskStatus from "./SwarmTaskStatus.generated.ts";
 import * as SwarmVersion from "./SwarmVersion.generated.ts";
 import * as SwarmVolumeAttachment from "./SwarmVolumeAttachment.generated.ts";
@@ -14,9 +13,12 @@ import * as SwarmVolumeAttachment from "./SwarmVolumeAttachment.generated.ts";
 export class SwarmTask extends Schema.Class<SwarmTask>("SwarmTask")(
     {
         ID: MobyIdentifiers.TaskIdentifier,
-        ...SwarmMeta.SwarmMeta.fields,
-        ...SwarmAnnotations.SwarmAnnotations.fields,
-        Spec: Schema.optional(Schema.NullOr(SwarmTaskSpec.SwarmTaskSpec)),
+        Version: Schema.NullOr(SwarmVersion.SwarmVersion),
+        CreatedAt: Schema.NullOr(Schema.DateFromString),
+        UpdatedAt: Schema.NullOr(Schema.DateFromString),
+        Name: Schema.optional(Schema.String),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)),
+        Spec: Schema.NullOr(SwarmTaskSpec.SwarmTaskSpec),
         ServiceID: Schema.optional(MobyIdentifiers.ServiceIdentifier),
         Slot: Schema.optional(
             MobyNumber.BigIntFromWireString.check(
@@ -24,38 +26,29 @@ export class SwarmTask extends Schema.Class<SwarmTask>("SwarmTask")(
             )
         ),
         NodeID: Schema.optional(MobyIdentifiers.NodeIdentifier),
-        Status: Schema.optional(Schema.NullOr(SwarmTaskStatus.SwarmTaskStatus)),
-        DesiredState: Schema.optional(
-            Schema.Literals([
-                "new",
-                "allocated",
-                "pending",
-                "assigned",
-                "accepted",
-                "preparing",
-                "ready",
-                "starting",
-                "running",
-                "complete",
-                "shutdown",
-                "failed",
-                "rejected",
-                "remove",
-                "orphaned",
-            ])
-        ),
+        Status: Schema.NullOr(SwarmTaskStatus.SwarmTaskStatus),
+        DesiredState: Schema.optional(SwarmTaskState.SwarmTaskState),
         NetworksAttachments: Schema.optional(
             Schema.NullOr(Schema.Array(Schema.NullOr(SwarmNetworkAttachment.SwarmNetworkAttachment)))
         ),
         GenericResources: Schema.optional(
             Schema.NullOr(Schema.Array(Schema.NullOr(SwarmGenericResource.SwarmGenericResource)))
         ),
-        JobIteration: Schema.optional(Schema.NullOr(SwarmVersion.SwarmVersion)),
-        Volumes: Schema.NullOr(Schema.Array(Schema.NullOr(SwarmVolumeAttachment.SwarmVolumeAttachment))),
+        JobIteration: Schema.optional(
+            Schema.NullOr(SwarmVersion.SwarmVersion).annotate({
+                description:
+                    "JobIteration is the JobIteration of the Service that this Task was\nspawned from, if the Service is a ReplicatedJob or GlobalJob. This is\nused to determine which Tasks belong to which run of the job. This field\nis absent if the Service mode is Replicated or Global.",
+            })
+        ),
+        Volumes: Schema.NullOr(Schema.Array(Schema.NullOr(SwarmVolumeAttachment.SwarmVolumeAttachment))).annotate({
+            description:
+                "Volumes is the list of VolumeAttachments for this task. It specifies\nwhich particular volumes are to be used by this particular task, and\nfulfilling what mounts in the spec.",
+        }),
     },
     {
         identifier: "SwarmTask",
         title: "swarm.Task",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#Task",
+        description: "Task represents a task.",
     }
 ) {}
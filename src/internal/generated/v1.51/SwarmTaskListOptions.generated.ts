@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmTaskListOptions extends Schema.Class<SwarmTaskListOptions>("SwarmTaskListOptions")(
+    {
+        Filters: Schema.NullOr(Schema.Record(Schema.String, Schema.Record(Schema.String, Schema.Boolean))),
+    },
+    {
+        identifier: "SwarmTaskListOptions",
+        title: "swarm.TaskListOptions",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#TaskListOptions",
+        description: "TaskListOptions holds parameters to list tasks with.",
+    }
+) {}
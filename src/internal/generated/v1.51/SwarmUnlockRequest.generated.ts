@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export class SwarmUnlockRequest extends Schema.Class<SwarmUnlockRequest>("SwarmUnlockRequest")(
+    {
+        UnlockKey: Schema.String.annotate({ description: "UnlockKey is the unlock key in ASCII-armored format." }),
+    },
+    {
+        identifier: "SwarmUnlockRequest",
+        title: "swarm.UnlockRequest",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#UnlockRequest",
+        description: "UnlockRequest is the request used to unlock a swarm.",
+    }
+) {}
@@ -0,0 +1,43 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class SwarmUpdateConfig extends Schema.Class<SwarmUpdateConfig>("SwarmUpdateConfig")(
+    {
+        Parallelism: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: 0n, maximum: 2n ** 64n - 1n })
+        ).annotate({
+            description: "Maximum number of tasks to be updated in one iteration.\n0 means unlimited parallelism.",
+        }),
+        Delay: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({ description: "Amount of time between updates." })
+        ),
+        FailureAction: Schema.optional(
+            Schema.String.annotate({ description: "FailureAction is the action to take when an update failures." })
+        ),
+        Monitor: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({
+                description:
+                    "Monitor indicates how long to monitor a task for failure after it is\ncreated. If the task fails by ending up in one of the states\nREJECTED, COMPLETED, or FAILED, within Monitor from its creation,\nthis counts as a failure. If it fails after Monitor, it does not\ncount as a failure. If Monitor is unspecified, a default value will\nbe used.",
+            })
+        ),
+        MaxFailureRatio: MobyNumber.NumberFromWireString.annotate({
+            description:
+                "MaxFailureRatio is the fraction of tasks that may fail during\nan update before the failure action is invoked. Any task created by\nthe current update which ends up in one of the states REJECTED,\nCOMPLETED or FAILED within Monitor from its creation counts as a\nfailure. The number of failures is divided by the number of tasks\nbeing updated, and if this fraction is greater than\nMaxFailureRatio, the failure action is invoked.\n\nIf the failure action is CONTINUE, there is no effect.\nIf the failure action is PAUSE, no more tasks will be updated until\nanother update is started.",
+        }),
+        Order: Schema.String.annotate({
+            description:
+                "Order indicates the order of operations when rolling out an updated\ntask. Either the old task is shut down before the new task is\nstarted, or the new task is started before the old task is shut down.",
+        }),
+    },
+    {
+        identifier: "SwarmUpdateConfig",
+        title: "swarm.UpdateConfig",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#UpdateConfig",
+        description: "UpdateConfig represents the update configuration.",
+    }
+) {}
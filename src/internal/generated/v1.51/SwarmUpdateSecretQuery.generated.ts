@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const SwarmUpdateSecretQuery = Schema.Struct({
+    version: Schema.optional(Schema.Finite),
+}).annotate({
+    identifier: "SwarmUpdateSecretQuery",
+    title: "swarm.updateSecret",
+    description: "Query parameters of POST /secrets/{id}/update",
+});
+
+export type SwarmUpdateSecretQuery = Schema.Schema.Type<typeof SwarmUpdateSecretQuery>;
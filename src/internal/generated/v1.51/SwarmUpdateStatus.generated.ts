@@ -1,17 +1,10 @@
 import * as Schema from "effect/Schema";
 
+import * as SwarmUpdateState from "./SwarmUpdateState.generated.ts";
+
 export class SwarmUpdateStatus extends Schema.Class<SwarmUpdateStatus>("SwarmUpdateStatus")(
     {
-        State: Schema.optional(
-            Schema.Literals([
-                "updating",
-                "paused",
-                "completed",
-                "rollback_started",
-                "rollback_paused",
-                "rollback_completed",
-            ])
-        ),
+        State: Schema.optional(SwarmUpdateState.SwarmUpdateState),
         StartedAt: Schema.optional(Schema.NullOr(Schema.DateFromString)),
         CompletedAt: Schema.optional(Schema.NullOr(Schema.DateFromString)),
         Message: Schema.optional(Schema.String),
@@ -20,5 +13,6 @@ export class SwarmUpdateStatus extends Schema.Class<SwarmUpdateStatus>("SwarmUpd
         identifier: "SwarmUpdateStatus",
         title: "swarm.UpdateStatus",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#UpdateStatus",
+        description: "UpdateStatus reports the status of a service update.",
     }
 ) {}
@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class SwarmVersion extends Schema.Class<SwarmVersion>("SwarmVersion")(
     {
@@ -12,5 +12,6 @@ export class SwarmVersion extends Schema.Class<SwarmVersion>("SwarmVersion")(
         identifier: "SwarmVersion",
         title: "swarm.Version",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/swarm#Version",
+        description: "Version represents the internal object version.",
     }
 ) {}
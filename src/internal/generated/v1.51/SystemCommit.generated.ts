@@ -0,0 +1,20 @@
+import * as Schema from "effect/Schema";
+
+export class SystemCommit extends Schema.Class<SystemCommit>("SystemCommit")(
+    {
+        ID: Schema.String.annotate({ description: "ID is the actual commit ID or version of external tool." }),
+        Expected: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "Expected is the commit ID of external tool expected by dockerd as set at build time.\n\nDeprecated: this field is no longer used in API v1.49, but kept for backward-compatibility with older API versions.",
+            })
+        ),
+    },
+    {
+        identifier: "SystemCommit",
+        title: "system.Commit",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/system#Commit",
+        description:
+            "Commit holds the Git-commit (SHA1) that a binary was built from, as reported\nin the version-string of external tools, such as containerd, or runC.",
+    }
+) {}
@@ -4,13 +4,18 @@ import * as SystemContainerdNamespaces from "./SystemContainerdNamespaces.genera
 
 export class SystemContainerdInfo extends Schema.Class<SystemContainerdInfo>("SystemContainerdInfo")(
     {
-        Address: Schema.optional(Schema.String),
-        Namespaces: Schema.NullOr(SystemContainerdNamespaces.SystemContainerdNamespaces),
+        Address: Schema.optional(
+            Schema.String.annotate({ description: "Address is the path to the containerd socket." })
+        ),
+        Namespaces: Schema.NullOr(SystemContainerdNamespaces.SystemContainerdNamespaces).annotate({
+            description: "Namespaces is the containerd namespaces used by the daemon.",
+        }),
     },
     {
         identifier: "SystemContainerdInfo",
         title: "system.ContainerdInfo",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/system#ContainerdInfo",
+        description: "ContainerdInfo holds information about the containerd instance used by the daemon.",
     }
 ) {}
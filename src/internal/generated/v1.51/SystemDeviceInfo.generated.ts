@@ -0,0 +1,17 @@
+import * as Schema from "effect/Schema";
+
+export class SystemDeviceInfo extends Schema.Class<SystemDeviceInfo>("SystemDeviceInfo")(
+    {
+        Source: Schema.String.annotate({ description: "Source indicates the origin device driver." }),
+        ID: Schema.String.annotate({
+            description:
+                'ID is the unique identifier for the device.\nExample: CDI FQDN like "vendor.com/gpu=0", or other driver-specific device ID',
+        }),
+    },
+    {
+        identifier: "SystemDeviceInfo",
+        title: "system.DeviceInfo",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/system#DeviceInfo",
+        description: "DeviceInfo represents a discoverable device from a device driver.",
+    }
+) {}
@@ -2,14 +2,17 @@ import * as Schema from "effect/Schema";
 
 export class SystemFirewallInfo extends Schema.Class<SystemFirewallInfo>("SystemFirewallInfo")(
     {
-        Driver: Schema.String,
+        Driver: Schema.String.annotate({ description: "Driver is the name of the firewall backend driver." }),
         Info: Schema.optional(
-            Schema.NullOr(Schema.Array(Schema.Array(Schema.String).check(Schema.isLengthBetween(2, 2))))
+            Schema.NullOr(Schema.Array(Schema.Array(Schema.String).check(Schema.isLengthBetween(2, 2)))).annotate({
+                description: "Info is a list of label/value pairs, containing information related to the firewall.",
+            })
         ),
     },
     {
         identifier: "SystemFirewallInfo",
         title: "system.FirewallInfo",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/system#FirewallInfo",
+        description: "FirewallInfo describes the firewall backend.",
     }
 ) {}
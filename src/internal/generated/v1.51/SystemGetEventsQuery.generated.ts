@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export const SystemGetEventsQuery = Schema.Struct({
+    since: Schema.optional(Schema.String),
+    until: Schema.optional(Schema.String),
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+}).annotate({
+    identifier: "SystemGetEventsQuery",
+    title: "system.getEvents",
+    description: "Query parameters of GET /events",
+});
+
+export type SystemGetEventsQuery = Schema.Schema.Type<typeof SystemGetEventsQuery>;
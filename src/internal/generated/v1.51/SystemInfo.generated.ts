@@ -1,6 +1,7 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
+import * as ContainerIsolation from "./ContainerIsolation.generated.ts";
 import * as RegistryServiceConfig from "./RegistryServiceConfig.generated.ts";
 import * as SwarmGenericResource from "./SwarmGenericResource.generated.ts";
 import * as SwarmInfo from "./SwarmInfo.generated.ts";
@@ -33,13 +34,19 @@ export class SystemInfo extends Schema.Class<SystemInfo>("SystemInfo")(
         Driver: Schema.String,
         DriverStatus: Schema.NullOr(Schema.Array(Schema.Array(Schema.String).check(Schema.isLengthBetween(2, 2)))),
         SystemStatus: Schema.optional(
-            Schema.NullOr(Schema.Array(Schema.Array(Schema.String).check(Schema.isLengthBetween(2, 2))))
+            Schema.NullOr(Schema.Array(Schema.Array(Schema.String).check(Schema.isLengthBetween(2, 2)))).annotate({
+                description: "SystemStatus is only propagated by the Swarm standalone API",
+            })
         ),
         Plugins: Schema.NullOr(SystemPluginsInfo.SystemPluginsInfo),
         MemoryLimit: Schema.Boolean,
         SwapLimit: Schema.Boolean,
-        KernelMemory: Schema.optional(Schema.Boolean),
-        KernelMemoryTCP: Schema.optional(Schema.Boolean),
+        KernelMemory: Schema.optional(
+            Schema.Boolean.annotate({ description: "Deprecated: kernel 5.4 deprecated kmem.limit_in_bytes" })
+        ),
+        KernelMemoryTCP: Schema.optional(
+            Schema.Boolean.annotate({ description: "KernelMemoryTCP is not supported on cgroups v2." })
+        ),
         CpuCfsPeriod: Schema.Boolean,
         CpuCfsQuota: Schema.Boolean,
         CPUShares: Schema.Boolean,
@@ -88,8 +95,11 @@ export class SystemInfo extends Schema.Class<SystemInfo>("SystemInfo")(
         ),
         DefaultRuntime: Schema.String,
         Swarm: Schema.NullOr(SwarmInfo.SwarmInfo),
-        LiveRestoreEnabled: Schema.Boolean,
-        Isolation: Schema.Literals(["", "default", "process", "hyperv"]),
+        LiveRestoreEnabled: Schema.Boolean.annotate({
+            description:
+                "LiveRestoreEnabled determines whether containers should be kept\nrunning when the daemon is shutdown or upon daemon start if\nrunning containers are detected",
+        }),
+        Isolation: ContainerIsolation.ContainerIsolation,
         InitBinary: Schema.String,
         ContainerdCommit: Schema.NullOr(SystemCommit.SystemCommit),
         RuncCommit: Schema.NullOr(SystemCommit.SystemCommit),
@@ -105,11 +115,15 @@ export class SystemInfo extends Schema.Class<SystemInfo>("SystemInfo")(
             Schema.NullOr(Schema.Array(Schema.NullOr(SystemDeviceInfo.SystemDeviceInfo)))
         ),
         Containerd: Schema.optional(Schema.NullOr(SystemContainerdInfo.SystemContainerdInfo)),
-        Warnings: Schema.NullOr(Schema.Array(Schema.String)),
+        Warnings: Schema.NullOr(Schema.Array(Schema.String)).annotate({
+            description:
+                "Warnings contains a slice of warnings that occurred  while collecting\nsystem information. These warnings are intended to be informational\nmessages for the user, and are not intended to be parsed / used for\nother purposes, as they do not have a fixed format.",
+        }),
     },
     {
         identifier: "SystemInfo",
         title: "system.Info",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/system#Info",
+        description: 'Info contains response of Engine API:\nGET "/info"',
     }
 ) {}
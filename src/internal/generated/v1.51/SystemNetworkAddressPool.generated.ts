@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class SystemNetworkAddressPool extends Schema.Class<SystemNetworkAddressPool>("SystemNetworkAddressPool")(
     {
@@ -14,5 +14,6 @@ export class SystemNetworkAddressPool extends Schema.Class<SystemNetworkAddressP
         title: "system.NetworkAddressPool",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/system#NetworkAddressPool",
+        description: "NetworkAddressPool is a temp struct used by [Info] struct.",
     }
 ) {}
@@ -11,5 +11,6 @@ export class SystemRuntime extends Schema.Class<SystemRuntime>("SystemRuntime")(
         identifier: "SystemRuntime",
         title: "system.Runtime",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/system#Runtime",
+        description: "Runtime describes an OCI runtime",
     }
 ) {}
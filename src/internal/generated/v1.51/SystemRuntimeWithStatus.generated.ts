@@ -1,10 +1,11 @@
 import * as Schema from "effect/Schema";
 
-import * as SystemRuntime from "./SystemRuntime.generated.ts";
-
 export class SystemRuntimeWithStatus extends Schema.Class<SystemRuntimeWithStatus>("SystemRuntimeWithStatus")(
     {
-        ...SystemRuntime.SystemRuntime.fields,
+        path: Schema.optional(Schema.String),
+        runtimeArgs: Schema.optional(Schema.NullOr(Schema.Array(Schema.String))),
+        runtimeType: Schema.optional(Schema.String),
+        options: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.ObjectKeyword))),
         status: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.String))),
     },
     {
@@ -12,5 +13,6 @@ export class SystemRuntimeWithStatus extends Schema.Class<SystemRuntimeWithStatu
         title: "system.RuntimeWithStatus",
         documentation:
             "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/system#RuntimeWithStatus",
+        description: "RuntimeWithStatus extends [Runtime] to hold [RuntimeStatus].",
     }
 ) {}
@@ -10,5 +10,6 @@ export class TypesComponentVersion extends Schema.Class<TypesComponentVersion>("
         identifier: "TypesComponentVersion",
         title: "types.ComponentVersion",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types#ComponentVersion",
+        description: "ComponentVersion describes the version information for a specific component.",
     }
 ) {}
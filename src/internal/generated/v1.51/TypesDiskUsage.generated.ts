@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 import * as BuildCacheRecord from "./BuildCacheRecord.generated.ts";
 import * as ContainerSummary from "./ContainerSummary.generated.ts";
 import * as ImageSummary from "./ImageSummary.generated.ts";
@@ -8,11 +8,8 @@ import * as VolumeVolume from "./VolumeVolume.generated.ts";
 
 export class TypesDiskUsage extends Schema.Class<TypesDiskUsage>("TypesDiskUsage")(
     {
-        // optional for docker.io/library/docker:dind-rootless (deprecated, omitted since docker v29)
-        LayersSize: Schema.optional(
-            MobyNumber.BigIntFromWireString.check(
-                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
-            )
+        LayersSize: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
         ),
         Images: Schema.NullOr(Schema.Array(Schema.NullOr(ImageSummary.ImageSummary))),
         Containers: Schema.NullOr(Schema.Array(Schema.NullOr(ContainerSummary.ContainerSummary))),
@@ -21,12 +18,13 @@ export class TypesDiskUsage extends Schema.Class<TypesDiskUsage>("TypesDiskUsage
         BuilderSize: Schema.optional(
             MobyNumber.BigIntFromWireString.check(
                 Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
-            )
+            ).annotate({ description: "Deprecated: deprecated in API 1.38, and no longer used since API 1.40." })
         ),
     },
     {
         identifier: "TypesDiskUsage",
         title: "types.DiskUsage",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types#DiskUsage",
+        description: 'DiskUsage contains response of Engine API:\nGET "/system/df"',
     }
 ) {}
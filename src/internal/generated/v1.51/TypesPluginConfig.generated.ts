@@ -0,0 +1,53 @@
+import * as Schema from "effect/Schema";
+
+import * as TypesPluginConfigArgs from "./TypesPluginConfigArgs.generated.ts";
+import * as TypesPluginConfigInterface from "./TypesPluginConfigInterface.generated.ts";
+import * as TypesPluginConfigLinux from "./TypesPluginConfigLinux.generated.ts";
+import * as TypesPluginConfigNetwork from "./TypesPluginConfigNetwork.generated.ts";
+import * as TypesPluginConfigRootfs from "./TypesPluginConfigRootfs.generated.ts";
+import * as TypesPluginConfigUser from "./TypesPluginConfigUser.generated.ts";
+import * as TypesPluginEnv from "./TypesPluginEnv.generated.ts";
+import * as TypesPluginMount from "./TypesPluginMount.generated.ts";
+
+export class TypesPluginConfig extends Schema.Class<TypesPluginConfig>("TypesPluginConfig")(
+    {
+        Args: Schema.NullOr(TypesPluginConfigArgs.TypesPluginConfigArgs).annotate({
+            description: "args\nRequired: true",
+        }),
+        Description: Schema.String.annotate({ description: "description\nRequired: true" }),
+        DockerVersion: Schema.optional(
+            Schema.String.annotate({ description: "Docker Version used to create the plugin" })
+        ),
+        Documentation: Schema.String.annotate({ description: "documentation\nRequired: true" }),
+        Entrypoint: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "entrypoint\nRequired: true" }),
+        Env: Schema.NullOr(Schema.Array(Schema.NullOr(TypesPluginEnv.TypesPluginEnv))).annotate({
+            description: "env\nRequired: true",
+        }),
+        Interface: Schema.NullOr(TypesPluginConfigInterface.TypesPluginConfigInterface).annotate({
+            description: "interface\nRequired: true",
+        }),
+        IpcHost: Schema.Boolean.annotate({ description: "ipc host\nRequired: true" }),
+        Linux: Schema.NullOr(TypesPluginConfigLinux.TypesPluginConfigLinux).annotate({
+            description: "linux\nRequired: true",
+        }),
+        Mounts: Schema.NullOr(Schema.Array(Schema.NullOr(TypesPluginMount.TypesPluginMount))).annotate({
+            description: "mounts\nRequired: true",
+        }),
+        Network: Schema.NullOr(TypesPluginConfigNetwork.TypesPluginConfigNetwork).annotate({
+            description: "network\nRequired: true",
+        }),
+        PidHost: Schema.Boolean.annotate({ description: "pid host\nRequired: true" }),
+        PropagatedMount: Schema.String.annotate({ description: "propagated mount\nRequired: true" }),
+        User: Schema.NullOr(TypesPluginConfigUser.TypesPluginConfigUser).annotate({ description: "user" }),
+        WorkDir: Schema.String.annotate({ description: "work dir\nRequired: true" }),
+        rootfs: Schema.optional(
+            Schema.NullOr(TypesPluginConfigRootfs.TypesPluginConfigRootfs).annotate({ description: "rootfs" })
+        ),
+    },
+    {
+        identifier: "TypesPluginConfig",
+        title: "types.PluginConfig",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types#PluginConfig",
+        description: "PluginConfig The config of a plugin.\nswagger:model PluginConfig",
+    }
+) {}
@@ -0,0 +1,16 @@
+import * as Schema from "effect/Schema";
+
+export class TypesPluginConfigArgs extends Schema.Class<TypesPluginConfigArgs>("TypesPluginConfigArgs")(
+    {
+        Description: Schema.String.annotate({ description: "description\nRequired: true" }),
+        Name: Schema.String.annotate({ description: "name\nRequired: true" }),
+        Settable: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "settable\nRequired: true" }),
+        Value: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "value\nRequired: true" }),
+    },
+    {
+        identifier: "TypesPluginConfigArgs",
+        title: "types.PluginConfigArgs",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types#PluginConfigArgs",
+        description: "PluginConfigArgs plugin config args\nswagger:model PluginConfigArgs",
+    }
+) {}
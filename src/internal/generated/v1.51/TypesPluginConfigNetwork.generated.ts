@@ -2,11 +2,12 @@ import * as Schema from "effect/Schema";
 
 export class TypesPluginConfigNetwork extends Schema.Class<TypesPluginConfigNetwork>("TypesPluginConfigNetwork")(
     {
-        Type: Schema.String,
+        Type: Schema.String.annotate({ description: "type\nRequired: true" }),
     },
     {
         identifier: "TypesPluginConfigNetwork",
         title: "types.PluginConfigNetwork",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types#PluginConfigNetwork",
+        description: "PluginConfigNetwork plugin config network\nswagger:model PluginConfigNetwork",
     }
 ) {}
@@ -2,12 +2,13 @@ import * as Schema from "effect/Schema";
 
 export class TypesPluginConfigRootfs extends Schema.Class<TypesPluginConfigRootfs>("TypesPluginConfigRootfs")(
     {
-        diff_ids: Schema.NullOr(Schema.Array(Schema.String)),
-        type: Schema.optional(Schema.String),
+        diff_ids: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "diff ids" }),
+        type: Schema.optional(Schema.String.annotate({ description: "type" })),
     },
     {
         identifier: "TypesPluginConfigRootfs",
         title: "types.PluginConfigRootfs",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types#PluginConfigRootfs",
+        description: "PluginConfigRootfs plugin config rootfs\nswagger:model PluginConfigRootfs",
     }
 ) {}
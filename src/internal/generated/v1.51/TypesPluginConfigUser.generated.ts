@@ -1,6 +1,6 @@
 import * as Schema from "effect/Schema";
 
-import * as MobyNumber from "../schemas/number.ts";
+import * as MobyNumber from "../../schemas/number.ts";
 
 export class TypesPluginConfigUser extends Schema.Class<TypesPluginConfigUser>("TypesPluginConfigUser")(
     {
@@ -8,18 +8,19 @@ export class TypesPluginConfigUser extends Schema.Class<TypesPluginConfigUser>("
             MobyNumber.NumberFromWireString.check(
                 Schema.isInt(),
                 Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
-            )
+            ).annotate({ description: "g ID" })
         ),
         UID: Schema.optional(
             MobyNumber.NumberFromWireString.check(
                 Schema.isInt(),
                 Schema.isBetween({ minimum: 0, maximum: 2 ** 32 - 1 })
-            )
+            ).annotate({ description: "UID" })
         ),
     },
     {
         identifier: "TypesPluginConfigUser",
         title: "types.PluginConfigUser",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types#PluginConfigUser",
+        description: "PluginConfigUser plugin config user\nswagger:model PluginConfigUser",
     }
 ) {}
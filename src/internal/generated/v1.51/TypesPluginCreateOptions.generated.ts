@@ -0,0 +1,13 @@
+import * as Schema from "effect/Schema";
+
+export class TypesPluginCreateOptions extends Schema.Class<TypesPluginCreateOptions>("TypesPluginCreateOptions")(
+    {
+        RepoName: Schema.String,
+    },
+    {
+        identifier: "TypesPluginCreateOptions",
+        title: "types.PluginCreateOptions",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types#PluginCreateOptions",
+        description: "PluginCreateOptions hold all options to plugin create.",
+    }
+) {}
@@ -0,0 +1,19 @@
+import * as Schema from "effect/Schema";
+
+export class TypesPluginMount extends Schema.Class<TypesPluginMount>("TypesPluginMount")(
+    {
+        Description: Schema.String.annotate({ description: "description\nRequired: true" }),
+        Destination: Schema.String.annotate({ description: "destination\nRequired: true" }),
+        Name: Schema.String.annotate({ description: "name\nRequired: true" }),
+        Options: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "options\nRequired: true" }),
+        Settable: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "settable\nRequired: true" }),
+        Source: Schema.NullOr(Schema.String).annotate({ description: "source\nRequired: true" }),
+        Type: Schema.String.annotate({ description: "type\nRequired: true" }),
+    },
+    {
+        identifier: "TypesPluginMount",
+        title: "types.PluginMount",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types#PluginMount",
+        description: "PluginMount plugin mount\nswagger:model PluginMount",
+    }
+) {}
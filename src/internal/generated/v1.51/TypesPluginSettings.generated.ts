@@ -5,14 +5,19 @@ import * as TypesPluginMount from "./TypesPluginMount.generated.ts";
 
 export class TypesPluginSettings extends Schema.Class<TypesPluginSettings>("TypesPluginSettings")(
     {
-        Args: Schema.NullOr(Schema.Array(Schema.String)),
-        Devices: Schema.NullOr(Schema.Array(Schema.NullOr(TypesPluginDevice.TypesPluginDevice))),
-        Env: Schema.NullOr(Schema.Array(Schema.String)),
-        Mounts: Schema.NullOr(Schema.Array(Schema.NullOr(TypesPluginMount.TypesPluginMount))),
+        Args: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "args\nRequired: true" }),
+        Devices: Schema.NullOr(Schema.Array(Schema.NullOr(TypesPluginDevice.TypesPluginDevice))).annotate({
+            description: "devices\nRequired: true",
+        }),
+        Env: Schema.NullOr(Schema.Array(Schema.String)).annotate({ description: "env\nRequired: true" }),
+        Mounts: Schema.NullOr(Schema.Array(Schema.NullOr(TypesPluginMount.TypesPluginMount))).annotate({
+            description: "mounts\nRequired: true",
+        }),
     },
     {
         identifier: "TypesPluginSettings",
         title: "types.PluginSettings",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types#PluginSettings",
+        description: "PluginSettings Settings that can be modified by users.\nswagger:model PluginSettings",
     }
 ) {}
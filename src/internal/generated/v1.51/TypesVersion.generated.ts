@@ -1,14 +1,11 @@
 import * as Schema from "effect/Schema";
 
 import * as TypesComponentVersion from "./TypesComponentVersion.generated.ts";
+import * as TypesVersionPlatform from "./TypesVersionPlatform.generated.ts";
 
 export class TypesVersion extends Schema.Class<TypesVersion>("TypesVersion")(
     {
-        Platform: Schema.optional(
-            Schema.Struct({
-                Name: Schema.String,
-            })
-        ),
+        Platform: TypesVersionPlatform.TypesVersionPlatform,
         Components: Schema.optional(
             Schema.NullOr(Schema.Array(Schema.NullOr(TypesComponentVersion.TypesComponentVersion)))
         ),
@@ -27,5 +24,6 @@ export class TypesVersion extends Schema.Class<TypesVersion>("TypesVersion")(
         identifier: "TypesVersion",
         title: "types.Version",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types#Version",
+        description: 'Version contains response of Engine API:\nGET "/version"',
     }
 ) {}
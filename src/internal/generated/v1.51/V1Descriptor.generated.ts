@@ -0,0 +1,49 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as MobyNumber from "../../schemas/number.ts";
+import * as V1Platform from "./V1Platform.generated.ts";
+
+export class V1Descriptor extends Schema.Class<V1Descriptor>("V1Descriptor")(
+    {
+        mediaType: Schema.String.annotate({
+            description: "MediaType is the media type of the object this schema refers to.",
+        }),
+        digest: MobyIdentifiers.Digest.annotate({ description: "Digest is the digest of the targeted content." }),
+        size: MobyNumber.BigIntFromWireString.check(
+            Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+        ).annotate({ description: "Size specifies the size in bytes of the blob." }),
+        urls: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "URLs specifies a list of URLs from which this object MAY be downloaded",
+            })
+        ),
+        annotations: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+                description: "Annotations contains arbitrary metadata relating to the targeted content.",
+            })
+        ),
+        data: Schema.optional(
+            Schema.NullOr(Schema.Uint8ArrayFromBase64).annotate({
+                description:
+                    "Data is an embedding of the targeted content. This is encoded as a base64\nstring when marshalled to JSON (automatically, by encoding/json). If\npresent, Data can be used directly to avoid fetching the targeted content.",
+            })
+        ),
+        platform: Schema.optional(
+            Schema.NullOr(V1Platform.V1Platform).annotate({
+                description:
+                    "Platform describes the platform which the image in the manifest runs on.\n\nThis should only be used when referring to a manifest.",
+            })
+        ),
+        artifactType: Schema.optional(
+            Schema.String.annotate({ description: "ArtifactType is the IANA media type of this artifact." })
+        ),
+    },
+    {
+        identifier: "V1Descriptor",
+        title: "v1.Descriptor",
+        documentation: "https://pkg.go.dev/github.com/opencontainers/image-spec@v1.1.1/specs-go/v1#Descriptor",
+        description:
+            "Descriptor describes the disposition of targeted content.\nThis structure provides `application/vnd.oci.descriptor.v1+json` mediatype\nwhen marshalled to JSON.",
+    }
+) {}
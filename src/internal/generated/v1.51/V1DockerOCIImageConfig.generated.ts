@@ -0,0 +1,83 @@
+import * as Schema from "effect/Schema";
+
+import * as V1HealthcheckConfig from "./V1HealthcheckConfig.generated.ts";
+
+export class V1DockerOCIImageConfig extends Schema.Class<V1DockerOCIImageConfig>("V1DockerOCIImageConfig")(
+    {
+        User: Schema.optional(
+            Schema.String.annotate({
+                description: "User defines the username or UID which the process in the container should run as.",
+            })
+        ),
+        ExposedPorts: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.ObjectKeyword)).annotate({
+                description: "ExposedPorts a set of ports to expose from a container running this image.",
+            })
+        ),
+        Env: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "Env is a list of environment variables to be used in a container.",
+            })
+        ),
+        Entrypoint: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description:
+                    "Entrypoint defines a list of arguments to use as the command to execute when the container starts.",
+            })
+        ),
+        Cmd: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "Cmd defines the default arguments to the entrypoint of the container.",
+            })
+        ),
+        Volumes: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.ObjectKeyword)).annotate({
+                description:
+                    "Volumes is a set of directories describing where the process is likely write data specific to a container instance.",
+            })
+        ),
+        WorkingDir: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "WorkingDir sets the current working directory of the entrypoint process in the container.",
+            })
+        ),
+        Labels: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+                description: "Labels contains arbitrary metadata for the container.",
+            })
+        ),
+        StopSignal: Schema.optional(
+            Schema.String.annotate({
+                description: "StopSignal contains the system call signal that will be sent to the container to exit.",
+            })
+        ),
+        ArgsEscaped: Schema.optional(
+            Schema.Boolean.annotate({
+                description:
+                    "ArgsEscaped\n\nDeprecated: This field is present only for legacy compatibility with\nDocker and should not be used by new image builders.  It is used by Docker\nfor Windows images to indicate that the `Entrypoint` or `Cmd` or both,\ncontains only a single element array, that is a pre-escaped, and combined\ninto a single string `CommandLine`. If `true` the value in `Entrypoint` or\n`Cmd` should be used as-is to avoid double escaping.\nhttps://github.com/opencontainers/image-spec/pull/892",
+            })
+        ),
+        Healthcheck: Schema.optional(
+            Schema.NullOr(V1HealthcheckConfig.V1HealthcheckConfig).annotate({
+                description: "Healthcheck describes how to check the container is healthy",
+            })
+        ),
+        OnBuild: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "ONBUILD metadata that were defined on the image Dockerfile",
+            })
+        ),
+        Shell: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "Shell for shell-form of RUN, CMD, ENTRYPOINT",
+            })
+        ),
+    },
+    {
+        identifier: "V1DockerOCIImageConfig",
+        title: "v1.DockerOCIImageConfig",
+        documentation: "https://pkg.go.dev/github.com/moby/docker-image-spec@v1.3.1/specs-go/v1#DockerOCIImageConfig",
+        description: "DockerOCIImageConfig is a ocispec.ImageConfig extended with Docker specific fields.",
+    }
+) {}
@@ -0,0 +1,30 @@
+import * as Schema from "effect/Schema";
+
+import * as V1HealthcheckConfig from "./V1HealthcheckConfig.generated.ts";
+
+export class V1DockerOCIImageConfigExt extends Schema.Class<V1DockerOCIImageConfigExt>("V1DockerOCIImageConfigExt")(
+    {
+        Healthcheck: Schema.optional(
+            Schema.NullOr(V1HealthcheckConfig.V1HealthcheckConfig).annotate({
+                description: "Healthcheck describes how to check the container is healthy",
+            })
+        ),
+        OnBuild: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "ONBUILD metadata that were defined on the image Dockerfile",
+            })
+        ),
+        Shell: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "Shell for shell-form of RUN, CMD, ENTRYPOINT",
+            })
+        ),
+    },
+    {
+        identifier: "V1DockerOCIImageConfigExt",
+        title: "v1.DockerOCIImageConfigExt",
+        documentation:
+            "https://pkg.go.dev/github.com/moby/docker-image-spec@v1.3.1/specs-go/v1#DockerOCIImageConfigExt",
+        description: "DockerOCIImageConfigExt contains Docker-specific fields in DockerImageConfig.",
+    }
+) {}
@@ -0,0 +1,51 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+
+export class V1HealthcheckConfig extends Schema.Class<V1HealthcheckConfig>("V1HealthcheckConfig")(
+    {
+        Test: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description:
+                    'Test is the test to perform to check that the container is healthy.\nAn empty slice means to inherit the default.\nThe options are:\n{} : inherit healthcheck\n{"NONE"} : disable healthcheck\n{"CMD", args...} : exec arguments directly\n{"CMD-SHELL", command} : run command with system\'s default shell',
+            })
+        ),
+        Interval: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({ description: "Interval is the time to wait between checks." })
+        ),
+        Timeout: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({ description: "Timeout is the time to wait before considering the check to have hung." })
+        ),
+        StartPeriod: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({
+                description:
+                    "The start period for the container to initialize before the retries starts to count down.",
+            })
+        ),
+        StartInterval: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({ description: "The interval to attempt healthchecks at during the start period" })
+        ),
+        Retries: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({
+                description:
+                    "Retries is the number of consecutive failures needed to consider a container as unhealthy.\nZero means inherit.",
+            })
+        ),
+    },
+    {
+        identifier: "V1HealthcheckConfig",
+        title: "v1.HealthcheckConfig",
+        documentation: "https://pkg.go.dev/github.com/moby/docker-image-spec@v1.3.1/specs-go/v1#HealthcheckConfig",
+        description: "HealthcheckConfig holds configuration settings for the HEALTHCHECK feature.",
+    }
+) {}
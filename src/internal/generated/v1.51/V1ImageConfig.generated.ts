@@ -0,0 +1,67 @@
+import * as Schema from "effect/Schema";
+
+export class V1ImageConfig extends Schema.Class<V1ImageConfig>("V1ImageConfig")(
+    {
+        User: Schema.optional(
+            Schema.String.annotate({
+                description: "User defines the username or UID which the process in the container should run as.",
+            })
+        ),
+        ExposedPorts: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.ObjectKeyword)).annotate({
+                description: "ExposedPorts a set of ports to expose from a container running this image.",
+            })
+        ),
+        Env: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "Env is a list of environment variables to be used in a container.",
+            })
+        ),
+        Entrypoint: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description:
+                    "Entrypoint defines a list of arguments to use as the command to execute when the container starts.",
+            })
+        ),
+        Cmd: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.String)).annotate({
+                description: "Cmd defines the default arguments to the entrypoint of the container.",
+            })
+        ),
+        Volumes: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.ObjectKeyword)).annotate({
+                description:
+                    "Volumes is a set of directories describing where the process is likely write data specific to a container instance.",
+            })
+        ),
+        WorkingDir: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "WorkingDir sets the current working directory of the entrypoint process in the container.",
+            })
+        ),
+        Labels: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+                description: "Labels contains arbitrary metadata for the container.",
+            })
+        ),
+        StopSignal: Schema.optional(
+            Schema.String.annotate({
+                description: "StopSignal contains the system call signal that will be sent to the container to exit.",
+            })
+        ),
+        ArgsEscaped: Schema.optional(
+            Schema.Boolean.annotate({
+                description:
+                    "ArgsEscaped\n\nDeprecated: This field is present only for legacy compatibility with\nDocker and should not be used by new image builders.  It is used by Docker\nfor Windows images to indicate that the `Entrypoint` or `Cmd` or both,\ncontains only a single element array, that is a pre-escaped, and combined\ninto a single string `CommandLine`. If `true` the value in `Entrypoint` or\n`Cmd` should be used as-is to avoid double escaping.\nhttps://github.com/opencontainers/image-spec/pull/892",
+            })
+        ),
+    },
+    {
+        identifier: "V1ImageConfig",
+        title: "v1.ImageConfig",
+        documentation: "https://pkg.go.dev/github.com/opencontainers/image-spec@v1.1.1/specs-go/v1#ImageConfig",
+        description:
+            "ImageConfig defines the execution parameters which should be used as a base when running a container using an image.",
+    }
+) {}
@@ -0,0 +1,40 @@
+import * as Schema from "effect/Schema";
+
+import * as SwarmVersion from "./SwarmVersion.generated.ts";
+import * as VolumeClusterVolumeSpec from "./VolumeClusterVolumeSpec.generated.ts";
+import * as VolumeInfo from "./VolumeInfo.generated.ts";
+import * as VolumePublishStatus from "./VolumePublishStatus.generated.ts";
+
+export class VolumeClusterVolume extends Schema.Class<VolumeClusterVolume>("VolumeClusterVolume")(
+    {
+        ID: Schema.String.annotate({
+            description:
+                "ID is the Swarm ID of the volume. Because cluster volumes are Swarm\nobjects, they have an ID, unlike non-cluster volumes, which only have a\nName. This ID can be used to refer to the cluster volume.",
+        }),
+        Version: Schema.NullOr(SwarmVersion.SwarmVersion),
+        CreatedAt: Schema.NullOr(Schema.DateFromString),
+        UpdatedAt: Schema.NullOr(Schema.DateFromString),
+        Spec: Schema.NullOr(VolumeClusterVolumeSpec.VolumeClusterVolumeSpec).annotate({
+            description: "Spec is the cluster-specific options from which this volume is derived.",
+        }),
+        PublishStatus: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.NullOr(VolumePublishStatus.VolumePublishStatus))).annotate({
+                description:
+                    "PublishStatus contains the status of the volume as it pertains to its\npublishing on Nodes.",
+            })
+        ),
+        Info: Schema.optional(
+            Schema.NullOr(VolumeInfo.VolumeInfo).annotate({
+                description: "Info is information about the global status of the volume.",
+            })
+        ),
+    },
+    {
+        identifier: "VolumeClusterVolume",
+        title: "volume.ClusterVolume",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/volume#ClusterVolume",
+        description:
+            "ClusterVolume contains options and information specific to, and only present\non, Swarm CSI cluster volumes.",
+    }
+) {}
@@ -0,0 +1,54 @@
+import * as Schema from "effect/Schema";
+
+import * as VolumeAccessMode from "./VolumeAccessMode.generated.ts";
+import * as VolumeAvailability from "./VolumeAvailability.generated.ts";
+import * as VolumeCapacityRange from "./VolumeCapacityRange.generated.ts";
+import * as VolumeSecret from "./VolumeSecret.generated.ts";
+import * as VolumeTopologyRequirement from "./VolumeTopologyRequirement.generated.ts";
+
+export class VolumeClusterVolumeSpec extends Schema.Class<VolumeClusterVolumeSpec>("VolumeClusterVolumeSpec")(
+    {
+        Group: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "Group defines the volume group of this volume. Volumes belonging to the\nsame group can be referred to by group name when creating Services.\nReferring to a volume by group instructs swarm to treat volumes in that\ngroup interchangeably for the purpose of scheduling. Volumes with an\nempty string for a group technically all belong to the same, emptystring\ngroup.",
+            })
+        ),
+        AccessMode: Schema.optional(
+            Schema.NullOr(VolumeAccessMode.VolumeAccessMode).annotate({
+                description: "AccessMode defines how the volume is used by tasks.",
+            })
+        ),
+        AccessibilityRequirements: Schema.optional(
+            Schema.NullOr(VolumeTopologyRequirement.VolumeTopologyRequirement).annotate({
+                description:
+                    "AccessibilityRequirements specifies where in the cluster a volume must\nbe accessible from.\n\nThis field must be empty if the plugin does not support\nVOLUME_ACCESSIBILITY_CONSTRAINTS capabilities. If it is present but the\nplugin does not support it, volume will not be created.\n\nIf AccessibilityRequirements is empty, but the plugin does support\nVOLUME_ACCESSIBILITY_CONSTRAINTS, then Swarmkit will assume the entire\ncluster is a valid target for the volume.",
+            })
+        ),
+        CapacityRange: Schema.optional(
+            Schema.NullOr(VolumeCapacityRange.VolumeCapacityRange).annotate({
+                description:
+                    "CapacityRange defines the desired capacity that the volume should be\ncreated with. If nil, the plugin will decide the capacity.",
+            })
+        ),
+        Secrets: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.NullOr(VolumeSecret.VolumeSecret))).annotate({
+                description:
+                    "Secrets defines Swarm Secrets that are passed to the CSI storage plugin\nwhen operating on this volume.",
+            })
+        ),
+        Availability: Schema.optional(
+            VolumeAvailability.VolumeAvailability.annotate({
+                description:
+                    "Availability is the Volume's desired availability. Analogous to Node\nAvailability, this allows the user to take volumes offline in order to\nupdate or delete them.",
+            })
+        ),
+    },
+    {
+        identifier: "VolumeClusterVolumeSpec",
+        title: "volume.ClusterVolumeSpec",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/volume#ClusterVolumeSpec",
+        description: "ClusterVolumeSpec contains the spec used to create this volume.",
+    }
+) {}
@@ -0,0 +1,35 @@
+import * as Schema from "effect/Schema";
+
+import * as VolumeClusterVolumeSpec from "./VolumeClusterVolumeSpec.generated.ts";
+
+export class VolumeCreateOptions extends Schema.Class<VolumeCreateOptions>("VolumeCreateOptions")(
+    {
+        ClusterVolumeSpec: Schema.optional(
+            Schema.NullOr(VolumeClusterVolumeSpec.VolumeClusterVolumeSpec).annotate({
+                description: "cluster volume spec",
+            })
+        ),
+        Driver: Schema.optional(Schema.String.annotate({ description: "Name of the volume driver to use." })),
+        DriverOpts: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+                description:
+                    "A mapping of driver options and values. These options are\npassed directly to the driver and are driver specific.",
+            })
+        ),
+        Labels: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+                description: "User-defined key/value metadata.",
+            })
+        ),
+        Name: Schema.optional(
+            Schema.String.annotate({ description: "The new volume's name. If not specified, Docker generates a name." })
+        ),
+    },
+    {
+        identifier: "VolumeCreateOptions",
+        title: "volume.CreateOptions",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/volume#CreateOptions",
+        description: "CreateOptions VolumeConfig\n\nVolume configuration\nswagger:model CreateOptions",
+    }
+) {}
@@ -0,0 +1,40 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyNumber from "../../schemas/number.ts";
+import * as VolumeTopology from "./VolumeTopology.generated.ts";
+
+export class VolumeInfo extends Schema.Class<VolumeInfo>("VolumeInfo")(
+    {
+        CapacityBytes: Schema.optional(
+            MobyNumber.BigIntFromWireString.check(
+                Schema.isBetweenBigInt({ minimum: -(2n ** 63n), maximum: 2n ** 63n - 1n })
+            ).annotate({
+                description:
+                    "CapacityBytes is the capacity of the volume in bytes. A value of 0\nindicates that the capacity is unknown.",
+            })
+        ),
+        VolumeContext: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+                description:
+                    "VolumeContext is the context originating from the CSI storage plugin\nwhen the Volume is created.",
+            })
+        ),
+        VolumeID: Schema.optional(
+            Schema.String.annotate({
+                description:
+                    "VolumeID is the ID of the Volume as seen by the CSI storage plugin. This\nis distinct from the Volume's Swarm ID, which is the ID used by all of\nthe Docker Engine to refer to the Volume. If this field is blank, then\nthe Volume has not been successfully created yet.",
+            })
+        ),
+        AccessibleTopology: Schema.optional(
+            Schema.NullOr(Schema.Array(Schema.NullOr(VolumeTopology.VolumeTopology))).annotate({
+                description: "AccessibleTopology is the topology this volume is actually accessible\nfrom.",
+            })
+        ),
+    },
+    {
+        identifier: "VolumeInfo",
+        title: "volume.Info",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/volume#Info",
+        description: "Info contains information about the Volume as a whole as provided by\nthe CSI storage plugin.",
+    }
+) {}
@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const VolumePostVolumesPruneQuery = Schema.Struct({
+    filters: Schema.optional(Schema.fromJsonString(Schema.Record(Schema.String, Schema.Array(Schema.String)))),
+}).annotate({
+    identifier: "VolumePostVolumesPruneQuery",
+    title: "volume.postVolumesPrune",
+    description: "Query parameters of POST /volumes/prune",
+});
+
+export type VolumePostVolumesPruneQuery = Schema.Schema.Type<typeof VolumePostVolumesPruneQuery>;
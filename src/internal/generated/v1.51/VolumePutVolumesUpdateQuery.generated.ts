@@ -0,0 +1,11 @@
+import * as Schema from "effect/Schema";
+
+export const VolumePutVolumesUpdateQuery = Schema.Struct({
+    version: Schema.optional(Schema.Finite),
+}).annotate({
+    identifier: "VolumePutVolumesUpdateQuery",
+    title: "volume.putVolumesUpdate",
+    description: "Query parameters of PUT /volumes/{name:.*}",
+});
+
+export type VolumePutVolumesUpdateQuery = Schema.Schema.Type<typeof VolumePutVolumesUpdateQuery>;
@@ -0,0 +1,14 @@
+import * as Schema from "effect/Schema";
+
+export class VolumeTopology extends Schema.Class<VolumeTopology>("VolumeTopology")(
+    {
+        Segments: Schema.optional(Schema.NullOr(Schema.Record(Schema.String, Schema.String))),
+    },
+    {
+        identifier: "VolumeTopology",
+        title: "volume.Topology",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/volume#Topology",
+        description:
+            'Topology is a map of topological domains to topological segments.\n\nThis description is taken verbatim from the CSI Spec:\n\nA topological domain is a sub-division of a cluster, like "region",\n"zone", "rack", etc.\nA topological segment is a specific instance of a topological domain,\nlike "zone3", "rack3", etc.\nFor example {"com.company/zone": "Z1", "com.company/rack": "R3"}\nValid keys have two segments: an OPTIONAL prefix and name, separated\nby a slash (/), for example: "com.company.example/zone".\nThe key name segment is REQUIRED. The prefix is OPTIONAL.\nThe key name MUST be 63 characters or less, begin and end with an\nalphanumeric character ([a-z0-9A-Z]), and contain only dashes (-),\nunderscores (_), dots (.), or alphanumerics in between, for example\n"zone".\nThe key prefix MUST be 63 characters or less, begin and end with a\nlower-case alphanumeric character ([a-z0-9]), contain only\ndashes (-), dots (.), or lower-case alphanumerics in between, and\nfollow domain name notation format\n(https://tools.ietf.org/html/rfc1035#section-2.3.1).\nThe key prefix SHOULD include the plugin\'s host company name and/or\nthe plugin name, to minimize the possibility of collisions with keys\nfrom other plugins.\nIf a key prefix is specified, it MUST be identical across all\ntopology keys returned by the SP (across all RPCs).\nKeys MUST be case-insensitive. Meaning the keys "Zone" and "zone"\nMUST not both exist.\nEach value (topological segment) MUST contain 1 or more strings.\nEach string MUST be 63 characters or less and begin and end with an\nalphanumeric character with \'-\', \'_\', \'.\', or alphanumerics in\nbetween.',
+    }
+) {}
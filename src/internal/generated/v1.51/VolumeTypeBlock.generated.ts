@@ -6,5 +6,6 @@ export class VolumeTypeBlock extends Schema.Class<VolumeTypeBlock>("VolumeTypeBl
         identifier: "VolumeTypeBlock",
         title: "volume.TypeBlock",
         documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/volume#TypeBlock",
+        description: "TypeBlock defines options for using a volume as a block-type volume.\n\nIntentionally empty.",
     }
 ) {}
@@ -0,0 +1,20 @@
+import * as Schema from "effect/Schema";
+
+import * as VolumeClusterVolumeSpec from "./VolumeClusterVolumeSpec.generated.ts";
+
+export class VolumeUpdateOptions extends Schema.Class<VolumeUpdateOptions>("VolumeUpdateOptions")(
+    {
+        Spec: Schema.optional(
+            Schema.NullOr(VolumeClusterVolumeSpec.VolumeClusterVolumeSpec).annotate({
+                description: "Spec is the ClusterVolumeSpec to update the volume to.",
+            })
+        ),
+    },
+    {
+        identifier: "VolumeUpdateOptions",
+        title: "volume.UpdateOptions",
+        documentation:
+            "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/volume#UpdateOptions",
+        description: "UpdateOptions is configuration to update a Volume with.",
+    }
+) {}
@@ -0,0 +1,46 @@
+import * as Schema from "effect/Schema";
+
+import * as MobyIdentifiers from "../../schemas/id.ts";
+import * as VolumeClusterVolume from "./VolumeClusterVolume.generated.ts";
+import * as VolumeUsageData from "./VolumeUsageData.generated.ts";
+
+export class VolumeVolume extends Schema.Class<VolumeVolume>("VolumeVolume")(
+    {
+        ClusterVolume: Schema.optional(
+            Schema.NullOr(VolumeClusterVolume.VolumeClusterVolume).annotate({ description: "cluster volume" })
+        ),
+        CreatedAt: Schema.optional(
+            Schema.DateFromString.annotate({ description: "Date/Time the volume was created." })
+        ),
+        Driver: Schema.String.annotate({
+            description: "Name of the volume driver used by the volume.\nRequired: true",
+        }),
+        Labels: Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+            description: "User-defined key/value metadata.\nRequired: true",
+        }),
+        Mountpoint: Schema.String.annotate({ description: "Mount path of the volume on the host.\nRequired: true" }),
+        Name: MobyIdentifiers.VolumeIdentifier.annotate({ description: "Name of the volume.\nRequired: true" }),
+        Options: Schema.NullOr(Schema.Record(Schema.String, Schema.String)).annotate({
+            description: "The driver specific options used when creating the volume.\n\nRequired: true",
+        }),
+        Scope: Schema.String.annotate({
+            description:
+                "The level at which the volume exists. Either `global` for cluster-wide,\nor `local` for machine level.\n\nRequired: true",
+        }),
+        Status: Schema.optional(
+            Schema.NullOr(Schema.Record(Schema.String, Schema.ObjectKeyword)).annotate({
+                description:
+                    'Low-level details about the volume, provided by the volume driver.\nDetails are returned as a map with key/value pairs:\n`{"key":"value","key2":"value2"}`.\n\nThe `Status` field is optional, and is omitted if the volume driver\ndoes not support this feature.',
+            })
+        ),
+        UsageData: Schema.optional(
+            Schema.NullOr(VolumeUsageData.VolumeUsageData).annotate({ description: "usage data" })
+        ),
+    },
+    {
+        identifier: "VolumeVolume",
+        title: "volume.Volume",
+        documentation: "https://pkg.go.dev/github.com/docker/docker@v28.4.0+incompatible/api/types/volume#Volume",
+        description: "Volume volume\nswagger:model Volume",
+    }
+) {}
@@ -0,0 +1,353 @@
+export * from "./ArchiveChange.generated.ts";
+export * from "./ArchiveChangeType.generated.ts";
+export * from "./BlkiodevThrottleDevice.generated.ts";
+export * from "./BlkiodevWeightDevice.generated.ts";
+export * from "./BuildCachePruneOptions.generated.ts";
+export * from "./BuildCachePruneReport.generated.ts";
+export * from "./BuildCacheRecord.generated.ts";
+export * from "./BuildPostBuildQuery.generated.ts";
+export * from "./BuildPostCancelQuery.generated.ts";
+export * from "./BuildPostPruneQuery.generated.ts";
+export * from "./CheckpointCreateOptions.generated.ts";
+export * from "./CheckpointDeleteContainerCheckpointQuery.generated.ts";
+export * from "./CheckpointDeleteOptions.generated.ts";
+export * from "./CheckpointGetContainerCheckpointsQuery.generated.ts";
+export * from "./CheckpointListOptions.generated.ts";
+export * from "./CheckpointSummary.generated.ts";
+export * from "./ContainerBlkioStatEntry.generated.ts";
+export * from "./ContainerBlkioStats.generated.ts";
+export * from "./ContainerCPUStats.generated.ts";
+export * from "./ContainerCPUUsage.generated.ts";
+export * from "./ContainerCgroupnsMode.generated.ts";
+export * from "./ContainerConfig.generated.ts";
+export * from "./ContainerContainerJSONBase.generated.ts";
+export * from "./ContainerCreateRequest.generated.ts";
+export * from "./ContainerCreateResponse.generated.ts";
+export * from "./ContainerDefaultNetworkSettings.generated.ts";
+export * from "./ContainerDeleteContainersQuery.generated.ts";
+export * from "./ContainerDeviceMapping.generated.ts";
+export * from "./ContainerDeviceRequest.generated.ts";
+export * from "./ContainerExecInspect.generated.ts";
+export * from "./ContainerExecOptions.generated.ts";
+export * from "./ContainerExecStartOptions.generated.ts";
+export * from "./ContainerGetContainersArchiveQuery.generated.ts";
+export * from "./ContainerGetContainersByNameQuery.generated.ts";
+export * from "./ContainerGetContainersJSONQuery.generated.ts";
+export * from "./ContainerGetContainersLogsQuery.generated.ts";
+export * from "./ContainerGetContainersStatsQuery.generated.ts";
+export * from "./ContainerGetContainersTopQuery.generated.ts";
+export * from "./ContainerHeadContainersArchiveQuery.generated.ts";
+export * from "./ContainerHealth.generated.ts";
+export * from "./ContainerHealthcheckResult.generated.ts";
+export * from "./ContainerHostConfig.generated.ts";
+export * from "./ContainerInspectResponse.generated.ts";
+export * from "./ContainerIsolation.generated.ts";
+export * from "./ContainerListOptions.generated.ts";
+export * from "./ContainerLogConfig.generated.ts";
+export * from "./ContainerLogsOptions.generated.ts";
+export * from "./ContainerMemoryStats.generated.ts";
+export * from "./ContainerMountPoint.generated.ts";
+export * from "./ContainerNetworkSettings.generated.ts";
+export * from "./ContainerNetworkSettingsBase.generated.ts";
+export * from "./ContainerNetworkSettingsSummary.generated.ts";
+export * from "./ContainerNetworkStats.generated.ts";
+export * from "./ContainerPathStat.generated.ts";
+export * from "./ContainerPidsStats.generated.ts";
+export * from "./ContainerPort.generated.ts";
+export * from "./ContainerPostCommitQuery.generated.ts";
+export * from "./ContainerPostContainerExecResizeQuery.generated.ts";
+export * from "./ContainerPostContainerRenameQuery.generated.ts";
+export * from "./ContainerPostContainersAttachQuery.generated.ts";
+export * from "./ContainerPostContainersCreateQuery.generated.ts";
+export * from "./ContainerPostContainersKillQuery.generated.ts";
+export * from "./ContainerPostContainersPruneQuery.generated.ts";
+export * from "./ContainerPostContainersResizeQuery.generated.ts";
+export * from "./ContainerPostContainersRestartQuery.generated.ts";
+export * from "./ContainerPostContainersStartQuery.generated.ts";
+export * from "./ContainerPostContainersStopQuery.generated.ts";
+export * from "./ContainerPostContainersWaitQuery.generated.ts";
+export * from "./ContainerPruneReport.generated.ts";
+export * from "./ContainerPutContainersArchiveQuery.generated.ts";
+export * from "./ContainerResources.generated.ts";
+export * from "./ContainerRestartPolicy.generated.ts";
+export * from "./ContainerRestartPolicyMode.generated.ts";
+export * from "./ContainerState.generated.ts";
+export * from "./ContainerStateStatus.generated.ts";
+export * from "./ContainerStatsResponse.generated.ts";
+export * from "./ContainerStopOptions.generated.ts";
+export * from "./ContainerStorageStats.generated.ts";
+export * from "./ContainerSummary.generated.ts";
+export * from "./ContainerSummaryHostConfig.generated.ts";
+export * from "./ContainerThrottlingData.generated.ts";
+export * from "./ContainerTopResponse.generated.ts";
+export * from "./ContainerUpdateResponse.generated.ts";
+export * from "./ContainerWaitExitError.generated.ts";
+export * from "./ContainerWaitResponse.generated.ts";
+export * from "./ContainerWsContainersAttachQuery.generated.ts";
+export * from "./EventsAction.generated.ts";
+export * from "./EventsActor.generated.ts";
+export * from "./EventsMessage.generated.ts";
+export * from "./EventsType.generated.ts";
+export * from "./ImageAttestationProperties.generated.ts";
+export * from "./ImageDeleteImagesQuery.generated.ts";
+export * from "./ImageDeleteResponse.generated.ts";
+export * from "./ImageGetImagesByNameQuery.generated.ts";
+export * from "./ImageGetImagesGetQuery.generated.ts";
+export * from "./ImageGetImagesHistoryQuery.generated.ts";
+export * from "./ImageGetImagesJSONQuery.generated.ts";
+export * from "./ImageGetImagesSearchQuery.generated.ts";
+export * from "./ImageHistoryResponseItem.generated.ts";
+export * from "./ImageImageProperties.generated.ts";
+export * from "./ImageImagePropertiesSize.generated.ts";
+export * from "./ImageInspectResponse.generated.ts";
+export * from "./ImageListOptions.generated.ts";
+export * from "./ImageManifestKind.generated.ts";
+export * from "./ImageManifestSummary.generated.ts";
+export * from "./ImageManifestSummarySize.generated.ts";
+export * from "./ImageMetadata.generated.ts";
+export * from "./ImagePostImagesCreateQuery.generated.ts";
+export * from "./ImagePostImagesLoadQuery.generated.ts";
+export * from "./ImagePostImagesPruneQuery.generated.ts";
+export * from "./ImagePostImagesPushQuery.generated.ts";
+export * from "./ImagePostImagesTagQuery.generated.ts";
+export * from "./ImagePruneReport.generated.ts";
+export * from "./ImageRemoveOptions.generated.ts";
+export * from "./ImageRootFS.generated.ts";
+export * from "./ImageSummary.generated.ts";
+export * from "./JSONMessage.generated.ts";
+export * from "./JsonmessageJSONError.generated.ts";
+export * from "./JsonmessageJSONProgress.generated.ts";
+export * from "./MountBindOptions.generated.ts";
+export * from "./MountClusterOptions.generated.ts";
+export * from "./MountConsistency.generated.ts";
+export * from "./MountDriver.generated.ts";
+export * from "./MountImageOptions.generated.ts";
+export * from "./MountMount.generated.ts";
+export * from "./MountPropagation.generated.ts";
+export * from "./MountTmpfsOptions.generated.ts";
+export * from "./MountType.generated.ts";
+export * from "./MountVolumeOptions.generated.ts";
+export * from "./NetworkAddress.generated.ts";
+export * from "./NetworkConfigReference.generated.ts";
+export * from "./NetworkConnectOptions.generated.ts";
+export * from "./NetworkCreateOptions.generated.ts";
+export * from "./NetworkCreateRequest.generated.ts";
+export * from "./NetworkCreateResponse.generated.ts";
+export * from "./NetworkEndpointIPAMConfig.generated.ts";
+export * from "./NetworkEndpointResource.generated.ts";
+export * from "./NetworkEndpointSettings.generated.ts";
+export * from "./NetworkGetNetworkQuery.generated.ts";
+export * from "./NetworkGetNetworksListQuery.generated.ts";
+export * from "./NetworkIPAM.generated.ts";
+export * from "./NetworkIPAMConfig.generated.ts";
+export * from "./NetworkInspect.generated.ts";
+export * from "./NetworkNetworkingConfig.generated.ts";
+export * from "./NetworkPeerInfo.generated.ts";
+export * from "./NetworkPostNetworksPruneQuery.generated.ts";
+export * from "./NetworkPruneReport.generated.ts";
+export * from "./NetworkServiceInfo.generated.ts";
+export * from "./NetworkTask.generated.ts";
+export * from "./PluginCreatePluginQuery.generated.ts";
+export * from "./PluginDisablePluginQuery.generated.ts";
+export * from "./PluginEnablePluginQuery.generated.ts";
+export * from "./PluginGetPrivilegesQuery.generated.ts";
+export * from "./PluginListPluginsQuery.generated.ts";
+export * from "./PluginPullPluginQuery.generated.ts";
+export * from "./PluginRemovePluginQuery.generated.ts";
+export * from "./PluginUpgradePluginQuery.generated.ts";
+export * from "./RegistryAuthConfig.generated.ts";
+export * from "./RegistryAuthenticateOKBody.generated.ts";
+export * from "./RegistryDistributionInspect.generated.ts";
+export * from "./RegistryIndexInfo.generated.ts";
+export * from "./RegistrySearchResult.generated.ts";
+export * from "./RegistryServiceConfig.generated.ts";
+export * from "./RuntimePluginPrivilege.generated.ts";
+export * from "./RuntimePluginSpec.generated.ts";
+export * from "./StorageDriverData.generated.ts";
+export * from "./SwarmAnnotations.generated.ts";
+export * from "./SwarmAppArmorMode.generated.ts";
+export * from "./SwarmAppArmorOpts.generated.ts";
+export * from "./SwarmCAConfig.generated.ts";
+export * from "./SwarmClusterInfo.generated.ts";
+export * from "./SwarmConfig.generated.ts";
+export * from "./SwarmConfigListOptions.generated.ts";
+export * from "./SwarmConfigReference.generated.ts";
+export * from "./SwarmConfigReferenceFileTarget.generated.ts";
+export * from "./SwarmConfigReferenceRuntimeTarget.generated.ts";
+export * from "./SwarmConfigSpec.generated.ts";
+export * from "./SwarmContainerSpec.generated.ts";
+export * from "./SwarmContainerStatus.generated.ts";
+export * from "./SwarmCredentialSpec.generated.ts";
+export * from "./SwarmDNSConfig.generated.ts";
+export * from "./SwarmDiscreteGenericResource.generated.ts";
+export * from "./SwarmDispatcherConfig.generated.ts";
+export * from "./SwarmDriver.generated.ts";
+export * from "./SwarmEncryptionConfig.generated.ts";
+export * from "./SwarmEndpoint.generated.ts";
+export * from "./SwarmEndpointSpec.generated.ts";
+export * from "./SwarmEndpointVirtualIP.generated.ts";
+export * from "./SwarmEngineDescription.generated.ts";
+export * from "./SwarmExternalCA.generated.ts";
+export * from "./SwarmExternalCAProtocol.generated.ts";
+export * from "./SwarmGenericResource.generated.ts";
+export * from "./SwarmGetConfigsQuery.generated.ts";
+export * from "./SwarmGetNodesQuery.generated.ts";
+export * from "./SwarmGetSecretsQuery.generated.ts";
+export * from "./SwarmGetServiceLogsQuery.generated.ts";
+export * from "./SwarmGetServiceQuery.generated.ts";
+export * from "./SwarmGetServicesQuery.generated.ts";
+export * from "./SwarmGetTaskLogsQuery.generated.ts";
+export * from "./SwarmGetTasksQuery.generated.ts";
+export * from "./SwarmGlobalJob.generated.ts";
+export * from "./SwarmGlobalService.generated.ts";
+export * from "./SwarmIPAMConfig.generated.ts";
+export * from "./SwarmIPAMOptions.generated.ts";
+export * from "./SwarmInfo.generated.ts";
+export * from "./SwarmInitRequest.generated.ts";
+export * from "./SwarmJobStatus.generated.ts";
+export * from "./SwarmJoinRequest.generated.ts";
+export * from "./SwarmJoinTokens.generated.ts";
+export * from "./SwarmLeaveClusterQuery.generated.ts";
+export * from "./SwarmLimit.generated.ts";
+export * from "./SwarmLocalNodeState.generated.ts";
+export * from "./SwarmManagerStatus.generated.ts";
+export * from "./SwarmMeta.generated.ts";
+export * from "./SwarmNamedGenericResource.generated.ts";
+export * from "./SwarmNetwork.generated.ts";
+export * from "./SwarmNetworkAttachment.generated.ts";
+export * from "./SwarmNetworkAttachmentConfig.generated.ts";
+export * from "./SwarmNetworkAttachmentSpec.generated.ts";
+export * from "./SwarmNetworkSpec.generated.ts";
+export * from "./SwarmNode.generated.ts";
+export * from "./SwarmNodeAvailability.generated.ts";
+export * from "./SwarmNodeCSIInfo.generated.ts";
+export * from "./SwarmNodeDescription.generated.ts";
+export * from "./SwarmNodeListOptions.generated.ts";
+export * from "./SwarmNodeRole.generated.ts";
+export * from "./SwarmNodeSpec.generated.ts";
+export * from "./SwarmNodeState.generated.ts";
+export * from "./SwarmNodeStatus.generated.ts";
+export * from "./SwarmOrchestrationConfig.generated.ts";
+export * from "./SwarmPeer.generated.ts";
+export * from "./SwarmPlacement.generated.ts";
+export * from "./SwarmPlacementPreference.generated.ts";
+export * from "./SwarmPlatform.generated.ts";
+export * from "./SwarmPluginDescription.generated.ts";
+export * from "./SwarmPortConfig.generated.ts";
+export * from "./SwarmPortConfigProtocol.generated.ts";
+export * from "./SwarmPortConfigPublishMode.generated.ts";
+export * from "./SwarmPortStatus.generated.ts";
+export * from "./SwarmPrivileges.generated.ts";
+export * from "./SwarmRaftConfig.generated.ts";
+export * from "./SwarmReachability.generated.ts";
+export * from "./SwarmRemoveNodeQuery.generated.ts";
+export * from "./SwarmReplicatedJob.generated.ts";
+export * from "./SwarmReplicatedService.generated.ts";
+export * from "./SwarmResolutionMode.generated.ts";
+export * from "./SwarmResourceRequirements.generated.ts";
+export * from "./SwarmResources.generated.ts";
+export * from "./SwarmRestartPolicy.generated.ts";
+export * from "./SwarmRestartPolicyCondition.generated.ts";
+export * from "./SwarmRuntimeType.generated.ts";
+export * from "./SwarmSELinuxContext.generated.ts";
+export * from "./SwarmSeccompMode.generated.ts";
+export * from "./SwarmSeccompOpts.generated.ts";
+export * from "./SwarmSecret.generated.ts";
+export * from "./SwarmSecretListOptions.generated.ts";
+export * from "./SwarmSecretReference.generated.ts";
+export * from "./SwarmSecretReferenceFileTarget.generated.ts";
+export * from "./SwarmSecretSpec.generated.ts";
+export * from "./SwarmService.generated.ts";
+export * from "./SwarmServiceCreateResponse.generated.ts";
+export * from "./SwarmServiceListOptions.generated.ts";
+export * from "./SwarmServiceMode.generated.ts";
+export * from "./SwarmServiceSpec.generated.ts";
+export * from "./SwarmServiceStatus.generated.ts";
+export * from "./SwarmServiceUpdateOptions.generated.ts";
+export * from "./SwarmServiceUpdateResponse.generated.ts";
+export * from "./SwarmSpec.generated.ts";
+export * from "./SwarmSpreadOver.generated.ts";
+export * from "./SwarmSwarm.generated.ts";
+export * from "./SwarmTLSInfo.generated.ts";
+export * from "./SwarmTask.generated.ts";
+export * from "./SwarmTaskDefaults.generated.ts";
+export * from "./SwarmTaskListOptions.generated.ts";
+export * from "./SwarmTaskSpec.generated.ts";
+export * from "./SwarmTaskState.generated.ts";
+export * from "./SwarmTaskStatus.generated.ts";
+export * from "./SwarmTopology.generated.ts";
+export * from "./SwarmUnlockRequest.generated.ts";
+export * from "./SwarmUpdateClusterQuery.generated.ts";
+export * from "./SwarmUpdateConfig.generated.ts";
+export * from "./SwarmUpdateConfigQuery.generated.ts";
+export * from "./SwarmUpdateFlags.generated.ts";
+export * from "./SwarmUpdateNodeQuery.generated.ts";
+export * from "./SwarmUpdateSecretQuery.generated.ts";
+export * from "./SwarmUpdateServiceQuery.generated.ts";
+export * from "./SwarmUpdateState.generated.ts";
+export * from "./SwarmUpdateStatus.generated.ts";
+export * from "./SwarmVersion.generated.ts";
+export * from "./SwarmVolumeAttachment.generated.ts";
+export * from "./SystemCommit.generated.ts";
+export * from "./SystemContainerdInfo.generated.ts";
+export * from "./SystemContainerdNamespaces.generated.ts";
+export * from "./SystemDeviceInfo.generated.ts";
+export * from "./SystemFirewallInfo.generated.ts";
+export * from "./SystemGetDiskUsageQuery.generated.ts";
+export * from "./SystemGetEventsQuery.generated.ts";
+export * from "./SystemInfo.generated.ts";
+export * from "./SystemNetworkAddressPool.generated.ts";
+export * from "./SystemPluginsInfo.generated.ts";
+export * from "./SystemRuntime.generated.ts";
+export * from "./SystemRuntimeWithStatus.generated.ts";
+export * from "./TypesComponentVersion.generated.ts";
+export * from "./TypesDiskUsage.generated.ts";
+export * from "./TypesPlugin.generated.ts";
+export * from "./TypesPluginConfig.generated.ts";
+export * from "./TypesPluginConfigArgs.generated.ts";
+export * from "./TypesPluginConfigInterface.generated.ts";
+export * from "./TypesPluginConfigLinux.generated.ts";
+export * from "./TypesPluginConfigNetwork.generated.ts";
+export * from "./TypesPluginConfigRootfs.generated.ts";
+export * from "./TypesPluginConfigUser.generated.ts";
+export * from "./TypesPluginCreateOptions.generated.ts";
+export * from "./TypesPluginDevice.generated.ts";
+export * from "./TypesPluginEnv.generated.ts";
+export * from "./TypesPluginMount.generated.ts";
+export * from "./TypesPluginSettings.generated.ts";
+export * from "./TypesVersion.generated.ts";
+export * from "./TypesVersionPlatform.generated.ts";
+export * from "./UnitsUlimit.generated.ts";
+export * from "./V1Descriptor.generated.ts";
+export * from "./V1DockerOCIImageConfig.generated.ts";
+export * from "./V1DockerOCIImageConfigExt.generated.ts";
+export * from "./V1HealthcheckConfig.generated.ts";
+export * from "./V1ImageConfig.generated.ts";
+export * from "./V1Platform.generated.ts";
+export * from "./VolumeAccessMode.generated.ts";
+export * from "./VolumeAvailability.generated.ts";
+export * from "./VolumeCapacityRange.generated.ts";
+export * from "./VolumeClusterVolume.generated.ts";
+export * from "./VolumeClusterVolumeSpec.generated.ts";
+export * from "./VolumeCreateOptions.generated.ts";
+export * from "./VolumeDeleteVolumesQuery.generated.ts";
+export * from "./VolumeGetVolumesListQuery.generated.ts";
+export * from "./VolumeInfo.generated.ts";
+export * from "./VolumeListOptions.generated.ts";
+export * from "./VolumePostVolumesPruneQuery.generated.ts";
+export * from "./VolumePruneReport.generated.ts";
+export * from "./VolumePublishState.generated.ts";
+export * from "./VolumePublishStatus.generated.ts";
+export * from "./VolumePutVolumesUpdateQuery.generated.ts";
+export * from "./VolumeScope.generated.ts";
+export * from "./VolumeSecret.generated.ts";
+export * from "./VolumeSharingMode.generated.ts";
+export * from "./VolumeTopology.generated.ts";
+export * from "./VolumeTopologyRequirement.generated.ts";
+export * from "./VolumeTypeBlock.generated.ts";
+export * from "./VolumeTypeMount.generated.ts";
+export * from "./VolumeUpdateOptions.generated.ts";
+export * from "./VolumeUsageData.generated.ts";
+export * from "./VolumeVolume.generated.ts";
+
+export const ApiVersion = "1.51" as const;
@@ -52,3 +52,22 @@ export const NumberFromWireString = Schema.String.annotate({
 export const BigIntFromWireString = Schema.String.annotate({
     expected: "a string that will be decoded as a bigint and sent over the wire as a bare JSON number",
 }).pipe(Schema.decodeTo(Schema.BigInt, sentinelTransformation.compose(SchemaTransformation.bigintFromString)));
+
+/**
+ * A fixed set of numbers, like the constants of a Go integer enum, that crosses
+ * the wire as a bare JSON number but is carried as a quoted string between the
+ * agnostic http client and this schema. See {@link wireNumberSentinel} for how
+ * the two directions work.
+ *
+ * @internal
+ */
+export const LiteralsFromWireString = <const Literals extends ReadonlyArray<number>>(literals: Literals) =>
+    NumberFromWireString.pipe(
+        Schema.decodeTo(
+            Schema.Literals(literals),
+            SchemaTransformation.transform({
+                decode: (n: number) => n as Literals[number],
+                encode: (literal: Literals[number]) => literal,
+            })
+        )
+    );
@@ -0,0 +1,38 @@
+/**
+ * Schemas for fields tagged with the `,string` option of encoding/json.
+ *
+ * @since 1.0.0
+ */
+
+import * as Schema from "effect/Schema";
+
+/**
+ * A number that encoding/json writes as a JSON string, like `"1024"`. Unlike
+ * the numbers of `number.ts` it stays a string on the wire, so it is encoded
+ * without the wire number sentinel.
+ *
+ * @internal
+ */
+export const NumberFromQuotedString = Schema.FiniteFromString;
+
+/**
+ * A 64-bit integer that encoding/json writes as a JSON string, like `"1024"`.
+ *
+ * @internal
+ */
+export const BigIntFromQuotedString = Schema.BigIntFromString;
+
+/**
+ * A boolean that encoding/json writes as a JSON string, like `"true"`.
+ *
+ * @internal
+ */
+export const BooleanFromQuotedString = Schema.fromJsonString(Schema.Boolean);
+
+/**
+ * A string that encoding/json writes as a JSON string holding the JSON
+ * encoded string, like `"\"value\""`.
+ *
+ * @internal
+ */
+export const StringFromQuotedString = Schema.fromJsonString(Schema.String);